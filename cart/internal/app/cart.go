@@ -119,6 +119,7 @@ func (c *CartApp) initHTTPServer(ctx context.Context) error {
 	mux.Handle("DELETE /user/{user_id}/cart/{sku_id}", middleware.TraceID(middleware.RequestMetric(middleware.RequestLogger(middleware.ErrorWrapper(cart.DeleteItem)))))
 	mux.Handle("DELETE /user/{user_id}/cart", middleware.TraceID(middleware.RequestMetric(middleware.RequestLogger(middleware.ErrorWrapper(cart.DeleteItemsByUserID)))))
 	mux.Handle("GET /user/{user_id}/cart/list", middleware.TraceID(middleware.RequestMetric(middleware.RequestLogger(middleware.ErrorWrapper(cart.GetCart)))))
+	mux.Handle("GET /user/{user_id}/orders", middleware.TraceID(middleware.RequestMetric(middleware.RequestLogger(middleware.ErrorWrapper(cart.GetOrders)))))
 	mux.Handle("/metrics", promhttp.Handler())
 
 	mux.HandleFunc("/debug/pprof/", pprof.Index)
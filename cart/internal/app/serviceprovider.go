@@ -8,22 +8,34 @@ import (
 
 	"github.com/BruteMors/marketplace-service/cart/internal/config"
 	"github.com/BruteMors/marketplace-service/cart/internal/controller/httpapi/hanlders/cart"
-	cartRepository "github.com/BruteMors/marketplace-service/cart/internal/repository/inmemory/cart"
+	inMemoryCartRepository "github.com/BruteMors/marketplace-service/cart/internal/repository/inmemory/cart"
+	postgresCartRepository "github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart"
+	cartRepositoryWithMetrics "github.com/BruteMors/marketplace-service/cart/internal/repository/withmetrics/cart"
 	cartService "github.com/BruteMors/marketplace-service/cart/internal/service/cart"
+	"github.com/BruteMors/marketplace-service/cart/pkg/circuitbreaker"
+	"github.com/BruteMors/marketplace-service/cart/pkg/closer"
 	"github.com/BruteMors/marketplace-service/cart/pkg/httpclient"
 	"github.com/BruteMors/marketplace-service/cart/pkg/lomsservice"
 	"github.com/BruteMors/marketplace-service/cart/pkg/productservice"
+	productCache "github.com/BruteMors/marketplace-service/cart/pkg/productservice/cache"
 	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type serviceProvider struct {
 	httpConfig                *config.HTTPServerConfig
 	httpClient                *httpclient.HttpClient
+	circuitBreakerConfig      *config.CircuitBreakerConfig
+	productServiceConfig      *config.ProductServiceConfig
 	productService            *productservice.ProductService
+	productCache              *productCache.Cache
 	cartHttpApi               *cart.HttpApi
 	cartService               *cartService.Service
-	cartRepository            *cartRepository.Repository
-	cartRepositoryWithMetrics *cartRepository.RepositoryWithMetrics
+	cartRepositoryConfig      *config.CartRepositoryConfig
+	pgConfig                  *config.PGConfig
+	dbPool                    *pgxpool.Pool
+	cartRepository            cartService.CartRepository
+	cartRepositoryWithMetrics *cartRepositoryWithMetrics.RepositoryWithMetrics
 	lomsService               *lomsservice.Client
 	validator                 *validator.Validate
 }
@@ -67,29 +79,99 @@ func (s *serviceProvider) HTTPClient(_ context.Context) *httpclient.HttpClient {
 			log.Fatalf("failed to parse http client retry delay: %s", err.Error())
 		}
 
-		s.httpClient = httpclient.New(timeout, retries, retryDelay)
+		retryMaxDelay, err := time.ParseDuration(cfg.RetryMaxDelay)
+		if err != nil {
+			log.Fatalf("failed to parse http client retry max delay: %s", err.Error())
+		}
+
+		retryBudget, err := time.ParseDuration(cfg.RetryBudget)
+		if err != nil {
+			log.Fatalf("failed to parse http client retry budget: %s", err.Error())
+		}
+
+		policy := httpclient.RetryPolicy{
+			MaxRetries: retries,
+			BaseDelay:  retryDelay,
+			MaxDelay:   retryMaxDelay,
+			Budget:     retryBudget,
+		}
+
+		productServiceCfg := s.ProductServiceConfig()
+
+		s.httpClient = httpclient.New(
+			timeout,
+			policy,
+			s.newCircuitBreaker("product_service"),
+			productservice.NewLimiter(productServiceCfg.GetProductRPSLimit, productServiceCfg.RPSBurst),
+		)
 	}
 
 	return s.httpClient
 }
 
-func (s *serviceProvider) ProductService(_ context.Context) *productservice.ProductService {
-	if s.productService == nil {
+func (s *serviceProvider) CircuitBreakerConfig() *config.CircuitBreakerConfig {
+	if s.circuitBreakerConfig == nil {
+		cfg, err := config.NewCircuitBreakerConfig()
+		if err != nil {
+			log.Fatalf("failed to get circuit breaker config: %s", err.Error())
+		}
+
+		s.circuitBreakerConfig = cfg
+	}
+
+	return s.circuitBreakerConfig
+}
+
+func (s *serviceProvider) newCircuitBreaker(name string) *circuitbreaker.Breaker {
+	cfg := s.CircuitBreakerConfig()
+
+	return circuitbreaker.New(name, cfg.FailureThreshold, cfg.OpenTimeout, cfg.HalfOpenRequests)
+}
+
+func (s *serviceProvider) ProductServiceConfig() *config.ProductServiceConfig {
+	if s.productServiceConfig == nil {
 		cfg, err := config.NewProductServiceConfig()
 		if err != nil {
 			log.Fatalf("failed to get product service config: %s", err.Error())
 		}
 
+		s.productServiceConfig = cfg
+	}
+
+	return s.productServiceConfig
+}
+
+func (s *serviceProvider) ProductService(_ context.Context) *productservice.ProductService {
+	if s.productService == nil {
+		cfg := s.ProductServiceConfig()
+
 		s.productService = productservice.New(
 			s.HTTPClient(context.Background()),
 			cfg.Address,
 			cfg.Token,
-			cfg.GetProductRPSLimit,
+			s.ProductCache(context.Background()),
 		)
 	}
 	return s.productService
 }
 
+func (s *serviceProvider) ProductCache(_ context.Context) *productCache.Cache {
+	if s.productCache == nil {
+		cfg, err := config.NewProductCacheConfig()
+		if err != nil {
+			log.Fatalf("failed to get product cache config: %s", err.Error())
+		}
+
+		if !cfg.Enabled {
+			return nil
+		}
+
+		s.productCache = productCache.New(cfg.Size, cfg.TTL, cfg.NegativeTTL, cfg.LoadTimeout)
+	}
+
+	return s.productCache
+}
+
 func (s *serviceProvider) Validator(_ context.Context) *validator.Validate {
 	if s.validator == nil {
 		s.validator = validator.New(validator.WithRequiredStructEnabled())
@@ -98,17 +180,74 @@ func (s *serviceProvider) Validator(_ context.Context) *validator.Validate {
 	return s.validator
 }
 
-func (s *serviceProvider) CartRepository(_ context.Context) *cartRepository.Repository {
+func (s *serviceProvider) CartRepositoryConfig() *config.CartRepositoryConfig {
+	if s.cartRepositoryConfig == nil {
+		cfg, err := config.NewCartRepositoryConfig()
+		if err != nil {
+			log.Fatalf("failed to get cart repository config: %s", err.Error())
+		}
+
+		s.cartRepositoryConfig = cfg
+	}
+
+	return s.cartRepositoryConfig
+}
+
+func (s *serviceProvider) PGConfig() *config.PGConfig {
+	if s.pgConfig == nil {
+		cfg, err := config.NewPGConfig()
+		if err != nil {
+			log.Fatalf("failed to get pg config: %s", err.Error())
+		}
+
+		s.pgConfig = cfg
+	}
+
+	return s.pgConfig
+}
+
+func (s *serviceProvider) DBPool(ctx context.Context) *pgxpool.Pool {
+	if s.dbPool == nil {
+		pool, err := pgxpool.New(ctx, s.PGConfig().DSN())
+		if err != nil {
+			log.Fatalf("failed to create db pool: %s", err.Error())
+		}
+
+		err = pool.Ping(ctx)
+		if err != nil {
+			log.Fatalf("ping error: %s", err.Error())
+		}
+
+		closer.Add(func() error {
+			pool.Close()
+			return nil
+		})
+
+		s.dbPool = pool
+	}
+
+	return s.dbPool
+}
+
+func (s *serviceProvider) CartRepository(ctx context.Context) cartService.CartRepository {
 	if s.cartRepository == nil {
-		s.cartRepository = cartRepository.NewRepository()
+		switch s.CartRepositoryConfig().Type() {
+		case config.RepositoryTypePostgres:
+			s.cartRepository = postgresCartRepository.NewRepository(s.DBPool(ctx))
+		default:
+			s.cartRepository = inMemoryCartRepository.NewShardedRepository(s.CartRepositoryConfig().Shards())
+		}
 	}
 
 	return s.cartRepository
 }
 
-func (s *serviceProvider) CartRepositoryWithMetrics(ctx context.Context) *cartRepository.RepositoryWithMetrics {
+func (s *serviceProvider) CartRepositoryWithMetrics(ctx context.Context) *cartRepositoryWithMetrics.RepositoryWithMetrics {
 	if s.cartRepositoryWithMetrics == nil {
-		s.cartRepositoryWithMetrics = cartRepository.NewRepositoryWithMetrics(s.CartRepository(ctx))
+		s.cartRepositoryWithMetrics = cartRepositoryWithMetrics.NewRepositoryWithMetrics(
+			s.CartRepository(ctx),
+			s.CartRepositoryConfig().Type() == config.RepositoryTypeInMemory,
+		)
 	}
 
 	return s.cartRepositoryWithMetrics
@@ -139,7 +278,7 @@ func (s *serviceProvider) CartHttpApi(ctx context.Context) *cart.HttpApi {
 
 func (s *serviceProvider) LomsService(_ context.Context) *lomsservice.Client {
 	if s.lomsService == nil {
-		client, err := lomsservice.NewClient()
+		client, err := lomsservice.NewClient(s.newCircuitBreaker("loms"))
 		if err != nil {
 			log.Fatalf("failed to get loms service client: %s", err.Error())
 		}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	circuitBreakerFailureThresholdEnvName = "CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	circuitBreakerOpenTimeoutEnvName      = "CIRCUIT_BREAKER_OPEN_TIMEOUT"
+	circuitBreakerHalfOpenRequestsEnvName = "CIRCUIT_BREAKER_HALF_OPEN_REQUESTS"
+)
+
+type CircuitBreakerConfig struct {
+	FailureThreshold int
+	OpenTimeout      time.Duration
+	HalfOpenRequests int
+}
+
+func NewCircuitBreakerConfig() (*CircuitBreakerConfig, error) {
+	failureThreshold := os.Getenv(circuitBreakerFailureThresholdEnvName)
+	if failureThreshold == "" {
+		return nil, errors.New("circuit breaker failure threshold is not set")
+	}
+
+	threshold, err := strconv.Atoi(failureThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	openTimeout, err := time.ParseDuration(os.Getenv(circuitBreakerOpenTimeoutEnvName))
+	if err != nil {
+		return nil, errors.New("circuit breaker open timeout is not set")
+	}
+
+	halfOpenRequests := os.Getenv(circuitBreakerHalfOpenRequestsEnvName)
+	if halfOpenRequests == "" {
+		return nil, errors.New("circuit breaker half-open requests is not set")
+	}
+
+	requests, err := strconv.Atoi(halfOpenRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CircuitBreakerConfig{
+		FailureThreshold: threshold,
+		OpenTimeout:      openTimeout,
+		HalfOpenRequests: requests,
+	}, nil
+}
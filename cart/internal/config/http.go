@@ -7,11 +7,13 @@ import (
 )
 
 const (
-	httpHostEnvName             = "HTTP_HOST"
-	httpPortEnvName             = "HTTP_PORT"
-	httpClintTimeoutEnvName     = "HTTP_CLIENT_TIMEOUT"
-	httpClientRetriesEnvName    = "HTTP_CLIENT_RETRIES"
-	httpClientRetryDelayEnvName = "HTTP_CLIENT_RETRY_DELAY"
+	httpHostEnvName                = "HTTP_HOST"
+	httpPortEnvName                = "HTTP_PORT"
+	httpClintTimeoutEnvName        = "HTTP_CLIENT_TIMEOUT"
+	httpClientRetriesEnvName       = "HTTP_CLIENT_RETRIES"
+	httpClientRetryDelayEnvName    = "HTTP_CLIENT_RETRY_DELAY"
+	httpClientRetryMaxDelayEnvName = "HTTP_CLIENT_RETRY_MAX_DELAY"
+	httpClientRetryBudgetEnvName   = "HTTP_CLIENT_RETRY_BUDGET"
 )
 
 type HTTPServerConfig struct {
@@ -41,9 +43,11 @@ func (cfg *HTTPServerConfig) Address() string {
 }
 
 type HTTPClientConfig struct {
-	Timeout    string
-	Retries    string
-	RetryDelay string
+	Timeout       string
+	Retries       string
+	RetryDelay    string
+	RetryMaxDelay string
+	RetryBudget   string
 }
 
 func NewHTTPClientConfig() (*HTTPClientConfig, error) {
@@ -62,9 +66,21 @@ func NewHTTPClientConfig() (*HTTPClientConfig, error) {
 		return nil, errors.New("http client retry delay not found")
 	}
 
+	retryMaxDelay := os.Getenv(httpClientRetryMaxDelayEnvName)
+	if len(retryMaxDelay) == 0 {
+		return nil, errors.New("http client retry max delay not found")
+	}
+
+	retryBudget := os.Getenv(httpClientRetryBudgetEnvName)
+	if len(retryBudget) == 0 {
+		return nil, errors.New("http client retry budget not found")
+	}
+
 	return &HTTPClientConfig{
-		Timeout:    timeout,
-		RetryDelay: retryDelay,
-		Retries:    retries,
+		Timeout:       timeout,
+		RetryDelay:    retryDelay,
+		Retries:       retries,
+		RetryMaxDelay: retryMaxDelay,
+		RetryBudget:   retryBudget,
 	}, nil
 }
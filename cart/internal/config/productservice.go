@@ -4,18 +4,28 @@ import (
 	"errors"
 	"os"
 	"strconv"
+	"time"
 )
 
 const (
 	productServiceAddressEnvName            = "PRODUCT_SERVICE_ADDRESS"
 	productServiceTokenEnvName              = "PRODUCT_SERVICE_TOKEN"
 	productServiceGetProductRPSLimitEnvName = "PRODUCT_SERVICE_GET_PRODUCT_RPS_LIMIT"
+	productServiceRPSBurstEnvName           = "PRODUCT_SERVICE_RPS_BURST"
+	productCacheEnabledEnvName              = "PRODUCT_CACHE_ENABLED"
+	productCacheSizeEnvName                 = "PRODUCT_CACHE_SIZE"
+	productCacheTTLEnvName                  = "PRODUCT_CACHE_TTL"
+	productCacheNegativeTTLEnvName          = "PRODUCT_CACHE_NEGATIVE_TTL"
+	productCacheLoadTimeoutEnvName          = "PRODUCT_CACHE_LOAD_TIMEOUT"
+
+	defaultProductCacheLoadTimeout = 5 * time.Second
 )
 
 type ProductServiceConfig struct {
 	Address            string
 	Token              string
 	GetProductRPSLimit int
+	RPSBurst           int
 }
 
 func NewProductServiceConfig() (*ProductServiceConfig, error) {
@@ -39,9 +49,80 @@ func NewProductServiceConfig() (*ProductServiceConfig, error) {
 		return nil, err
 	}
 
+	rpsBurst := os.Getenv(productServiceRPSBurstEnvName)
+	if rpsBurst == "" {
+		return nil, errors.New("product service rps burst is not set")
+	}
+
+	burst, err := strconv.Atoi(rpsBurst)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ProductServiceConfig{
 		Address:            address,
 		Token:              token,
 		GetProductRPSLimit: limit,
+		RPSBurst:           burst,
+	}, nil
+}
+
+type ProductCacheConfig struct {
+	Enabled     bool
+	Size        int
+	TTL         time.Duration
+	NegativeTTL time.Duration
+	LoadTimeout time.Duration
+}
+
+func NewProductCacheConfig() (*ProductCacheConfig, error) {
+	enabled := os.Getenv(productCacheEnabledEnvName)
+	if enabled == "" {
+		return nil, errors.New("product cache enabled flag is not set")
+	}
+
+	isEnabled, err := strconv.ParseBool(enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isEnabled {
+		return &ProductCacheConfig{}, nil
+	}
+
+	size := os.Getenv(productCacheSizeEnvName)
+	if size == "" {
+		return nil, errors.New("product cache size is not set")
+	}
+
+	cacheSize, err := strconv.Atoi(size)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := time.ParseDuration(os.Getenv(productCacheTTLEnvName))
+	if err != nil {
+		return nil, errors.New("product cache ttl is not set")
+	}
+
+	negativeTTL, err := time.ParseDuration(os.Getenv(productCacheNegativeTTLEnvName))
+	if err != nil {
+		return nil, errors.New("product cache negative ttl is not set")
+	}
+
+	loadTimeout := defaultProductCacheLoadTimeout
+	if raw := os.Getenv(productCacheLoadTimeoutEnvName); len(raw) != 0 {
+		loadTimeout, err = time.ParseDuration(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ProductCacheConfig{
+		Enabled:     true,
+		Size:        cacheSize,
+		TTL:         ttl,
+		NegativeTTL: negativeTTL,
+		LoadTimeout: loadTimeout,
 	}, nil
 }
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	cartRepositoryTypeEnvName   = "CART_REPOSITORY_TYPE"
+	cartRepositoryShardsEnvName = "CART_REPOSITORY_SHARDS"
+	pgDSNEnvName                = "PG_DSN"
+
+	defaultCartRepositoryShards = 32
+)
+
+type RepositoryType string
+
+const (
+	RepositoryTypeInMemory RepositoryType = "inmemory"
+	RepositoryTypePostgres RepositoryType = "postgres"
+)
+
+type CartRepositoryConfig struct {
+	repositoryType RepositoryType
+	shards         int
+}
+
+// NewCartRepositoryConfig reads the cart repository settings. The in-memory repository
+// with defaultCartRepositoryShards shards is used when they are not set.
+func NewCartRepositoryConfig() (*CartRepositoryConfig, error) {
+	repositoryType := RepositoryType(os.Getenv(cartRepositoryTypeEnvName))
+
+	switch repositoryType {
+	case "":
+		repositoryType = RepositoryTypeInMemory
+	case RepositoryTypeInMemory, RepositoryTypePostgres:
+	default:
+		return nil, fmt.Errorf("unknown cart repository type: %s", repositoryType)
+	}
+
+	cfg := &CartRepositoryConfig{
+		repositoryType: repositoryType,
+	}
+
+	if repositoryType == RepositoryTypeInMemory {
+		cfg.shards = defaultCartRepositoryShards
+
+		if shards := os.Getenv(cartRepositoryShardsEnvName); len(shards) != 0 {
+			shardCount, err := strconv.Atoi(shards)
+			if err != nil {
+				return nil, err
+			}
+
+			if shardCount < 1 {
+				return nil, fmt.Errorf("invalid cart repository shards: %d", shardCount)
+			}
+
+			cfg.shards = shardCount
+		}
+	}
+
+	return cfg, nil
+}
+
+func (cfg *CartRepositoryConfig) Type() RepositoryType {
+	return cfg.repositoryType
+}
+
+// Shards is the shard count of the in-memory repository, it is zero for other types.
+func (cfg *CartRepositoryConfig) Shards() int {
+	return cfg.shards
+}
+
+type PGConfig struct {
+	dsn string
+}
+
+func NewPGConfig() (*PGConfig, error) {
+	dsn := os.Getenv(pgDSNEnvName)
+	if dsn == "" {
+		return nil, errors.New("pg dsn is not set")
+	}
+
+	return &PGConfig{
+		dsn: dsn,
+	}, nil
+}
+
+func (cfg *PGConfig) DSN() string {
+	return cfg.dsn
+}
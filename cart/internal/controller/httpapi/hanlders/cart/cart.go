@@ -12,7 +12,8 @@ type Service interface {
 	DeleteItem(ctx context.Context, userID int64, skuID int64) error
 	DeleteItemsByUserID(ctx context.Context, userID int64) error
 	GetCart(ctx context.Context, userID int64) (*models.Cart, error)
-	Checkout(ctx context.Context, userID int64) (orderID int64, err error)
+	Checkout(ctx context.Context, userID int64, idempotencyKey string) (orderID int64, err error)
+	OrdersList(ctx context.Context, userID int64, filter models.OrdersFilter) (*models.OrdersPage, error)
 }
 
 type HttpApi struct {
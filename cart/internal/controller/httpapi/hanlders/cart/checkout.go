@@ -31,12 +31,14 @@ func (h *HttpApi) Checkout(writer http.ResponseWriter, request *http.Request) (e
 	}
 	span.SetAttributes(attribute.Int64("userID", req.UserID))
 
+	req.IdempotencyKey = request.Header.Get("Idempotency-Key")
+
 	err = h.validator.Struct(req)
 	if err != nil {
 		return httpapi.ErrValidation
 	}
 
-	orderID, err := h.cartService.Checkout(ctx, req.UserID)
+	orderID, err := h.cartService.Checkout(ctx, req.UserID, req.IdempotencyKey)
 	if err != nil {
 		return err
 	}
@@ -51,10 +51,12 @@ func (h *HttpApi) GetCart(writer http.ResponseWriter, request *http.Request) (er
 
 	for _, item := range cart.Items {
 		items = append(items, responses.Item{
-			SkuID: item.SkuID,
-			Name:  item.Name,
-			Count: item.Count,
-			Price: item.Price,
+			SkuID:             item.SkuID,
+			Name:              item.Name,
+			Count:             item.Count,
+			Price:             item.Price,
+			Available:         item.Available,
+			InsufficientStock: item.InsufficientStock,
 		})
 	}
 
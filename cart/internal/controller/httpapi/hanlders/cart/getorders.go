@@ -0,0 +1,133 @@
+package cart
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/controller/httpapi"
+	"github.com/BruteMors/marketplace-service/cart/internal/controller/httpapi/hanlders/cart/requests"
+	"github.com/BruteMors/marketplace-service/cart/internal/controller/httpapi/hanlders/cart/responses"
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (h *HttpApi) GetOrders(writer http.ResponseWriter, request *http.Request) (err error) {
+	tr := otel.Tracer("httpApi")
+	ctx, span := tr.Start(request.Context(), "GetOrders")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	var req requests.GetOrders
+
+	req.UserID, err = strconv.ParseInt(request.PathValue("user_id"), 10, 64)
+	if err != nil {
+		return err
+	}
+	span.SetAttributes(attribute.Int64("userID", req.UserID))
+
+	err = parseGetOrdersQuery(request.URL.Query(), &req)
+	if err != nil {
+		return httpapi.ErrValidation
+	}
+
+	err = h.validator.Struct(req)
+	if err != nil {
+		return httpapi.ErrValidation
+	}
+
+	if req.CreatedFrom != nil && req.CreatedTo != nil && !req.CreatedFrom.Before(*req.CreatedTo) {
+		return httpapi.ErrValidation
+	}
+
+	page, err := h.cartService.OrdersList(ctx, req.UserID, models.OrdersFilter{
+		Statuses:    req.Statuses,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Cursor:      req.Cursor,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidOrdersFilter) {
+			return httpapi.ErrValidation
+		}
+		return err
+	}
+
+	orders := make([]responses.Order, 0, len(page.Orders))
+
+	for _, order := range page.Orders {
+		items := make([]responses.OrderItem, 0, len(order.Items))
+		for _, item := range order.Items {
+			items = append(items, responses.OrderItem{
+				SkuID: item.SkuID,
+				Count: item.Count,
+			})
+		}
+
+		orders = append(orders, responses.Order{
+			OrderID:   order.ID,
+			Status:    order.Status,
+			Items:     items,
+			CreatedAt: order.CreatedAt,
+			UpdatedAt: order.UpdatedAt,
+		})
+	}
+
+	response := responses.GetOrders{
+		Orders:     orders,
+		NextCursor: page.NextCursor,
+	}
+
+	responseBuf, err := json.Marshal(&response)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(responseBuf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func parseGetOrdersQuery(query url.Values, req *requests.GetOrders) error {
+	req.Statuses = query["status"]
+	req.Cursor = query.Get("cursor")
+
+	if from := query.Get("from"); from != "" {
+		createdFrom, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return err
+		}
+		req.CreatedFrom = &createdFrom
+	}
+
+	if to := query.Get("to"); to != "" {
+		createdTo, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return err
+		}
+		req.CreatedTo = &createdTo
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		value, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return err
+		}
+		req.Limit = uint32(value)
+	}
+
+	return nil
+}
@@ -1,5 +1,6 @@
 package requests
 
 type Checkout struct {
-	UserID int64 `json:"-" validate:"required,gt=0"`
+	UserID         int64  `json:"-" validate:"required,gt=0"`
+	IdempotencyKey string `json:"-" validate:"max=128"`
 }
@@ -0,0 +1,12 @@
+package requests
+
+import "time"
+
+type GetOrders struct {
+	UserID      int64      `json:"-" validate:"required,gt=0"`
+	Statuses    []string   `json:"-" validate:"dive,oneof=new awaiting_payment failed payed cancelled"`
+	CreatedFrom *time.Time `json:"-"`
+	CreatedTo   *time.Time `json:"-"`
+	Cursor      string     `json:"-"`
+	Limit       uint32     `json:"-" validate:"lte=100"`
+}
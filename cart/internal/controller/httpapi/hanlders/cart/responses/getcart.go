@@ -6,8 +6,10 @@ type GetCart struct {
 }
 
 type Item struct {
-	SkuID int64  `json:"sku_id"`
-	Name  string `json:"name"`
-	Count uint16 `json:"count"`
-	Price uint32 `json:"price"`
+	SkuID             int64   `json:"sku_id"`
+	Name              string  `json:"name"`
+	Count             uint16  `json:"count"`
+	Price             uint32  `json:"price"`
+	Available         *uint64 `json:"available,omitempty"`
+	InsufficientStock bool    `json:"insufficient_stock"`
 }
@@ -0,0 +1,21 @@
+package responses
+
+import "time"
+
+type GetOrders struct {
+	Orders     []Order `json:"orders"`
+	NextCursor string  `json:"next_cursor,omitempty"`
+}
+
+type Order struct {
+	OrderID   int64       `json:"order_id"`
+	Status    string      `json:"status"`
+	Items     []OrderItem `json:"items"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+type OrderItem struct {
+	SkuID int64  `json:"sku_id"`
+	Count uint16 `json:"count"`
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/BruteMors/marketplace-service/cart/internal/controller/httpapi"
 	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	"github.com/BruteMors/marketplace-service/cart/pkg/circuitbreaker"
 )
 
 type ErrorWrapper func(writer http.ResponseWriter, request *http.Request) error
@@ -15,6 +16,12 @@ type ErrorWrapper func(writer http.ResponseWriter, request *http.Request) error
 func (s ErrorWrapper) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	if err := s(writer, request); err != nil {
 		ctx := request.Context()
+
+		if errors.As(err, &circuitbreaker.Error{}) {
+			writeError(writer, http.StatusServiceUnavailable, err)
+			return
+		}
+
 		if !errors.As(err, &httpapi.Error{}) && !errors.As(err, &models.Error{}) {
 			writer.WriteHeader(http.StatusInternalServerError)
 			slog.LogAttrs(
@@ -29,19 +36,23 @@ func (s ErrorWrapper) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 			return
 		}
 
-		writer.WriteHeader(http.StatusBadRequest)
+		writeError(writer, http.StatusBadRequest, err)
+	}
+}
+
+func writeError(writer http.ResponseWriter, statusCode int, err error) {
+	writer.WriteHeader(statusCode)
 
-		var errorHandler httpapi.Error
-		errorHandler.Message = err.Error()
+	var errorHandler httpapi.Error
+	errorHandler.Message = err.Error()
 
-		buf, err := json.Marshal(errorHandler)
-		if err != nil {
-			return
-		}
+	buf, err := json.Marshal(errorHandler)
+	if err != nil {
+		return
+	}
 
-		_, err = writer.Write(buf)
-		if err != nil {
-			return
-		}
+	_, err = writer.Write(buf)
+	if err != nil {
+		return
 	}
 }
@@ -23,6 +23,10 @@ type Metrics struct {
 	dbRequestCounter              *prometheus.CounterVec
 	dbHistogramResponseTime       *prometheus.HistogramVec
 	inMemoryObjectCount           prometheus.Gauge
+	productCacheCounter           *prometheus.CounterVec
+	productCacheSize              prometheus.Gauge
+	rateLimiterWaitTime           *prometheus.HistogramVec
+	circuitBreakerState           *prometheus.GaugeVec
 }
 
 var metrics *Metrics
@@ -112,6 +116,42 @@ func Init(_ context.Context) error {
 				Help:      "Количество объектов в in-memory репозитории",
 			},
 		),
+		productCacheCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "cache",
+				Name:      appName + "_product_cache_requests_total",
+				Help:      "Количество обращений к кэшу товаров",
+			},
+			[]string{"result"},
+		),
+		productCacheSize: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "cache",
+				Name:      appName + "_product_cache_size",
+				Help:      "Количество записей в кэше товаров",
+			},
+		),
+		rateLimiterWaitTime: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "external",
+				Name:      appName + "_rate_limiter_wait_seconds",
+				Help:      "Время ожидания разрешения rate limiter перед запросом к внешним ресурсам",
+				Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+			},
+			[]string{"client"},
+		),
+		circuitBreakerState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "external",
+				Name:      appName + "_circuit_breaker_state",
+				Help:      "Состояние circuit breaker внешних ресурсов (0 - closed, 1 - open, 2 - half-open)",
+			},
+			[]string{"name"},
+		),
 	}
 
 	return nil
@@ -152,3 +192,19 @@ func AddInMemoryObjectCount(num float64) {
 func SubInMemoryObjectCount(num float64) {
 	metrics.inMemoryObjectCount.Sub(num)
 }
+
+func IncProductCacheCounter(result string) {
+	metrics.productCacheCounter.WithLabelValues(result).Inc()
+}
+
+func SetProductCacheSize(num float64) {
+	metrics.productCacheSize.Set(num)
+}
+
+func ObserveRateLimiterWaitTime(client string, time float64) {
+	metrics.rateLimiterWaitTime.WithLabelValues(client).Observe(time)
+}
+
+func SetCircuitBreakerState(name string, state float64) {
+	metrics.circuitBreakerState.WithLabelValues(name).Set(state)
+}
@@ -15,7 +15,8 @@ func NewError(message string) Error {
 }
 
 var (
-	ErrProductNotFound = NewError("product not found")
-	ErrCartNotFound    = NewError("cart not found")
-	ErrStocksNotEnough = NewError("stocks not enough")
+	ErrProductNotFound     = NewError("product not found")
+	ErrCartNotFound        = NewError("cart not found")
+	ErrStocksNotEnough     = NewError("stocks not enough")
+	ErrInvalidOrdersFilter = NewError("invalid orders filter")
 )
@@ -3,6 +3,9 @@ package models
 type Item struct {
 	Name  string
 	Price uint32
+	// Available is nil when the stock is unknown.
+	Available         *uint64
+	InsufficientStock bool
 	ItemCount
 }
 
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type OrdersFilter struct {
+	Statuses    []string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Cursor      string
+	Limit       uint32
+}
+
+type OrdersPage struct {
+	Orders     []Order
+	NextCursor string
+}
+
+type Order struct {
+	ID        int64
+	Status    string
+	Items     []ItemCount
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
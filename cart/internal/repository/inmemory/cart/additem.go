@@ -1,19 +1,13 @@
 package cart
 
-import (
-	"context"
-	"time"
-
-	"github.com/BruteMors/marketplace-service/cart/internal/metric"
-	"github.com/BruteMors/marketplace-service/libs/tracing"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-)
+import "context"
 
 func (r *Repository) Add(_ context.Context, userID int64, skuID int64, count uint16) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
+	delete(r.checkoutKeys, userID)
+
 	if _, ok := r.store[userID]; !ok {
 		r.store[userID] = make(map[int64]uint16)
 	}
@@ -23,32 +17,19 @@ func (r *Repository) Add(_ context.Context, userID int64, skuID int64, count uin
 	return nil
 }
 
-func (p *RepositoryWithMetrics) Add(ctx context.Context, userID int64, skuID int64, count uint16) (err error) {
-	tr := otel.Tracer("repository")
-	ctx, span := tr.Start(ctx, "Add")
-	defer func() {
-		tracing.RecordSpanError(span, err)
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.Int64("userID", userID),
-		attribute.Int64("skuID", skuID),
-		attribute.Int64("count", int64(count)),
-	)
-
-	start := time.Now()
-	err = p.repo.Add(ctx, userID, skuID, count)
-	duration := time.Since(start).Seconds()
-
-	status := "success"
-	if err != nil {
-		status = "error"
+func (r *ShardedRepository) Add(_ context.Context, userID int64, skuID int64, count uint16) error {
+	s := r.shard(userID)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.checkoutKeys, userID)
+
+	if _, ok := s.store[userID]; !ok {
+		s.store[userID] = make(map[int64]uint16)
 	}
 
-	metric.IncDBRequestCounter("add", status)
-	metric.ObserveDBResponseTime("add", status, duration)
-	metric.AddInMemoryObjectCount(1)
+	s.store[userID][skuID] += count
 
-	return err
+	return nil
 }
@@ -2,6 +2,7 @@ package cart
 
 import (
 	"context"
+	"math/rand"
 	"sync"
 	"testing"
 
@@ -96,6 +97,46 @@ func BenchmarkAdd(b *testing.B) {
 	}
 }
 
+// The parallel benchmarks spread the calls over benchmarkUsers carts,
+// run them with -cpu 1,2,4,8 to compare the contention.
+const benchmarkUsers = 1024
+
+func BenchmarkAddParallel(b *testing.B) {
+	ctx := context.Background()
+
+	repo := NewRepository()
+
+	skuID := int64(100)
+	count := uint16(1)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		userID := rand.Int63n(benchmarkUsers)
+		for pb.Next() {
+			_ = repo.Add(ctx, userID%benchmarkUsers, skuID, count)
+			userID++
+		}
+	})
+}
+
+func BenchmarkShardedAddParallel(b *testing.B) {
+	ctx := context.Background()
+
+	repo := NewShardedRepository(16)
+
+	skuID := int64(100)
+	count := uint16(1)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		userID := rand.Int63n(benchmarkUsers)
+		for pb.Next() {
+			_ = repo.Add(ctx, userID%benchmarkUsers, skuID, count)
+			userID++
+		}
+	})
+}
+
 func TestRepositoryCartAddConcurrent(t *testing.T) {
 	ctx := context.Background()
 	repo := &Repository{
@@ -122,3 +163,73 @@ func TestRepositoryCartAddConcurrent(t *testing.T) {
 	expectedCount := uint16(numRoutines)
 	assert.Equal(t, expectedCount, repo.store[userID][skuID])
 }
+
+func TestShardedRepositoryCartAdd(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        int64
+		skuID         int64
+		count         uint16
+		store         map[int64]map[int64]uint16
+		expectedStore map[int64]map[int64]uint16
+	}{
+		{
+			name:          "add new item to new user",
+			userID:        1,
+			skuID:         100,
+			count:         2,
+			store:         map[int64]map[int64]uint16{},
+			expectedStore: map[int64]map[int64]uint16{1: {100: 2}},
+		},
+		{
+			name:          "add existing item to user in another shard",
+			userID:        5,
+			skuID:         100,
+			count:         3,
+			store:         map[int64]map[int64]uint16{4: {100: 1}, 5: {100: 2}},
+			expectedStore: map[int64]map[int64]uint16{4: {100: 1}, 5: {100: 5}},
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newShardedRepositoryWithStore(4, tt.store)
+
+			err := repo.Add(ctx, tt.userID, tt.skuID, tt.count)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStore, repo.snapshot())
+		})
+	}
+}
+
+func TestShardedRepositoryCartAddConcurrent(t *testing.T) {
+	ctx := context.Background()
+	repo := NewShardedRepository(8)
+
+	var wg sync.WaitGroup
+	numUsers := 20
+	numRoutines := 100
+	skuID := int64(100)
+	count := uint16(1)
+
+	for userID := 0; userID < numUsers; userID++ {
+		for i := 0; i < numRoutines; i++ {
+			wg.Add(1)
+			go func(userID int64) {
+				defer wg.Done()
+				err := repo.Add(ctx, userID, skuID, count)
+				assert.NoError(t, err)
+			}(int64(userID))
+		}
+	}
+
+	wg.Wait()
+	for userID := 0; userID < numUsers; userID++ {
+		assert.Equal(t, uint16(numRoutines), repo.shard(int64(userID)).store[int64(userID)][skuID])
+	}
+}
@@ -3,20 +3,48 @@ package cart
 import "sync"
 
 type Repository struct {
-	mutex sync.Mutex
-	store map[int64]map[int64]uint16
+	mutex        sync.Mutex
+	store        map[int64]map[int64]uint16
+	checkoutKeys map[int64]string
 }
 
 func NewRepository() *Repository {
 	return &Repository{
-		store: make(map[int64]map[int64]uint16),
+		store:        make(map[int64]map[int64]uint16),
+		checkoutKeys: make(map[int64]string),
 	}
 }
 
-type RepositoryWithMetrics struct {
-	repo *Repository
+type shard struct {
+	mutex        sync.RWMutex
+	store        map[int64]map[int64]uint16
+	checkoutKeys map[int64]string
 }
 
-func NewRepositoryWithMetrics(repo *Repository) *RepositoryWithMetrics {
-	return &RepositoryWithMetrics{repo: repo}
+// ShardedRepository spreads carts over independent shards by userID,
+// so requests of different users rarely contend for the same lock.
+type ShardedRepository struct {
+	shards []*shard
+}
+
+func NewShardedRepository(shardCount int) *ShardedRepository {
+	if shardCount < 1 {
+		shardCount = 1
+	}
+
+	shards := make([]*shard, shardCount)
+	for i := range shards {
+		shards[i] = &shard{
+			store:        make(map[int64]map[int64]uint16),
+			checkoutKeys: make(map[int64]string),
+		}
+	}
+
+	return &ShardedRepository{
+		shards: shards,
+	}
+}
+
+func (r *ShardedRepository) shard(userID int64) *shard {
+	return r.shards[uint64(userID)%uint64(len(r.shards))]
 }
@@ -0,0 +1,46 @@
+package cart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newShardedRepositoryWithStore(shardCount int, store map[int64]map[int64]uint16) *ShardedRepository {
+	repo := NewShardedRepository(shardCount)
+	for userID, items := range store {
+		repo.shard(userID).store[userID] = items
+	}
+
+	return repo
+}
+
+func (r *ShardedRepository) snapshot() map[int64]map[int64]uint16 {
+	store := make(map[int64]map[int64]uint16)
+	for _, s := range r.shards {
+		for userID, items := range s.store {
+			store[userID] = items
+		}
+	}
+
+	return store
+}
+
+func TestNewShardedRepository(t *testing.T) {
+	tests := []struct {
+		name          string
+		shardCount    int
+		expectedCount int
+	}{
+		{name: "positive shard count", shardCount: 16, expectedCount: 16},
+		{name: "zero shard count", shardCount: 0, expectedCount: 1},
+		{name: "negative shard count", shardCount: -5, expectedCount: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewShardedRepository(tt.shardCount)
+			assert.Len(t, repo.shards, tt.expectedCount)
+		})
+	}
+}
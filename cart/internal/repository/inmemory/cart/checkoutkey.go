@@ -0,0 +1,54 @@
+package cart
+
+import "context"
+
+func (r *Repository) CheckoutKey(_ context.Context, userID int64, newKey string) (string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if key, ok := r.checkoutKeys[userID]; ok {
+		return key, nil
+	}
+
+	if r.checkoutKeys == nil {
+		r.checkoutKeys = make(map[int64]string)
+	}
+	r.checkoutKeys[userID] = newKey
+
+	return newKey, nil
+}
+
+func (r *ShardedRepository) CheckoutKey(_ context.Context, userID int64, newKey string) (string, error) {
+	s := r.shard(userID)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if key, ok := s.checkoutKeys[userID]; ok {
+		return key, nil
+	}
+
+	s.checkoutKeys[userID] = newKey
+
+	return newKey, nil
+}
+
+func (r *Repository) DropCheckoutKey(_ context.Context, userID int64) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.checkoutKeys, userID)
+
+	return nil
+}
+
+func (r *ShardedRepository) DropCheckoutKey(_ context.Context, userID int64) error {
+	s := r.shard(userID)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.checkoutKeys, userID)
+
+	return nil
+}
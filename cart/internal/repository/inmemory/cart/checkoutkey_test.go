@@ -0,0 +1,79 @@
+package cart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryCheckoutKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		change      func(ctx context.Context, repo *ShardedRepository) error
+		expectedKey string
+	}{
+		{
+			name:        "unchanged cart keeps the key",
+			change:      func(context.Context, *ShardedRepository) error { return nil },
+			expectedKey: "first",
+		},
+		{
+			name: "added item drops the key",
+			change: func(ctx context.Context, repo *ShardedRepository) error {
+				return repo.Add(ctx, 1, 101, 1)
+			},
+			expectedKey: "second",
+		},
+		{
+			name: "deleted item drops the key",
+			change: func(ctx context.Context, repo *ShardedRepository) error {
+				return repo.DeleteItem(ctx, 1, 100)
+			},
+			expectedKey: "second",
+		},
+		{
+			name: "cleared cart drops the key",
+			change: func(ctx context.Context, repo *ShardedRepository) error {
+				_, err := repo.DeleteItemsByUserID(ctx, 1)
+				return err
+			},
+			expectedKey: "second",
+		},
+		{
+			name: "dropped key is replaced",
+			change: func(ctx context.Context, repo *ShardedRepository) error {
+				return repo.DropCheckoutKey(ctx, 1)
+			},
+			expectedKey: "second",
+		},
+		{
+			name: "change of another cart keeps the key",
+			change: func(ctx context.Context, repo *ShardedRepository) error {
+				return repo.Add(ctx, 2, 100, 1)
+			},
+			expectedKey: "first",
+		},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newShardedRepositoryWithStore(4, map[int64]map[int64]uint16{
+				1: {100: 2},
+			})
+
+			key, err := repo.CheckoutKey(ctx, 1, "first")
+			require.NoError(t, err)
+			require.Equal(t, "first", key)
+
+			require.NoError(t, tt.change(ctx, repo))
+
+			key, err = repo.CheckoutKey(ctx, 1, "second")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedKey, key)
+		})
+	}
+}
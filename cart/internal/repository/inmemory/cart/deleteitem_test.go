@@ -105,3 +105,43 @@ func TestRepositoryDeleteItemConcurrent(t *testing.T) {
 	}
 	assert.Equal(t, expectedStore, repo.store)
 }
+
+func TestShardedRepositoryDeleteItem(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        int64
+		skuID         int64
+		store         map[int64]map[int64]uint16
+		expectedStore map[int64]map[int64]uint16
+	}{
+		{
+			name:          "delete existing item",
+			userID:        1,
+			skuID:         100,
+			store:         map[int64]map[int64]uint16{1: {100: 5, 101: 3}, 2: {100: 1}},
+			expectedStore: map[int64]map[int64]uint16{1: {101: 3}, 2: {100: 1}},
+		},
+		{
+			name:          "delete item from non-existing user",
+			userID:        3,
+			skuID:         100,
+			store:         map[int64]map[int64]uint16{1: {100: 5}},
+			expectedStore: map[int64]map[int64]uint16{1: {100: 5}},
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newShardedRepositoryWithStore(4, tt.store)
+
+			err := repo.DeleteItem(ctx, tt.userID, tt.skuID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStore, repo.snapshot())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package cart
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,9 +86,10 @@ func TestRepositoryDeleteItemsByUserIDConcurrent(t *testing.T) {
 	}
 
 	var wg sync.WaitGroup
+	var deleted atomic.Int64
 	numRoutines := 100
 	userID := int64(1)
-	expectedCount := 2
+	expectedCount := int64(2)
 
 	for i := 0; i < numRoutines; i++ {
 		wg.Add(1)
@@ -95,13 +97,55 @@ func TestRepositoryDeleteItemsByUserIDConcurrent(t *testing.T) {
 			defer wg.Done()
 			count, err := repo.DeleteItemsByUserID(ctx, userID)
 			assert.NoError(t, err)
-			assert.Equal(t, expectedCount, count)
+			deleted.Add(int64(count))
 		}()
 	}
 
 	wg.Wait()
+	assert.Equal(t, expectedCount, deleted.Load())
 	expectedStore := map[int64]map[int64]uint16{
 		2: {102: 2},
 	}
 	assert.Equal(t, expectedStore, repo.store)
 }
+
+func TestShardedRepositoryDeleteItemsByUserID(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        int64
+		store         map[int64]map[int64]uint16
+		expectedStore map[int64]map[int64]uint16
+		expectedCount int
+	}{
+		{
+			name:          "clear existing user cart",
+			userID:        1,
+			store:         map[int64]map[int64]uint16{1: {100: 5, 101: 3}, 2: {102: 2}},
+			expectedStore: map[int64]map[int64]uint16{2: {102: 2}},
+			expectedCount: 2,
+		},
+		{
+			name:          "clear non-existing user cart",
+			userID:        3,
+			store:         map[int64]map[int64]uint16{1: {100: 5}},
+			expectedStore: map[int64]map[int64]uint16{1: {100: 5}},
+			expectedCount: 0,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newShardedRepositoryWithStore(4, tt.store)
+
+			count, err := repo.DeleteItemsByUserID(ctx, tt.userID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStore, repo.snapshot())
+			assert.Equal(t, tt.expectedCount, count)
+		})
+	}
+}
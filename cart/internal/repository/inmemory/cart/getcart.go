@@ -2,14 +2,9 @@ package cart
 
 import (
 	"context"
-	"time"
 
-	"github.com/BruteMors/marketplace-service/cart/internal/metric"
 	"github.com/BruteMors/marketplace-service/cart/internal/models"
 	"github.com/BruteMors/marketplace-service/cart/internal/repository"
-	"github.com/BruteMors/marketplace-service/libs/tracing"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
 )
 
 func (r *Repository) GetCart(_ context.Context, userID int64) ([]models.ItemCount, error) {
@@ -36,27 +31,28 @@ func (r *Repository) GetCart(_ context.Context, userID int64) ([]models.ItemCoun
 	return items, nil
 }
 
-func (p *RepositoryWithMetrics) GetCart(ctx context.Context, userID int64) (items []models.ItemCount, err error) {
-	tr := otel.Tracer("repository")
-	ctx, span := tr.Start(ctx, "GetCart")
-	defer func() {
-		tracing.RecordSpanError(span, err)
-		span.End()
-	}()
+func (r *ShardedRepository) GetCart(_ context.Context, userID int64) ([]models.ItemCount, error) {
+	s := r.shard(userID)
 
-	span.SetAttributes(attribute.Int64("userID", userID))
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
 
-	start := time.Now()
-	items, err = p.repo.GetCart(ctx, userID)
-	duration := time.Since(start).Seconds()
+	if _, ok := s.store[userID]; !ok {
+		return nil, repository.ErrCartNotFound
+	}
 
-	status := "success"
-	if err != nil {
-		status = "error"
+	if len(s.store[userID]) == 0 {
+		return nil, repository.ErrCartEmpty
 	}
 
-	metric.IncDBRequestCounter("get_cart", status)
-	metric.ObserveDBResponseTime("get_cart", status, duration)
+	items := make([]models.ItemCount, 0, len(s.store[userID]))
+
+	for skuID, count := range s.store[userID] {
+		items = append(items, models.ItemCount{
+			SkuID: skuID,
+			Count: count,
+		})
+	}
 
-	return items, err
+	return items, nil
 }
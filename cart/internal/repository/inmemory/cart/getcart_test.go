@@ -2,6 +2,7 @@ package cart
 
 import (
 	"context"
+	"math/rand"
 	"sync"
 	"testing"
 
@@ -111,3 +112,91 @@ func TestRepositoryGetCartConcurrent(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestShardedRepositoryGetCart(t *testing.T) {
+	tests := []struct {
+		name          string
+		userID        int64
+		store         map[int64]map[int64]uint16
+		expectedItems []models.ItemCount
+		expectedError error
+	}{
+		{
+			name:   "existing user with non-empty cart",
+			userID: 1,
+			store:  map[int64]map[int64]uint16{1: {101: 3, 100: 2}, 2: {100: 1}},
+			expectedItems: []models.ItemCount{
+				{SkuID: 101, Count: 3},
+				{SkuID: 100, Count: 2},
+			},
+		},
+		{
+			name:          "existing user with empty cart",
+			userID:        1,
+			store:         map[int64]map[int64]uint16{1: {}},
+			expectedError: repository.ErrCartEmpty,
+		},
+		{
+			name:          "non-existing user",
+			userID:        2,
+			store:         map[int64]map[int64]uint16{1: {100: 2}},
+			expectedError: repository.ErrCartNotFound,
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newShardedRepositoryWithStore(4, tt.store)
+
+			items, err := repo.GetCart(ctx, tt.userID)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, items)
+			} else {
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, tt.expectedItems, items)
+			}
+		})
+	}
+}
+
+func BenchmarkGetCartParallel(b *testing.B) {
+	ctx := context.Background()
+
+	repo := NewRepository()
+	for userID := int64(0); userID < benchmarkUsers; userID++ {
+		_ = repo.Add(ctx, userID, 100, 1)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		userID := rand.Int63n(benchmarkUsers)
+		for pb.Next() {
+			_, _ = repo.GetCart(ctx, userID%benchmarkUsers)
+			userID++
+		}
+	})
+}
+
+func BenchmarkShardedGetCartParallel(b *testing.B) {
+	ctx := context.Background()
+
+	repo := NewShardedRepository(16)
+	for userID := int64(0); userID < benchmarkUsers; userID++ {
+		_ = repo.Add(ctx, userID, 100, 1)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		userID := rand.Int63n(benchmarkUsers)
+		for pb.Next() {
+			_, _ = repo.GetCart(ctx, userID%benchmarkUsers)
+			userID++
+		}
+	})
+}
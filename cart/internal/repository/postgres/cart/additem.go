@@ -0,0 +1,17 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart/sqlc"
+)
+
+func (r *Repository) Add(ctx context.Context, userID int64, skuID int64, count uint16) error {
+	queries := sqlc.New(r.db)
+
+	return queries.AddItem(ctx, sqlc.AddItemParams{
+		UserID: userID,
+		Sku:    skuID,
+		Count:  int32(count),
+	})
+}
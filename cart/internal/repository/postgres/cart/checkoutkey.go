@@ -0,0 +1,22 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart/sqlc"
+)
+
+func (r *Repository) CheckoutKey(ctx context.Context, userID int64, newKey string) (string, error) {
+	queries := sqlc.New(r.db)
+
+	return queries.GetCheckoutKey(ctx, sqlc.GetCheckoutKeyParams{
+		UserID:      userID,
+		CheckoutKey: newKey,
+	})
+}
+
+func (r *Repository) DropCheckoutKey(ctx context.Context, userID int64) error {
+	queries := sqlc.New(r.db)
+
+	return queries.DropCheckoutKey(ctx, userID)
+}
@@ -0,0 +1,16 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart/sqlc"
+)
+
+func (r *Repository) DeleteItem(ctx context.Context, userID int64, skuID int64) error {
+	queries := sqlc.New(r.db)
+
+	return queries.DeleteItem(ctx, sqlc.DeleteItemParams{
+		UserID: userID,
+		Sku:    skuID,
+	})
+}
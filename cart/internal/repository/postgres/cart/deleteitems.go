@@ -0,0 +1,18 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart/sqlc"
+)
+
+func (r *Repository) DeleteItemsByUserID(ctx context.Context, userID int64) (int, error) {
+	queries := sqlc.New(r.db)
+
+	count, err := queries.DeleteItemsByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
@@ -0,0 +1,39 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	"github.com/BruteMors/marketplace-service/cart/internal/repository"
+	"github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart/sqlc"
+)
+
+func (r *Repository) GetCart(ctx context.Context, userID int64) ([]models.ItemCount, error) {
+	queries := sqlc.New(r.db)
+
+	rows, err := queries.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, repository.ErrCartNotFound
+	}
+
+	items := make([]models.ItemCount, 0, len(rows))
+
+	for _, row := range rows {
+		if row.Count < 1 || row.Count > math.MaxUint16 {
+			return nil, fmt.Errorf("count %d of sku %d is out of range", row.Count, row.Sku)
+		}
+
+		items = append(items, models.ItemCount{
+			SkuID: row.Sku,
+			Count: uint16(row.Count),
+		})
+	}
+
+	return items, nil
+}
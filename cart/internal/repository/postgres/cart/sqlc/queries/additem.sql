@@ -0,0 +1,10 @@
+-- name: AddItem :exec
+WITH dropped_checkout_key AS (
+    DELETE FROM "cart_checkout_keys"
+    WHERE user_id = $1
+)
+INSERT INTO "cart_items" (user_id, sku, count, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
+ON CONFLICT (user_id, sku) DO UPDATE
+SET count = "cart_items".count + EXCLUDED.count,
+    updated_at = NOW();
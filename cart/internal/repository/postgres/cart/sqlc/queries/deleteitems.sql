@@ -0,0 +1,7 @@
+-- name: DeleteItemsByUserID :execrows
+WITH dropped_checkout_key AS (
+    DELETE FROM "cart_checkout_keys"
+    WHERE user_id = $1
+)
+DELETE FROM "cart_items"
+WHERE user_id = $1;
@@ -0,0 +1,3 @@
+-- name: DropCheckoutKey :exec
+DELETE FROM "cart_checkout_keys"
+WHERE user_id = $1;
@@ -0,0 +1,5 @@
+-- name: GetCart :many
+SELECT sku, count
+FROM "cart_items"
+WHERE user_id = $1
+ORDER BY sku;
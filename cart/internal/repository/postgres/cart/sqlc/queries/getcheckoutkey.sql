@@ -0,0 +1,6 @@
+-- name: GetCheckoutKey :one
+INSERT INTO "cart_checkout_keys" (user_id, checkout_key, created_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET checkout_key = "cart_checkout_keys".checkout_key
+RETURNING checkout_key;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "cart_items" (
+                                          user_id BIGINT NOT NULL,
+                                          sku BIGINT NOT NULL,
+                                          count INTEGER NOT NULL CHECK (count > 0 AND count <= 65535),
+                                          created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                          updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                          PRIMARY KEY (user_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "cart_items";
+-- +goose StatementEnd
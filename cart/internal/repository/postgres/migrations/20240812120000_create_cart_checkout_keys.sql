@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "cart_checkout_keys" (
+                                                  user_id BIGINT PRIMARY KEY,
+                                                  checkout_key TEXT NOT NULL,
+                                                  created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "cart_checkout_keys";
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+package cart
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+)
+
+type Repository interface {
+	Add(ctx context.Context, userID int64, skuID int64, count uint16) error
+	DeleteItem(ctx context.Context, userID int64, skuID int64) error
+	DeleteItemsByUserID(ctx context.Context, userID int64) (int, error)
+	GetCart(ctx context.Context, userID int64) ([]models.ItemCount, error)
+	CheckoutKey(ctx context.Context, userID int64, newKey string) (string, error)
+	DropCheckoutKey(ctx context.Context, userID int64) error
+}
+
+type RepositoryWithMetrics struct {
+	repo     Repository
+	inMemory bool
+}
+
+// NewRepositoryWithMetrics wraps repo. The in-memory object count is kept only when
+// inMemory is set, a Postgres repository holds no carts in memory.
+func NewRepositoryWithMetrics(repo Repository, inMemory bool) *RepositoryWithMetrics {
+	return &RepositoryWithMetrics{
+		repo:     repo,
+		inMemory: inMemory,
+	}
+}
+
+func (p *RepositoryWithMetrics) addInMemoryObjectCount(num float64) {
+	if p.inMemory {
+		metric.AddInMemoryObjectCount(num)
+	}
+}
+
+func (p *RepositoryWithMetrics) subInMemoryObjectCount(num float64) {
+	if p.inMemory {
+		metric.SubInMemoryObjectCount(num)
+	}
+}
@@ -0,0 +1,61 @@
+package cart
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (p *RepositoryWithMetrics) CheckoutKey(ctx context.Context, userID int64, newKey string) (key string, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "CheckoutKey")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	start := time.Now()
+	key, err = p.repo.CheckoutKey(ctx, userID, newKey)
+	duration := time.Since(start).Seconds()
+
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+
+	metric.IncDBRequestCounter("checkout_key", status)
+	metric.ObserveDBResponseTime("checkout_key", status, duration)
+
+	return key, err
+}
+
+func (p *RepositoryWithMetrics) DropCheckoutKey(ctx context.Context, userID int64) (err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "DropCheckoutKey")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	start := time.Now()
+	err = p.repo.DropCheckoutKey(ctx, userID)
+	duration := time.Since(start).Seconds()
+
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+
+	metric.IncDBRequestCounter("drop_checkout_key", status)
+	metric.ObserveDBResponseTime("drop_checkout_key", status, duration)
+
+	return err
+}
@@ -0,0 +1,37 @@
+package cart
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (p *RepositoryWithMetrics) GetCart(ctx context.Context, userID int64) (items []models.ItemCount, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "GetCart")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	start := time.Now()
+	items, err = p.repo.GetCart(ctx, userID)
+	duration := time.Since(start).Seconds()
+
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+
+	metric.IncDBRequestCounter("get_cart", status)
+	metric.ObserveDBResponseTime("get_cart", status, duration)
+
+	return items, err
+}
@@ -13,6 +13,12 @@ type CartRepository interface {
 	DeleteItem(ctx context.Context, userID int64, skuID int64) error
 	DeleteItemsByUserID(_ context.Context, userID int64) (int, error)
 	GetCart(ctx context.Context, userID int64) ([]models.ItemCount, error)
+	// CheckoutKey returns the idempotency key of the checkout of the cart, newKey when the cart
+	// has none yet. The key is dropped once the cart changes.
+	CheckoutKey(ctx context.Context, userID int64, newKey string) (string, error)
+	// DropCheckoutKey drops the idempotency key of the checkout of the cart, so the next checkout
+	// places a new order.
+	DropCheckoutKey(ctx context.Context, userID int64) error
 }
 
 type ProductService interface {
@@ -27,6 +33,8 @@ type ProductService interface {
 type LomsService interface {
 	OrderCreate(ctx context.Context, order lomsserviceModels.OrderCreate) (orderID int64, err error)
 	StocksInfo(ctx context.Context, sku uint32) (count uint64, err error)
+	StocksInfoBatch(ctx context.Context, skus []uint32) (counts map[uint32]uint64, err error)
+	OrdersList(ctx context.Context, list lomsserviceModels.OrdersList) (*lomsserviceModels.OrdersListResponse, error)
 }
 
 type Service struct {
@@ -2,6 +2,8 @@ package cart
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 
 	"github.com/BruteMors/marketplace-service/cart/internal/models"
@@ -12,7 +14,11 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func (s *Service) Checkout(ctx context.Context, userID int64) (orderID int64, err error) {
+// Checkout places an order for the cart. Requests with the same idempotency key yield the same order;
+// when the key is empty the key of the cart is used, so retries of a checkout of an unchanged cart
+// yield the same order as well. The key of the cart is dropped when LOMS rejects the order,
+// so a later checkout places a new one instead of replaying the rejection.
+func (s *Service) Checkout(ctx context.Context, userID int64, idempotencyKey string) (orderID int64, err error) {
 	tr := otel.Tracer("cartService")
 	ctx, span := tr.Start(ctx, "Checkout")
 	defer func() {
@@ -40,11 +46,25 @@ func (s *Service) Checkout(ctx context.Context, userID int64) (orderID int64, er
 		})
 	}
 
+	cartKey := idempotencyKey == ""
+	if cartKey {
+		idempotencyKey, err = s.cartRepository.CheckoutKey(ctx, userID, newCheckoutKey())
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	orderID, err = s.lomsService.OrderCreate(ctx, lomsserviceModels.OrderCreate{
-		User:  userID,
-		Items: items,
+		User:           userID,
+		Items:          items,
+		IdempotencyKey: idempotencyKey,
 	})
 	if err != nil {
+		if cartKey && errors.Is(err, lomsserviceModels.ErrOrderRejected) {
+			if errDrop := s.cartRepository.DropCheckoutKey(ctx, userID); errDrop != nil {
+				err = errors.Join(err, errDrop)
+			}
+		}
 		return 0, err
 	}
 
@@ -55,3 +75,10 @@ func (s *Service) Checkout(ctx context.Context, userID int64) (orderID int64, er
 
 	return orderID, nil
 }
+
+func newCheckoutKey() string {
+	key := make([]byte, 16)
+	_, _ = rand.Read(key)
+
+	return "cart-" + hex.EncodeToString(key)
+}
@@ -3,14 +3,17 @@ package cart
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/BruteMors/marketplace-service/cart/internal/models"
 	"github.com/BruteMors/marketplace-service/cart/internal/repository"
+	inMemoryCartRepository "github.com/BruteMors/marketplace-service/cart/internal/repository/inmemory/cart"
 	"github.com/BruteMors/marketplace-service/cart/internal/service/cart/mock"
 	lomsserviceModels "github.com/BruteMors/marketplace-service/cart/pkg/lomsservice/models"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestServiceCheckout(t *testing.T) {
@@ -30,7 +33,9 @@ func TestServiceCheckout(t *testing.T) {
 	tests := []struct {
 		name               string
 		userID             int64
+		idempotencyKey     string
 		mockCartFunc       func()
+		mockKeyFunc        func()
 		mockLomsFunc       func()
 		mockDeleteCartFunc func()
 		expectedOrderID    int64
@@ -55,8 +60,9 @@ func TestServiceCheckout(t *testing.T) {
 			expectedError:   errors.New("db error"),
 		},
 		{
-			name:   "successful checkout",
-			userID: 3,
+			name:           "successful checkout",
+			userID:         3,
+			idempotencyKey: "checkout-3",
 			mockCartFunc: func() {
 				cart := []models.ItemCount{
 					{SkuID: 100, Count: 2},
@@ -70,8 +76,9 @@ func TestServiceCheckout(t *testing.T) {
 					{SkuID: 101, Count: 3},
 				}
 				lomsServiceMock.OrderCreateMock.Expect(minimock.AnyContext, lomsserviceModels.OrderCreate{
-					User:  3,
-					Items: items,
+					User:           3,
+					Items:          items,
+					IdempotencyKey: "checkout-3",
 				}).Return(int64(12345), nil)
 			},
 			mockDeleteCartFunc: func() {
@@ -89,18 +96,52 @@ func TestServiceCheckout(t *testing.T) {
 				}
 				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 4).Return(cart, nil)
 			},
+			mockKeyFunc: func() {
+				cartRepositoryMock.CheckoutKeyMock.Set(func(_ context.Context, userID int64, newKey string) (string, error) {
+					assert.Equal(t, int64(4), userID)
+					assert.NotEmpty(t, newKey)
+					return "cart-key-4", nil
+				})
+			},
 			mockLomsFunc: func() {
 				items := []lomsserviceModels.OrderItem{
 					{SkuID: 200, Count: 1},
 				}
 				lomsServiceMock.OrderCreateMock.Expect(minimock.AnyContext, lomsserviceModels.OrderCreate{
-					User:  4,
-					Items: items,
+					User:           4,
+					Items:          items,
+					IdempotencyKey: "cart-key-4",
 				}).Return(int64(0), errors.New("loms service error"))
 			},
 			expectedOrderID: 0,
 			expectedError:   errors.New("loms service error"),
 		},
+		{
+			name:   "rejected order drops the checkout key",
+			userID: 7,
+			mockCartFunc: func() {
+				cart := []models.ItemCount{
+					{SkuID: 500, Count: 1},
+				}
+				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 7).Return(cart, nil)
+			},
+			mockKeyFunc: func() {
+				cartRepositoryMock.CheckoutKeyMock.Set(func(_ context.Context, userID int64, newKey string) (string, error) {
+					assert.Equal(t, int64(7), userID)
+					return "cart-key-7", nil
+				})
+				cartRepositoryMock.DropCheckoutKeyMock.Expect(minimock.AnyContext, 7).Return(nil)
+			},
+			mockLomsFunc: func() {
+				lomsServiceMock.OrderCreateMock.Expect(minimock.AnyContext, lomsserviceModels.OrderCreate{
+					User:           7,
+					Items:          []lomsserviceModels.OrderItem{{SkuID: 500, Count: 1}},
+					IdempotencyKey: "cart-key-7",
+				}).Return(int64(0), fmt.Errorf("%w: insufficient stock", lomsserviceModels.ErrOrderRejected))
+			},
+			expectedOrderID: 0,
+			expectedError:   fmt.Errorf("%w: insufficient stock", lomsserviceModels.ErrOrderRejected),
+		},
 		{
 			name:   "delete cart items error",
 			userID: 5,
@@ -110,13 +151,21 @@ func TestServiceCheckout(t *testing.T) {
 				}
 				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 5).Return(cart, nil)
 			},
+			mockKeyFunc: func() {
+				cartRepositoryMock.CheckoutKeyMock.Set(func(_ context.Context, userID int64, newKey string) (string, error) {
+					assert.Equal(t, int64(5), userID)
+					assert.NotEmpty(t, newKey)
+					return "cart-key-5", nil
+				})
+			},
 			mockLomsFunc: func() {
 				items := []lomsserviceModels.OrderItem{
 					{SkuID: 300, Count: 4},
 				}
 				lomsServiceMock.OrderCreateMock.Expect(minimock.AnyContext, lomsserviceModels.OrderCreate{
-					User:  5,
-					Items: items,
+					User:           5,
+					Items:          items,
+					IdempotencyKey: "cart-key-5",
 				}).Return(int64(67890), nil)
 			},
 			mockDeleteCartFunc: func() {
@@ -125,11 +174,33 @@ func TestServiceCheckout(t *testing.T) {
 			expectedOrderID: 0,
 			expectedError:   errors.New("delete error"),
 		},
+		{
+			name:   "checkout key error",
+			userID: 6,
+			mockCartFunc: func() {
+				cart := []models.ItemCount{
+					{SkuID: 400, Count: 1},
+				}
+				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 6).Return(cart, nil)
+			},
+			mockKeyFunc: func() {
+				cartRepositoryMock.CheckoutKeyMock.Set(func(_ context.Context, userID int64, newKey string) (string, error) {
+					assert.Equal(t, int64(6), userID)
+					assert.NotEmpty(t, newKey)
+					return "", errors.New("key error")
+				})
+			},
+			expectedOrderID: 0,
+			expectedError:   errors.New("key error"),
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockCartFunc()
+			if tt.mockKeyFunc != nil {
+				tt.mockKeyFunc()
+			}
 			if tt.mockLomsFunc != nil {
 				tt.mockLomsFunc()
 			}
@@ -137,7 +208,7 @@ func TestServiceCheckout(t *testing.T) {
 				tt.mockDeleteCartFunc()
 			}
 
-			orderID, err := s.Checkout(ctx, tt.userID)
+			orderID, err := s.Checkout(ctx, tt.userID, tt.idempotencyKey)
 			assert.Equal(t, tt.expectedOrderID, orderID)
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
@@ -147,3 +218,48 @@ func TestServiceCheckout(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceCheckoutRetryAfterRejectedOrder(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	repo := inMemoryCartRepository.NewRepository()
+	lomsServiceMock := mock.NewLomsServiceMock(mc)
+	s := &Service{
+		cartRepository: repo,
+		lomsService:    lomsServiceMock,
+	}
+
+	ctx := context.Background()
+
+	// the item stays out of stock, so LOMS rejects every attempt, replayed or not
+	var keys []string
+	lomsServiceMock.OrderCreateMock.Set(func(_ context.Context, order lomsserviceModels.OrderCreate) (int64, error) {
+		keys = append(keys, order.IdempotencyKey)
+		return 0, fmt.Errorf("%w: insufficient stock", lomsserviceModels.ErrOrderRejected)
+	})
+
+	require.NoError(t, repo.Add(ctx, 1, 100, 2))
+
+	for i := 0; i < 2; i++ {
+		_, err := s.Checkout(ctx, 1, "")
+		require.ErrorIs(t, err, lomsserviceModels.ErrOrderRejected)
+	}
+
+	items, err := repo.GetCart(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []models.ItemCount{{SkuID: 100, Count: 2}}, items)
+
+	require.Len(t, keys, 2)
+	assert.NotEqual(t, keys[0], keys[1])
+}
+
+func TestNewCheckoutKey(t *testing.T) {
+	t.Parallel()
+
+	key := newCheckoutKey()
+
+	assert.NotEqual(t, key, newCheckoutKey())
+	assert.LessOrEqual(t, len(key), 128)
+}
@@ -3,10 +3,12 @@ package cart
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"sort"
 
 	"github.com/BruteMors/marketplace-service/cart/internal/models"
 	"github.com/BruteMors/marketplace-service/cart/internal/repository"
+	"github.com/BruteMors/marketplace-service/cart/pkg/errorgroup"
 	productserviceModels "github.com/BruteMors/marketplace-service/cart/pkg/productservice/models"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"go.opentelemetry.io/otel"
@@ -33,11 +35,42 @@ func (s *Service) GetCart(ctx context.Context, userID int64) (cart *models.Cart,
 	}
 
 	skus := make([]int64, len(itemsCount))
+	stockSkus := make([]uint32, len(itemsCount))
 	for i, item := range itemsCount {
 		skus[i] = item.SkuID
+		stockSkus[i] = uint32(item.SkuID)
 	}
 
-	products, err := s.productService.GetProducts(ctx, skus)
+	var products []productserviceModels.GetProductResponse
+	var stocks map[uint32]uint64
+	var stocksKnown bool
+
+	eg := errorgroup.NewErrGroup(ctx)
+	eg.Go(func() error {
+		var errProducts error
+		products, errProducts = s.productService.GetProducts(eg.Context(), skus)
+		return errProducts
+	})
+	// Availability is best effort: when LOMS fails the cart is still returned, with availability unknown.
+	eg.Go(func() error {
+		var errStocks error
+		stocks, errStocks = s.lomsService.StocksInfoBatch(eg.Context(), stockSkus)
+		if errStocks != nil {
+			slog.LogAttrs(
+				ctx,
+				slog.LevelWarn,
+				"failed to get stocks info",
+				slog.String("method", "Service.GetCart"),
+				slog.String("error", errStocks.Error()),
+			)
+			return nil
+		}
+
+		stocksKnown = true
+		return nil
+	})
+
+	err = eg.Wait()
 	if err != nil {
 		if errors.Is(err, productserviceModels.ErrNotFound) {
 			err = models.ErrProductNotFound
@@ -64,6 +97,11 @@ func (s *Service) GetCart(ctx context.Context, userID int64) (cart *models.Cart,
 			Price:     uint32(product.Price),
 			ItemCount: item,
 		}
+		if stocksKnown {
+			available := stocks[uint32(item.SkuID)]
+			items[i].Available = &available
+			items[i].InsufficientStock = uint64(item.Count) > available
+		}
 	}
 
 	sort.Slice(items, func(i, j int) bool {
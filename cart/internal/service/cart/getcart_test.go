@@ -13,15 +13,21 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func available(count uint64) *uint64 {
+	return &count
+}
+
 func TestServiceCartGetCart(t *testing.T) {
 	t.Parallel()
 	mc := minimock.NewController(t)
 
 	cartRepositoryMock := mock.NewCartRepositoryMock(mc)
 	productServiceMock := mock.NewProductServiceMock(mc)
+	lomsServiceMock := mock.NewLomsServiceMock(mc)
 	s := &Service{
 		cartRepository: cartRepositoryMock,
 		productService: productServiceMock,
+		lomsService:    lomsServiceMock,
 	}
 
 	ctx := context.Background()
@@ -31,6 +37,7 @@ func TestServiceCartGetCart(t *testing.T) {
 		userID          int64
 		mockCartFunc    func()
 		mockProductFunc func()
+		mockStocksFunc  func()
 		expectedResult  *models.Cart
 		expectedError   error
 	}{
@@ -52,15 +59,57 @@ func TestServiceCartGetCart(t *testing.T) {
 					nil,
 				)
 			},
+			mockStocksFunc: func() {
+				lomsServiceMock.StocksInfoBatchMock.Expect(minimock.AnyContext, []uint32{101, 102}).Return(
+					map[uint32]uint64{101: 10, 102: 3},
+					nil,
+				)
+			},
 			expectedResult: &models.Cart{
 				Items: []models.Item{
-					{Name: "Product A", Price: 100, ItemCount: models.ItemCount{SkuID: 101, Count: 2}},
-					{Name: "Product B", Price: 150, ItemCount: models.ItemCount{SkuID: 102, Count: 3}},
+					{Name: "Product A", Price: 100, Available: available(10), ItemCount: models.ItemCount{SkuID: 101, Count: 2}},
+					{Name: "Product B", Price: 150, Available: available(3), ItemCount: models.ItemCount{SkuID: 102, Count: 3}},
 				},
 				TotalPrice: 650,
 			},
 			expectedError: nil,
 		},
+		{
+			name:   "items with insufficient stock are marked",
+			userID: 4,
+			mockCartFunc: func() {
+				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 4).Return([]models.ItemCount{
+					{SkuID: 101, Count: 2},
+					{SkuID: 102, Count: 3},
+					{SkuID: 104, Count: 1},
+				}, nil)
+			},
+			mockProductFunc: func() {
+				productServiceMock.GetProductsMock.Expect(minimock.AnyContext, []int64{101, 102, 104}).Return(
+					[]productServiceModels.GetProductResponse{
+						{Sku: 101, Name: "Product A", Price: 100},
+						{Sku: 102, Name: "Product B", Price: 150},
+						{Sku: 104, Name: "Product D", Price: 50},
+					},
+					nil,
+				)
+			},
+			mockStocksFunc: func() {
+				lomsServiceMock.StocksInfoBatchMock.Expect(minimock.AnyContext, []uint32{101, 102, 104}).Return(
+					map[uint32]uint64{101: 10, 102: 1},
+					nil,
+				)
+			},
+			expectedResult: &models.Cart{
+				Items: []models.Item{
+					{Name: "Product A", Price: 100, Available: available(10), ItemCount: models.ItemCount{SkuID: 101, Count: 2}},
+					{Name: "Product B", Price: 150, Available: available(1), InsufficientStock: true, ItemCount: models.ItemCount{SkuID: 102, Count: 3}},
+					{Name: "Product D", Price: 50, Available: available(0), InsufficientStock: true, ItemCount: models.ItemCount{SkuID: 104, Count: 1}},
+				},
+				TotalPrice: 700,
+			},
+			expectedError: nil,
+		},
 		{
 			name:   "cart not found error",
 			userID: 2,
@@ -68,6 +117,7 @@ func TestServiceCartGetCart(t *testing.T) {
 				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 2).Return(nil, repository.ErrCartNotFound)
 			},
 			mockProductFunc: func() {},
+			mockStocksFunc:  func() {},
 			expectedResult:  nil,
 			expectedError:   models.ErrCartNotFound,
 		},
@@ -86,6 +136,9 @@ func TestServiceCartGetCart(t *testing.T) {
 					productServiceModels.ErrNotFound,
 				)
 			},
+			mockStocksFunc: func() {
+				lomsServiceMock.StocksInfoBatchMock.Expect(minimock.AnyContext, []uint32{103}).Return(map[uint32]uint64{103: 1}, nil)
+			},
 			expectedResult: nil,
 			expectedError:  models.ErrProductNotFound,
 		},
@@ -99,6 +152,7 @@ func TestServiceCartGetCart(t *testing.T) {
 				)
 			},
 			mockProductFunc: func() {},
+			mockStocksFunc:  func() {},
 			expectedResult:  nil,
 			expectedError:   fmt.Errorf("unexpected repository error"),
 		},
@@ -114,15 +168,45 @@ func TestServiceCartGetCart(t *testing.T) {
 					fmt.Errorf("unexpected product service error"),
 				)
 			},
+			mockStocksFunc: func() {
+				lomsServiceMock.StocksInfoBatchMock.Expect(minimock.AnyContext, []uint32{103}).Return(map[uint32]uint64{103: 1}, nil)
+			},
 			expectedResult: nil,
 			expectedError:  fmt.Errorf("unexpected product service error"),
 		},
+		{
+			name:   "loms service error leaves availability unknown",
+			userID: 5,
+			mockCartFunc: func() {
+				cartRepositoryMock.GetCartMock.Expect(minimock.AnyContext, 5).Return([]models.ItemCount{{SkuID: 105, Count: 1}}, nil)
+			},
+			mockProductFunc: func() {
+				productServiceMock.GetProductsMock.Expect(minimock.AnyContext, []int64{105}).Return(
+					[]productServiceModels.GetProductResponse{{Sku: 105, Name: "Product E", Price: 10}},
+					nil,
+				)
+			},
+			mockStocksFunc: func() {
+				lomsServiceMock.StocksInfoBatchMock.Expect(minimock.AnyContext, []uint32{105}).Return(
+					nil,
+					fmt.Errorf("unexpected loms service error"),
+				)
+			},
+			expectedResult: &models.Cart{
+				Items: []models.Item{
+					{Name: "Product E", Price: 10, ItemCount: models.ItemCount{SkuID: 105, Count: 1}},
+				},
+				TotalPrice: 10,
+			},
+			expectedError: nil,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockCartFunc()
 			tt.mockProductFunc()
+			tt.mockStocksFunc()
 
 			result, err := s.GetCart(ctx, tt.userID)
 
@@ -25,6 +25,12 @@ type CartRepositoryMock struct {
 	beforeAddCounter uint64
 	AddMock          mCartRepositoryMockAdd
 
+	funcCheckoutKey          func(ctx context.Context, userID int64, newKey string) (s1 string, err error)
+	inspectFuncCheckoutKey   func(ctx context.Context, userID int64, newKey string)
+	afterCheckoutKeyCounter  uint64
+	beforeCheckoutKeyCounter uint64
+	CheckoutKeyMock          mCartRepositoryMockCheckoutKey
+
 	funcDeleteItem          func(ctx context.Context, userID int64, skuID int64) (err error)
 	inspectFuncDeleteItem   func(ctx context.Context, userID int64, skuID int64)
 	afterDeleteItemCounter  uint64
@@ -37,6 +43,12 @@ type CartRepositoryMock struct {
 	beforeDeleteItemsByUserIDCounter uint64
 	DeleteItemsByUserIDMock          mCartRepositoryMockDeleteItemsByUserID
 
+	funcDropCheckoutKey          func(ctx context.Context, userID int64) (err error)
+	inspectFuncDropCheckoutKey   func(ctx context.Context, userID int64)
+	afterDropCheckoutKeyCounter  uint64
+	beforeDropCheckoutKeyCounter uint64
+	DropCheckoutKeyMock          mCartRepositoryMockDropCheckoutKey
+
 	funcGetCart          func(ctx context.Context, userID int64) (ia1 []models.ItemCount, err error)
 	inspectFuncGetCart   func(ctx context.Context, userID int64)
 	afterGetCartCounter  uint64
@@ -55,12 +67,18 @@ func NewCartRepositoryMock(t minimock.Tester) *CartRepositoryMock {
 	m.AddMock = mCartRepositoryMockAdd{mock: m}
 	m.AddMock.callArgs = []*CartRepositoryMockAddParams{}
 
+	m.CheckoutKeyMock = mCartRepositoryMockCheckoutKey{mock: m}
+	m.CheckoutKeyMock.callArgs = []*CartRepositoryMockCheckoutKeyParams{}
+
 	m.DeleteItemMock = mCartRepositoryMockDeleteItem{mock: m}
 	m.DeleteItemMock.callArgs = []*CartRepositoryMockDeleteItemParams{}
 
 	m.DeleteItemsByUserIDMock = mCartRepositoryMockDeleteItemsByUserID{mock: m}
 	m.DeleteItemsByUserIDMock.callArgs = []*CartRepositoryMockDeleteItemsByUserIDParams{}
 
+	m.DropCheckoutKeyMock = mCartRepositoryMockDropCheckoutKey{mock: m}
+	m.DropCheckoutKeyMock.callArgs = []*CartRepositoryMockDropCheckoutKeyParams{}
+
 	m.GetCartMock = mCartRepositoryMockGetCart{mock: m}
 	m.GetCartMock.callArgs = []*CartRepositoryMockGetCartParams{}
 
@@ -445,6 +463,355 @@ func (m *CartRepositoryMock) MinimockAddInspect() {
 	}
 }
 
+type mCartRepositoryMockCheckoutKey struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockCheckoutKeyExpectation
+	expectations       []*CartRepositoryMockCheckoutKeyExpectation
+
+	callArgs []*CartRepositoryMockCheckoutKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// CartRepositoryMockCheckoutKeyExpectation specifies expectation struct of the CartRepository.CheckoutKey
+type CartRepositoryMockCheckoutKeyExpectation struct {
+	mock      *CartRepositoryMock
+	params    *CartRepositoryMockCheckoutKeyParams
+	paramPtrs *CartRepositoryMockCheckoutKeyParamPtrs
+	results   *CartRepositoryMockCheckoutKeyResults
+	Counter   uint64
+}
+
+// CartRepositoryMockCheckoutKeyParams contains parameters of the CartRepository.CheckoutKey
+type CartRepositoryMockCheckoutKeyParams struct {
+	ctx    context.Context
+	userID int64
+	newKey string
+}
+
+// CartRepositoryMockCheckoutKeyParamPtrs contains pointers to parameters of the CartRepository.CheckoutKey
+type CartRepositoryMockCheckoutKeyParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	newKey *string
+}
+
+// CartRepositoryMockCheckoutKeyResults contains results of the CartRepository.CheckoutKey
+type CartRepositoryMockCheckoutKeyResults struct {
+	s1  string
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Optional() *mCartRepositoryMockCheckoutKey {
+	mmCheckoutKey.optional = true
+	return mmCheckoutKey
+}
+
+// Expect sets up expected params for CartRepository.CheckoutKey
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Expect(ctx context.Context, userID int64, newKey string) *mCartRepositoryMockCheckoutKey {
+	if mmCheckoutKey.mock.funcCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Set")
+	}
+
+	if mmCheckoutKey.defaultExpectation == nil {
+		mmCheckoutKey.defaultExpectation = &CartRepositoryMockCheckoutKeyExpectation{}
+	}
+
+	if mmCheckoutKey.defaultExpectation.paramPtrs != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by ExpectParams functions")
+	}
+
+	mmCheckoutKey.defaultExpectation.params = &CartRepositoryMockCheckoutKeyParams{ctx, userID, newKey}
+	for _, e := range mmCheckoutKey.expectations {
+		if minimock.Equal(e.params, mmCheckoutKey.defaultExpectation.params) {
+			mmCheckoutKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckoutKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckoutKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.CheckoutKey
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockCheckoutKey {
+	if mmCheckoutKey.mock.funcCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Set")
+	}
+
+	if mmCheckoutKey.defaultExpectation == nil {
+		mmCheckoutKey.defaultExpectation = &CartRepositoryMockCheckoutKeyExpectation{}
+	}
+
+	if mmCheckoutKey.defaultExpectation.params != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Expect")
+	}
+
+	if mmCheckoutKey.defaultExpectation.paramPtrs == nil {
+		mmCheckoutKey.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutKeyParamPtrs{}
+	}
+	mmCheckoutKey.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckoutKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.CheckoutKey
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) ExpectUserIDParam2(userID int64) *mCartRepositoryMockCheckoutKey {
+	if mmCheckoutKey.mock.funcCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Set")
+	}
+
+	if mmCheckoutKey.defaultExpectation == nil {
+		mmCheckoutKey.defaultExpectation = &CartRepositoryMockCheckoutKeyExpectation{}
+	}
+
+	if mmCheckoutKey.defaultExpectation.params != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Expect")
+	}
+
+	if mmCheckoutKey.defaultExpectation.paramPtrs == nil {
+		mmCheckoutKey.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutKeyParamPtrs{}
+	}
+	mmCheckoutKey.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmCheckoutKey
+}
+
+// ExpectNewKeyParam3 sets up expected param newKey for CartRepository.CheckoutKey
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) ExpectNewKeyParam3(newKey string) *mCartRepositoryMockCheckoutKey {
+	if mmCheckoutKey.mock.funcCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Set")
+	}
+
+	if mmCheckoutKey.defaultExpectation == nil {
+		mmCheckoutKey.defaultExpectation = &CartRepositoryMockCheckoutKeyExpectation{}
+	}
+
+	if mmCheckoutKey.defaultExpectation.params != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Expect")
+	}
+
+	if mmCheckoutKey.defaultExpectation.paramPtrs == nil {
+		mmCheckoutKey.defaultExpectation.paramPtrs = &CartRepositoryMockCheckoutKeyParamPtrs{}
+	}
+	mmCheckoutKey.defaultExpectation.paramPtrs.newKey = &newKey
+
+	return mmCheckoutKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.CheckoutKey
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Inspect(f func(ctx context.Context, userID int64, newKey string)) *mCartRepositoryMockCheckoutKey {
+	if mmCheckoutKey.mock.inspectFuncCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.CheckoutKey")
+	}
+
+	mmCheckoutKey.mock.inspectFuncCheckoutKey = f
+
+	return mmCheckoutKey
+}
+
+// Return sets up results that will be returned by CartRepository.CheckoutKey
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Return(s1 string, err error) *CartRepositoryMock {
+	if mmCheckoutKey.mock.funcCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Set")
+	}
+
+	if mmCheckoutKey.defaultExpectation == nil {
+		mmCheckoutKey.defaultExpectation = &CartRepositoryMockCheckoutKeyExpectation{mock: mmCheckoutKey.mock}
+	}
+	mmCheckoutKey.defaultExpectation.results = &CartRepositoryMockCheckoutKeyResults{s1, err}
+	return mmCheckoutKey.mock
+}
+
+// Set uses given function f to mock the CartRepository.CheckoutKey method
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Set(f func(ctx context.Context, userID int64, newKey string) (s1 string, err error)) *CartRepositoryMock {
+	if mmCheckoutKey.defaultExpectation != nil {
+		mmCheckoutKey.mock.t.Fatalf("Default expectation is already set for the CartRepository.CheckoutKey method")
+	}
+
+	if len(mmCheckoutKey.expectations) > 0 {
+		mmCheckoutKey.mock.t.Fatalf("Some expectations are already set for the CartRepository.CheckoutKey method")
+	}
+
+	mmCheckoutKey.mock.funcCheckoutKey = f
+	return mmCheckoutKey.mock
+}
+
+// When sets expectation for the CartRepository.CheckoutKey which will trigger the result defined by the following
+// Then helper
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) When(ctx context.Context, userID int64, newKey string) *CartRepositoryMockCheckoutKeyExpectation {
+	if mmCheckoutKey.mock.funcCheckoutKey != nil {
+		mmCheckoutKey.mock.t.Fatalf("CartRepositoryMock.CheckoutKey mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockCheckoutKeyExpectation{
+		mock:   mmCheckoutKey.mock,
+		params: &CartRepositoryMockCheckoutKeyParams{ctx, userID, newKey},
+	}
+	mmCheckoutKey.expectations = append(mmCheckoutKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.CheckoutKey return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockCheckoutKeyExpectation) Then(s1 string, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockCheckoutKeyResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.CheckoutKey should be invoked
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Times(n uint64) *mCartRepositoryMockCheckoutKey {
+	if n == 0 {
+		mmCheckoutKey.mock.t.Fatalf("Times of CartRepositoryMock.CheckoutKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckoutKey.expectedInvocations, n)
+	return mmCheckoutKey
+}
+
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) invocationsDone() bool {
+	if len(mmCheckoutKey.expectations) == 0 && mmCheckoutKey.defaultExpectation == nil && mmCheckoutKey.mock.funcCheckoutKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckoutKey.mock.afterCheckoutKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckoutKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckoutKey implements cart.CartRepository
+func (mmCheckoutKey *CartRepositoryMock) CheckoutKey(ctx context.Context, userID int64, newKey string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmCheckoutKey.beforeCheckoutKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckoutKey.afterCheckoutKeyCounter, 1)
+
+	if mmCheckoutKey.inspectFuncCheckoutKey != nil {
+		mmCheckoutKey.inspectFuncCheckoutKey(ctx, userID, newKey)
+	}
+
+	mm_params := CartRepositoryMockCheckoutKeyParams{ctx, userID, newKey}
+
+	// Record call args
+	mmCheckoutKey.CheckoutKeyMock.mutex.Lock()
+	mmCheckoutKey.CheckoutKeyMock.callArgs = append(mmCheckoutKey.CheckoutKeyMock.callArgs, &mm_params)
+	mmCheckoutKey.CheckoutKeyMock.mutex.Unlock()
+
+	for _, e := range mmCheckoutKey.CheckoutKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCheckoutKey.CheckoutKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckoutKey.CheckoutKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckoutKey.CheckoutKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckoutKey.CheckoutKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockCheckoutKeyParams{ctx, userID, newKey}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckoutKey.t.Errorf("CartRepositoryMock.CheckoutKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheckoutKey.t.Errorf("CartRepositoryMock.CheckoutKey got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.newKey != nil && !minimock.Equal(*mm_want_ptrs.newKey, mm_got.newKey) {
+				mmCheckoutKey.t.Errorf("CartRepositoryMock.CheckoutKey got unexpected parameter newKey, want: %#v, got: %#v%s\n", *mm_want_ptrs.newKey, mm_got.newKey, minimock.Diff(*mm_want_ptrs.newKey, mm_got.newKey))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckoutKey.t.Errorf("CartRepositoryMock.CheckoutKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckoutKey.CheckoutKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckoutKey.t.Fatal("No results are set for the CartRepositoryMock.CheckoutKey")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCheckoutKey.funcCheckoutKey != nil {
+		return mmCheckoutKey.funcCheckoutKey(ctx, userID, newKey)
+	}
+	mmCheckoutKey.t.Fatalf("Unexpected call to CartRepositoryMock.CheckoutKey. %v %v %v", ctx, userID, newKey)
+	return
+}
+
+// CheckoutKeyAfterCounter returns a count of finished CartRepositoryMock.CheckoutKey invocations
+func (mmCheckoutKey *CartRepositoryMock) CheckoutKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckoutKey.afterCheckoutKeyCounter)
+}
+
+// CheckoutKeyBeforeCounter returns a count of CartRepositoryMock.CheckoutKey invocations
+func (mmCheckoutKey *CartRepositoryMock) CheckoutKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckoutKey.beforeCheckoutKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.CheckoutKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckoutKey *mCartRepositoryMockCheckoutKey) Calls() []*CartRepositoryMockCheckoutKeyParams {
+	mmCheckoutKey.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockCheckoutKeyParams, len(mmCheckoutKey.callArgs))
+	copy(argCopy, mmCheckoutKey.callArgs)
+
+	mmCheckoutKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckoutKeyDone returns true if the count of the CheckoutKey invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockCheckoutKeyDone() bool {
+	if m.CheckoutKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckoutKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckoutKeyMock.invocationsDone()
+}
+
+// MinimockCheckoutKeyInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockCheckoutKeyInspect() {
+	for _, e := range m.CheckoutKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.CheckoutKey with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckoutKeyCounter := mm_atomic.LoadUint64(&m.afterCheckoutKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckoutKeyMock.defaultExpectation != nil && afterCheckoutKeyCounter < 1 {
+		if m.CheckoutKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartRepositoryMock.CheckoutKey")
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.CheckoutKey with params: %#v", *m.CheckoutKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckoutKey != nil && afterCheckoutKeyCounter < 1 {
+		m.t.Error("Expected call to CartRepositoryMock.CheckoutKey")
+	}
+
+	if !m.CheckoutKeyMock.invocationsDone() && afterCheckoutKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.CheckoutKey but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckoutKeyMock.expectedInvocations), afterCheckoutKeyCounter)
+	}
+}
+
 type mCartRepositoryMockDeleteItem struct {
 	optional           bool
 	mock               *CartRepositoryMock
@@ -1114,6 +1481,326 @@ func (m *CartRepositoryMock) MinimockDeleteItemsByUserIDInspect() {
 	}
 }
 
+type mCartRepositoryMockDropCheckoutKey struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockDropCheckoutKeyExpectation
+	expectations       []*CartRepositoryMockDropCheckoutKeyExpectation
+
+	callArgs []*CartRepositoryMockDropCheckoutKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// CartRepositoryMockDropCheckoutKeyExpectation specifies expectation struct of the CartRepository.DropCheckoutKey
+type CartRepositoryMockDropCheckoutKeyExpectation struct {
+	mock      *CartRepositoryMock
+	params    *CartRepositoryMockDropCheckoutKeyParams
+	paramPtrs *CartRepositoryMockDropCheckoutKeyParamPtrs
+	results   *CartRepositoryMockDropCheckoutKeyResults
+	Counter   uint64
+}
+
+// CartRepositoryMockDropCheckoutKeyParams contains parameters of the CartRepository.DropCheckoutKey
+type CartRepositoryMockDropCheckoutKeyParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// CartRepositoryMockDropCheckoutKeyParamPtrs contains pointers to parameters of the CartRepository.DropCheckoutKey
+type CartRepositoryMockDropCheckoutKeyParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// CartRepositoryMockDropCheckoutKeyResults contains results of the CartRepository.DropCheckoutKey
+type CartRepositoryMockDropCheckoutKeyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Optional() *mCartRepositoryMockDropCheckoutKey {
+	mmDropCheckoutKey.optional = true
+	return mmDropCheckoutKey
+}
+
+// Expect sets up expected params for CartRepository.DropCheckoutKey
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Expect(ctx context.Context, userID int64) *mCartRepositoryMockDropCheckoutKey {
+	if mmDropCheckoutKey.mock.funcDropCheckoutKey != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Set")
+	}
+
+	if mmDropCheckoutKey.defaultExpectation == nil {
+		mmDropCheckoutKey.defaultExpectation = &CartRepositoryMockDropCheckoutKeyExpectation{}
+	}
+
+	if mmDropCheckoutKey.defaultExpectation.paramPtrs != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by ExpectParams functions")
+	}
+
+	mmDropCheckoutKey.defaultExpectation.params = &CartRepositoryMockDropCheckoutKeyParams{ctx, userID}
+	for _, e := range mmDropCheckoutKey.expectations {
+		if minimock.Equal(e.params, mmDropCheckoutKey.defaultExpectation.params) {
+			mmDropCheckoutKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDropCheckoutKey.defaultExpectation.params)
+		}
+	}
+
+	return mmDropCheckoutKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.DropCheckoutKey
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockDropCheckoutKey {
+	if mmDropCheckoutKey.mock.funcDropCheckoutKey != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Set")
+	}
+
+	if mmDropCheckoutKey.defaultExpectation == nil {
+		mmDropCheckoutKey.defaultExpectation = &CartRepositoryMockDropCheckoutKeyExpectation{}
+	}
+
+	if mmDropCheckoutKey.defaultExpectation.params != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Expect")
+	}
+
+	if mmDropCheckoutKey.defaultExpectation.paramPtrs == nil {
+		mmDropCheckoutKey.defaultExpectation.paramPtrs = &CartRepositoryMockDropCheckoutKeyParamPtrs{}
+	}
+	mmDropCheckoutKey.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDropCheckoutKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.DropCheckoutKey
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) ExpectUserIDParam2(userID int64) *mCartRepositoryMockDropCheckoutKey {
+	if mmDropCheckoutKey.mock.funcDropCheckoutKey != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Set")
+	}
+
+	if mmDropCheckoutKey.defaultExpectation == nil {
+		mmDropCheckoutKey.defaultExpectation = &CartRepositoryMockDropCheckoutKeyExpectation{}
+	}
+
+	if mmDropCheckoutKey.defaultExpectation.params != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Expect")
+	}
+
+	if mmDropCheckoutKey.defaultExpectation.paramPtrs == nil {
+		mmDropCheckoutKey.defaultExpectation.paramPtrs = &CartRepositoryMockDropCheckoutKeyParamPtrs{}
+	}
+	mmDropCheckoutKey.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmDropCheckoutKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.DropCheckoutKey
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Inspect(f func(ctx context.Context, userID int64)) *mCartRepositoryMockDropCheckoutKey {
+	if mmDropCheckoutKey.mock.inspectFuncDropCheckoutKey != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.DropCheckoutKey")
+	}
+
+	mmDropCheckoutKey.mock.inspectFuncDropCheckoutKey = f
+
+	return mmDropCheckoutKey
+}
+
+// Return sets up results that will be returned by CartRepository.DropCheckoutKey
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Return(err error) *CartRepositoryMock {
+	if mmDropCheckoutKey.mock.funcDropCheckoutKey != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Set")
+	}
+
+	if mmDropCheckoutKey.defaultExpectation == nil {
+		mmDropCheckoutKey.defaultExpectation = &CartRepositoryMockDropCheckoutKeyExpectation{mock: mmDropCheckoutKey.mock}
+	}
+	mmDropCheckoutKey.defaultExpectation.results = &CartRepositoryMockDropCheckoutKeyResults{err}
+	return mmDropCheckoutKey.mock
+}
+
+// Set uses given function f to mock the CartRepository.DropCheckoutKey method
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Set(f func(ctx context.Context, userID int64) (err error)) *CartRepositoryMock {
+	if mmDropCheckoutKey.defaultExpectation != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("Default expectation is already set for the CartRepository.DropCheckoutKey method")
+	}
+
+	if len(mmDropCheckoutKey.expectations) > 0 {
+		mmDropCheckoutKey.mock.t.Fatalf("Some expectations are already set for the CartRepository.DropCheckoutKey method")
+	}
+
+	mmDropCheckoutKey.mock.funcDropCheckoutKey = f
+	return mmDropCheckoutKey.mock
+}
+
+// When sets expectation for the CartRepository.DropCheckoutKey which will trigger the result defined by the following
+// Then helper
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) When(ctx context.Context, userID int64) *CartRepositoryMockDropCheckoutKeyExpectation {
+	if mmDropCheckoutKey.mock.funcDropCheckoutKey != nil {
+		mmDropCheckoutKey.mock.t.Fatalf("CartRepositoryMock.DropCheckoutKey mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockDropCheckoutKeyExpectation{
+		mock:   mmDropCheckoutKey.mock,
+		params: &CartRepositoryMockDropCheckoutKeyParams{ctx, userID},
+	}
+	mmDropCheckoutKey.expectations = append(mmDropCheckoutKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.DropCheckoutKey return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockDropCheckoutKeyExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockDropCheckoutKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.DropCheckoutKey should be invoked
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Times(n uint64) *mCartRepositoryMockDropCheckoutKey {
+	if n == 0 {
+		mmDropCheckoutKey.mock.t.Fatalf("Times of CartRepositoryMock.DropCheckoutKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDropCheckoutKey.expectedInvocations, n)
+	return mmDropCheckoutKey
+}
+
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) invocationsDone() bool {
+	if len(mmDropCheckoutKey.expectations) == 0 && mmDropCheckoutKey.defaultExpectation == nil && mmDropCheckoutKey.mock.funcDropCheckoutKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDropCheckoutKey.mock.afterDropCheckoutKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDropCheckoutKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DropCheckoutKey implements cart.CartRepository
+func (mmDropCheckoutKey *CartRepositoryMock) DropCheckoutKey(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmDropCheckoutKey.beforeDropCheckoutKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDropCheckoutKey.afterDropCheckoutKeyCounter, 1)
+
+	if mmDropCheckoutKey.inspectFuncDropCheckoutKey != nil {
+		mmDropCheckoutKey.inspectFuncDropCheckoutKey(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockDropCheckoutKeyParams{ctx, userID}
+
+	// Record call args
+	mmDropCheckoutKey.DropCheckoutKeyMock.mutex.Lock()
+	mmDropCheckoutKey.DropCheckoutKeyMock.callArgs = append(mmDropCheckoutKey.DropCheckoutKeyMock.callArgs, &mm_params)
+	mmDropCheckoutKey.DropCheckoutKeyMock.mutex.Unlock()
+
+	for _, e := range mmDropCheckoutKey.DropCheckoutKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDropCheckoutKey.DropCheckoutKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDropCheckoutKey.DropCheckoutKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDropCheckoutKey.DropCheckoutKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmDropCheckoutKey.DropCheckoutKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockDropCheckoutKeyParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDropCheckoutKey.t.Errorf("CartRepositoryMock.DropCheckoutKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDropCheckoutKey.t.Errorf("CartRepositoryMock.DropCheckoutKey got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDropCheckoutKey.t.Errorf("CartRepositoryMock.DropCheckoutKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDropCheckoutKey.DropCheckoutKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDropCheckoutKey.t.Fatal("No results are set for the CartRepositoryMock.DropCheckoutKey")
+		}
+		return (*mm_results).err
+	}
+	if mmDropCheckoutKey.funcDropCheckoutKey != nil {
+		return mmDropCheckoutKey.funcDropCheckoutKey(ctx, userID)
+	}
+	mmDropCheckoutKey.t.Fatalf("Unexpected call to CartRepositoryMock.DropCheckoutKey. %v %v", ctx, userID)
+	return
+}
+
+// DropCheckoutKeyAfterCounter returns a count of finished CartRepositoryMock.DropCheckoutKey invocations
+func (mmDropCheckoutKey *CartRepositoryMock) DropCheckoutKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDropCheckoutKey.afterDropCheckoutKeyCounter)
+}
+
+// DropCheckoutKeyBeforeCounter returns a count of CartRepositoryMock.DropCheckoutKey invocations
+func (mmDropCheckoutKey *CartRepositoryMock) DropCheckoutKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDropCheckoutKey.beforeDropCheckoutKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.DropCheckoutKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDropCheckoutKey *mCartRepositoryMockDropCheckoutKey) Calls() []*CartRepositoryMockDropCheckoutKeyParams {
+	mmDropCheckoutKey.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockDropCheckoutKeyParams, len(mmDropCheckoutKey.callArgs))
+	copy(argCopy, mmDropCheckoutKey.callArgs)
+
+	mmDropCheckoutKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDropCheckoutKeyDone returns true if the count of the DropCheckoutKey invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockDropCheckoutKeyDone() bool {
+	if m.DropCheckoutKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DropCheckoutKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DropCheckoutKeyMock.invocationsDone()
+}
+
+// MinimockDropCheckoutKeyInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockDropCheckoutKeyInspect() {
+	for _, e := range m.DropCheckoutKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.DropCheckoutKey with params: %#v", *e.params)
+		}
+	}
+
+	afterDropCheckoutKeyCounter := mm_atomic.LoadUint64(&m.afterDropCheckoutKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DropCheckoutKeyMock.defaultExpectation != nil && afterDropCheckoutKeyCounter < 1 {
+		if m.DropCheckoutKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CartRepositoryMock.DropCheckoutKey")
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.DropCheckoutKey with params: %#v", *m.DropCheckoutKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDropCheckoutKey != nil && afterDropCheckoutKeyCounter < 1 {
+		m.t.Error("Expected call to CartRepositoryMock.DropCheckoutKey")
+	}
+
+	if !m.DropCheckoutKeyMock.invocationsDone() && afterDropCheckoutKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.DropCheckoutKey but found %d calls",
+			mm_atomic.LoadUint64(&m.DropCheckoutKeyMock.expectedInvocations), afterDropCheckoutKeyCounter)
+	}
+}
+
 type mCartRepositoryMockGetCart struct {
 	optional           bool
 	mock               *CartRepositoryMock
@@ -1441,10 +2128,14 @@ func (m *CartRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddInspect()
 
+			m.MinimockCheckoutKeyInspect()
+
 			m.MinimockDeleteItemInspect()
 
 			m.MinimockDeleteItemsByUserIDInspect()
 
+			m.MinimockDropCheckoutKeyInspect()
+
 			m.MinimockGetCartInspect()
 		}
 	})
@@ -1470,7 +2161,9 @@ func (m *CartRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddDone() &&
+		m.MinimockCheckoutKeyDone() &&
 		m.MinimockDeleteItemDone() &&
 		m.MinimockDeleteItemsByUserIDDone() &&
+		m.MinimockDropCheckoutKeyDone() &&
 		m.MinimockGetCartDone()
 }
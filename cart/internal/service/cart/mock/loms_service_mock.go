@@ -25,11 +25,23 @@ type LomsServiceMock struct {
 	beforeOrderCreateCounter uint64
 	OrderCreateMock          mLomsServiceMockOrderCreate
 
+	funcOrdersList          func(ctx context.Context, list lomsserviceModels.OrdersList) (op1 *lomsserviceModels.OrdersListResponse, err error)
+	inspectFuncOrdersList   func(ctx context.Context, list lomsserviceModels.OrdersList)
+	afterOrdersListCounter  uint64
+	beforeOrdersListCounter uint64
+	OrdersListMock          mLomsServiceMockOrdersList
+
 	funcStocksInfo          func(ctx context.Context, sku uint32) (count uint64, err error)
 	inspectFuncStocksInfo   func(ctx context.Context, sku uint32)
 	afterStocksInfoCounter  uint64
 	beforeStocksInfoCounter uint64
 	StocksInfoMock          mLomsServiceMockStocksInfo
+
+	funcStocksInfoBatch          func(ctx context.Context, skus []uint32) (counts map[uint32]uint64, err error)
+	inspectFuncStocksInfoBatch   func(ctx context.Context, skus []uint32)
+	afterStocksInfoBatchCounter  uint64
+	beforeStocksInfoBatchCounter uint64
+	StocksInfoBatchMock          mLomsServiceMockStocksInfoBatch
 }
 
 // NewLomsServiceMock returns a mock for cart.LomsService
@@ -43,9 +55,15 @@ func NewLomsServiceMock(t minimock.Tester) *LomsServiceMock {
 	m.OrderCreateMock = mLomsServiceMockOrderCreate{mock: m}
 	m.OrderCreateMock.callArgs = []*LomsServiceMockOrderCreateParams{}
 
+	m.OrdersListMock = mLomsServiceMockOrdersList{mock: m}
+	m.OrdersListMock.callArgs = []*LomsServiceMockOrdersListParams{}
+
 	m.StocksInfoMock = mLomsServiceMockStocksInfo{mock: m}
 	m.StocksInfoMock.callArgs = []*LomsServiceMockStocksInfoParams{}
 
+	m.StocksInfoBatchMock = mLomsServiceMockStocksInfoBatch{mock: m}
+	m.StocksInfoBatchMock.callArgs = []*LomsServiceMockStocksInfoBatchParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -372,6 +390,327 @@ func (m *LomsServiceMock) MinimockOrderCreateInspect() {
 	}
 }
 
+type mLomsServiceMockOrdersList struct {
+	optional           bool
+	mock               *LomsServiceMock
+	defaultExpectation *LomsServiceMockOrdersListExpectation
+	expectations       []*LomsServiceMockOrdersListExpectation
+
+	callArgs []*LomsServiceMockOrdersListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LomsServiceMockOrdersListExpectation specifies expectation struct of the LomsService.OrdersList
+type LomsServiceMockOrdersListExpectation struct {
+	mock      *LomsServiceMock
+	params    *LomsServiceMockOrdersListParams
+	paramPtrs *LomsServiceMockOrdersListParamPtrs
+	results   *LomsServiceMockOrdersListResults
+	Counter   uint64
+}
+
+// LomsServiceMockOrdersListParams contains parameters of the LomsService.OrdersList
+type LomsServiceMockOrdersListParams struct {
+	ctx  context.Context
+	list lomsserviceModels.OrdersList
+}
+
+// LomsServiceMockOrdersListParamPtrs contains pointers to parameters of the LomsService.OrdersList
+type LomsServiceMockOrdersListParamPtrs struct {
+	ctx  *context.Context
+	list *lomsserviceModels.OrdersList
+}
+
+// LomsServiceMockOrdersListResults contains results of the LomsService.OrdersList
+type LomsServiceMockOrdersListResults struct {
+	op1 *lomsserviceModels.OrdersListResponse
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOrdersList *mLomsServiceMockOrdersList) Optional() *mLomsServiceMockOrdersList {
+	mmOrdersList.optional = true
+	return mmOrdersList
+}
+
+// Expect sets up expected params for LomsService.OrdersList
+func (mmOrdersList *mLomsServiceMockOrdersList) Expect(ctx context.Context, list lomsserviceModels.OrdersList) *mLomsServiceMockOrdersList {
+	if mmOrdersList.mock.funcOrdersList != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Set")
+	}
+
+	if mmOrdersList.defaultExpectation == nil {
+		mmOrdersList.defaultExpectation = &LomsServiceMockOrdersListExpectation{}
+	}
+
+	if mmOrdersList.defaultExpectation.paramPtrs != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by ExpectParams functions")
+	}
+
+	mmOrdersList.defaultExpectation.params = &LomsServiceMockOrdersListParams{ctx, list}
+	for _, e := range mmOrdersList.expectations {
+		if minimock.Equal(e.params, mmOrdersList.defaultExpectation.params) {
+			mmOrdersList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOrdersList.defaultExpectation.params)
+		}
+	}
+
+	return mmOrdersList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LomsService.OrdersList
+func (mmOrdersList *mLomsServiceMockOrdersList) ExpectCtxParam1(ctx context.Context) *mLomsServiceMockOrdersList {
+	if mmOrdersList.mock.funcOrdersList != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Set")
+	}
+
+	if mmOrdersList.defaultExpectation == nil {
+		mmOrdersList.defaultExpectation = &LomsServiceMockOrdersListExpectation{}
+	}
+
+	if mmOrdersList.defaultExpectation.params != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Expect")
+	}
+
+	if mmOrdersList.defaultExpectation.paramPtrs == nil {
+		mmOrdersList.defaultExpectation.paramPtrs = &LomsServiceMockOrdersListParamPtrs{}
+	}
+	mmOrdersList.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmOrdersList
+}
+
+// ExpectListParam2 sets up expected param list for LomsService.OrdersList
+func (mmOrdersList *mLomsServiceMockOrdersList) ExpectListParam2(list lomsserviceModels.OrdersList) *mLomsServiceMockOrdersList {
+	if mmOrdersList.mock.funcOrdersList != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Set")
+	}
+
+	if mmOrdersList.defaultExpectation == nil {
+		mmOrdersList.defaultExpectation = &LomsServiceMockOrdersListExpectation{}
+	}
+
+	if mmOrdersList.defaultExpectation.params != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Expect")
+	}
+
+	if mmOrdersList.defaultExpectation.paramPtrs == nil {
+		mmOrdersList.defaultExpectation.paramPtrs = &LomsServiceMockOrdersListParamPtrs{}
+	}
+	mmOrdersList.defaultExpectation.paramPtrs.list = &list
+
+	return mmOrdersList
+}
+
+// Inspect accepts an inspector function that has same arguments as the LomsService.OrdersList
+func (mmOrdersList *mLomsServiceMockOrdersList) Inspect(f func(ctx context.Context, list lomsserviceModels.OrdersList)) *mLomsServiceMockOrdersList {
+	if mmOrdersList.mock.inspectFuncOrdersList != nil {
+		mmOrdersList.mock.t.Fatalf("Inspect function is already set for LomsServiceMock.OrdersList")
+	}
+
+	mmOrdersList.mock.inspectFuncOrdersList = f
+
+	return mmOrdersList
+}
+
+// Return sets up results that will be returned by LomsService.OrdersList
+func (mmOrdersList *mLomsServiceMockOrdersList) Return(op1 *lomsserviceModels.OrdersListResponse, err error) *LomsServiceMock {
+	if mmOrdersList.mock.funcOrdersList != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Set")
+	}
+
+	if mmOrdersList.defaultExpectation == nil {
+		mmOrdersList.defaultExpectation = &LomsServiceMockOrdersListExpectation{mock: mmOrdersList.mock}
+	}
+	mmOrdersList.defaultExpectation.results = &LomsServiceMockOrdersListResults{op1, err}
+	return mmOrdersList.mock
+}
+
+// Set uses given function f to mock the LomsService.OrdersList method
+func (mmOrdersList *mLomsServiceMockOrdersList) Set(f func(ctx context.Context, list lomsserviceModels.OrdersList) (op1 *lomsserviceModels.OrdersListResponse, err error)) *LomsServiceMock {
+	if mmOrdersList.defaultExpectation != nil {
+		mmOrdersList.mock.t.Fatalf("Default expectation is already set for the LomsService.OrdersList method")
+	}
+
+	if len(mmOrdersList.expectations) > 0 {
+		mmOrdersList.mock.t.Fatalf("Some expectations are already set for the LomsService.OrdersList method")
+	}
+
+	mmOrdersList.mock.funcOrdersList = f
+	return mmOrdersList.mock
+}
+
+// When sets expectation for the LomsService.OrdersList which will trigger the result defined by the following
+// Then helper
+func (mmOrdersList *mLomsServiceMockOrdersList) When(ctx context.Context, list lomsserviceModels.OrdersList) *LomsServiceMockOrdersListExpectation {
+	if mmOrdersList.mock.funcOrdersList != nil {
+		mmOrdersList.mock.t.Fatalf("LomsServiceMock.OrdersList mock is already set by Set")
+	}
+
+	expectation := &LomsServiceMockOrdersListExpectation{
+		mock:   mmOrdersList.mock,
+		params: &LomsServiceMockOrdersListParams{ctx, list},
+	}
+	mmOrdersList.expectations = append(mmOrdersList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LomsService.OrdersList return parameters for the expectation previously defined by the When method
+func (e *LomsServiceMockOrdersListExpectation) Then(op1 *lomsserviceModels.OrdersListResponse, err error) *LomsServiceMock {
+	e.results = &LomsServiceMockOrdersListResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times LomsService.OrdersList should be invoked
+func (mmOrdersList *mLomsServiceMockOrdersList) Times(n uint64) *mLomsServiceMockOrdersList {
+	if n == 0 {
+		mmOrdersList.mock.t.Fatalf("Times of LomsServiceMock.OrdersList mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOrdersList.expectedInvocations, n)
+	return mmOrdersList
+}
+
+func (mmOrdersList *mLomsServiceMockOrdersList) invocationsDone() bool {
+	if len(mmOrdersList.expectations) == 0 && mmOrdersList.defaultExpectation == nil && mmOrdersList.mock.funcOrdersList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOrdersList.mock.afterOrdersListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOrdersList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OrdersList implements cart.LomsService
+func (mmOrdersList *LomsServiceMock) OrdersList(ctx context.Context, list lomsserviceModels.OrdersList) (op1 *lomsserviceModels.OrdersListResponse, err error) {
+	mm_atomic.AddUint64(&mmOrdersList.beforeOrdersListCounter, 1)
+	defer mm_atomic.AddUint64(&mmOrdersList.afterOrdersListCounter, 1)
+
+	if mmOrdersList.inspectFuncOrdersList != nil {
+		mmOrdersList.inspectFuncOrdersList(ctx, list)
+	}
+
+	mm_params := LomsServiceMockOrdersListParams{ctx, list}
+
+	// Record call args
+	mmOrdersList.OrdersListMock.mutex.Lock()
+	mmOrdersList.OrdersListMock.callArgs = append(mmOrdersList.OrdersListMock.callArgs, &mm_params)
+	mmOrdersList.OrdersListMock.mutex.Unlock()
+
+	for _, e := range mmOrdersList.OrdersListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmOrdersList.OrdersListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOrdersList.OrdersListMock.defaultExpectation.Counter, 1)
+		mm_want := mmOrdersList.OrdersListMock.defaultExpectation.params
+		mm_want_ptrs := mmOrdersList.OrdersListMock.defaultExpectation.paramPtrs
+
+		mm_got := LomsServiceMockOrdersListParams{ctx, list}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOrdersList.t.Errorf("LomsServiceMock.OrdersList got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.list != nil && !minimock.Equal(*mm_want_ptrs.list, mm_got.list) {
+				mmOrdersList.t.Errorf("LomsServiceMock.OrdersList got unexpected parameter list, want: %#v, got: %#v%s\n", *mm_want_ptrs.list, mm_got.list, minimock.Diff(*mm_want_ptrs.list, mm_got.list))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOrdersList.t.Errorf("LomsServiceMock.OrdersList got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOrdersList.OrdersListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOrdersList.t.Fatal("No results are set for the LomsServiceMock.OrdersList")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmOrdersList.funcOrdersList != nil {
+		return mmOrdersList.funcOrdersList(ctx, list)
+	}
+	mmOrdersList.t.Fatalf("Unexpected call to LomsServiceMock.OrdersList. %v %v", ctx, list)
+	return
+}
+
+// OrdersListAfterCounter returns a count of finished LomsServiceMock.OrdersList invocations
+func (mmOrdersList *LomsServiceMock) OrdersListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOrdersList.afterOrdersListCounter)
+}
+
+// OrdersListBeforeCounter returns a count of LomsServiceMock.OrdersList invocations
+func (mmOrdersList *LomsServiceMock) OrdersListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOrdersList.beforeOrdersListCounter)
+}
+
+// Calls returns a list of arguments used in each call to LomsServiceMock.OrdersList.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOrdersList *mLomsServiceMockOrdersList) Calls() []*LomsServiceMockOrdersListParams {
+	mmOrdersList.mutex.RLock()
+
+	argCopy := make([]*LomsServiceMockOrdersListParams, len(mmOrdersList.callArgs))
+	copy(argCopy, mmOrdersList.callArgs)
+
+	mmOrdersList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOrdersListDone returns true if the count of the OrdersList invocations corresponds
+// the number of defined expectations
+func (m *LomsServiceMock) MinimockOrdersListDone() bool {
+	if m.OrdersListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OrdersListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OrdersListMock.invocationsDone()
+}
+
+// MinimockOrdersListInspect logs each unmet expectation
+func (m *LomsServiceMock) MinimockOrdersListInspect() {
+	for _, e := range m.OrdersListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LomsServiceMock.OrdersList with params: %#v", *e.params)
+		}
+	}
+
+	afterOrdersListCounter := mm_atomic.LoadUint64(&m.afterOrdersListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OrdersListMock.defaultExpectation != nil && afterOrdersListCounter < 1 {
+		if m.OrdersListMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LomsServiceMock.OrdersList")
+		} else {
+			m.t.Errorf("Expected call to LomsServiceMock.OrdersList with params: %#v", *m.OrdersListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOrdersList != nil && afterOrdersListCounter < 1 {
+		m.t.Error("Expected call to LomsServiceMock.OrdersList")
+	}
+
+	if !m.OrdersListMock.invocationsDone() && afterOrdersListCounter > 0 {
+		m.t.Errorf("Expected %d calls to LomsServiceMock.OrdersList but found %d calls",
+			mm_atomic.LoadUint64(&m.OrdersListMock.expectedInvocations), afterOrdersListCounter)
+	}
+}
+
 type mLomsServiceMockStocksInfo struct {
 	optional           bool
 	mock               *LomsServiceMock
@@ -693,13 +1032,338 @@ func (m *LomsServiceMock) MinimockStocksInfoInspect() {
 	}
 }
 
+type mLomsServiceMockStocksInfoBatch struct {
+	optional           bool
+	mock               *LomsServiceMock
+	defaultExpectation *LomsServiceMockStocksInfoBatchExpectation
+	expectations       []*LomsServiceMockStocksInfoBatchExpectation
+
+	callArgs []*LomsServiceMockStocksInfoBatchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LomsServiceMockStocksInfoBatchExpectation specifies expectation struct of the LomsService.StocksInfoBatch
+type LomsServiceMockStocksInfoBatchExpectation struct {
+	mock      *LomsServiceMock
+	params    *LomsServiceMockStocksInfoBatchParams
+	paramPtrs *LomsServiceMockStocksInfoBatchParamPtrs
+	results   *LomsServiceMockStocksInfoBatchResults
+	Counter   uint64
+}
+
+// LomsServiceMockStocksInfoBatchParams contains parameters of the LomsService.StocksInfoBatch
+type LomsServiceMockStocksInfoBatchParams struct {
+	ctx  context.Context
+	skus []uint32
+}
+
+// LomsServiceMockStocksInfoBatchParamPtrs contains pointers to parameters of the LomsService.StocksInfoBatch
+type LomsServiceMockStocksInfoBatchParamPtrs struct {
+	ctx  *context.Context
+	skus *[]uint32
+}
+
+// LomsServiceMockStocksInfoBatchResults contains results of the LomsService.StocksInfoBatch
+type LomsServiceMockStocksInfoBatchResults struct {
+	counts map[uint32]uint64
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Optional() *mLomsServiceMockStocksInfoBatch {
+	mmStocksInfoBatch.optional = true
+	return mmStocksInfoBatch
+}
+
+// Expect sets up expected params for LomsService.StocksInfoBatch
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Expect(ctx context.Context, skus []uint32) *mLomsServiceMockStocksInfoBatch {
+	if mmStocksInfoBatch.mock.funcStocksInfoBatch != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Set")
+	}
+
+	if mmStocksInfoBatch.defaultExpectation == nil {
+		mmStocksInfoBatch.defaultExpectation = &LomsServiceMockStocksInfoBatchExpectation{}
+	}
+
+	if mmStocksInfoBatch.defaultExpectation.paramPtrs != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by ExpectParams functions")
+	}
+
+	mmStocksInfoBatch.defaultExpectation.params = &LomsServiceMockStocksInfoBatchParams{ctx, skus}
+	for _, e := range mmStocksInfoBatch.expectations {
+		if minimock.Equal(e.params, mmStocksInfoBatch.defaultExpectation.params) {
+			mmStocksInfoBatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStocksInfoBatch.defaultExpectation.params)
+		}
+	}
+
+	return mmStocksInfoBatch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LomsService.StocksInfoBatch
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) ExpectCtxParam1(ctx context.Context) *mLomsServiceMockStocksInfoBatch {
+	if mmStocksInfoBatch.mock.funcStocksInfoBatch != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Set")
+	}
+
+	if mmStocksInfoBatch.defaultExpectation == nil {
+		mmStocksInfoBatch.defaultExpectation = &LomsServiceMockStocksInfoBatchExpectation{}
+	}
+
+	if mmStocksInfoBatch.defaultExpectation.params != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Expect")
+	}
+
+	if mmStocksInfoBatch.defaultExpectation.paramPtrs == nil {
+		mmStocksInfoBatch.defaultExpectation.paramPtrs = &LomsServiceMockStocksInfoBatchParamPtrs{}
+	}
+	mmStocksInfoBatch.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmStocksInfoBatch
+}
+
+// ExpectSkusParam2 sets up expected param skus for LomsService.StocksInfoBatch
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) ExpectSkusParam2(skus []uint32) *mLomsServiceMockStocksInfoBatch {
+	if mmStocksInfoBatch.mock.funcStocksInfoBatch != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Set")
+	}
+
+	if mmStocksInfoBatch.defaultExpectation == nil {
+		mmStocksInfoBatch.defaultExpectation = &LomsServiceMockStocksInfoBatchExpectation{}
+	}
+
+	if mmStocksInfoBatch.defaultExpectation.params != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Expect")
+	}
+
+	if mmStocksInfoBatch.defaultExpectation.paramPtrs == nil {
+		mmStocksInfoBatch.defaultExpectation.paramPtrs = &LomsServiceMockStocksInfoBatchParamPtrs{}
+	}
+	mmStocksInfoBatch.defaultExpectation.paramPtrs.skus = &skus
+
+	return mmStocksInfoBatch
+}
+
+// Inspect accepts an inspector function that has same arguments as the LomsService.StocksInfoBatch
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Inspect(f func(ctx context.Context, skus []uint32)) *mLomsServiceMockStocksInfoBatch {
+	if mmStocksInfoBatch.mock.inspectFuncStocksInfoBatch != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("Inspect function is already set for LomsServiceMock.StocksInfoBatch")
+	}
+
+	mmStocksInfoBatch.mock.inspectFuncStocksInfoBatch = f
+
+	return mmStocksInfoBatch
+}
+
+// Return sets up results that will be returned by LomsService.StocksInfoBatch
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Return(counts map[uint32]uint64, err error) *LomsServiceMock {
+	if mmStocksInfoBatch.mock.funcStocksInfoBatch != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Set")
+	}
+
+	if mmStocksInfoBatch.defaultExpectation == nil {
+		mmStocksInfoBatch.defaultExpectation = &LomsServiceMockStocksInfoBatchExpectation{mock: mmStocksInfoBatch.mock}
+	}
+	mmStocksInfoBatch.defaultExpectation.results = &LomsServiceMockStocksInfoBatchResults{counts, err}
+	return mmStocksInfoBatch.mock
+}
+
+// Set uses given function f to mock the LomsService.StocksInfoBatch method
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Set(f func(ctx context.Context, skus []uint32) (counts map[uint32]uint64, err error)) *LomsServiceMock {
+	if mmStocksInfoBatch.defaultExpectation != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("Default expectation is already set for the LomsService.StocksInfoBatch method")
+	}
+
+	if len(mmStocksInfoBatch.expectations) > 0 {
+		mmStocksInfoBatch.mock.t.Fatalf("Some expectations are already set for the LomsService.StocksInfoBatch method")
+	}
+
+	mmStocksInfoBatch.mock.funcStocksInfoBatch = f
+	return mmStocksInfoBatch.mock
+}
+
+// When sets expectation for the LomsService.StocksInfoBatch which will trigger the result defined by the following
+// Then helper
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) When(ctx context.Context, skus []uint32) *LomsServiceMockStocksInfoBatchExpectation {
+	if mmStocksInfoBatch.mock.funcStocksInfoBatch != nil {
+		mmStocksInfoBatch.mock.t.Fatalf("LomsServiceMock.StocksInfoBatch mock is already set by Set")
+	}
+
+	expectation := &LomsServiceMockStocksInfoBatchExpectation{
+		mock:   mmStocksInfoBatch.mock,
+		params: &LomsServiceMockStocksInfoBatchParams{ctx, skus},
+	}
+	mmStocksInfoBatch.expectations = append(mmStocksInfoBatch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LomsService.StocksInfoBatch return parameters for the expectation previously defined by the When method
+func (e *LomsServiceMockStocksInfoBatchExpectation) Then(counts map[uint32]uint64, err error) *LomsServiceMock {
+	e.results = &LomsServiceMockStocksInfoBatchResults{counts, err}
+	return e.mock
+}
+
+// Times sets number of times LomsService.StocksInfoBatch should be invoked
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Times(n uint64) *mLomsServiceMockStocksInfoBatch {
+	if n == 0 {
+		mmStocksInfoBatch.mock.t.Fatalf("Times of LomsServiceMock.StocksInfoBatch mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStocksInfoBatch.expectedInvocations, n)
+	return mmStocksInfoBatch
+}
+
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) invocationsDone() bool {
+	if len(mmStocksInfoBatch.expectations) == 0 && mmStocksInfoBatch.defaultExpectation == nil && mmStocksInfoBatch.mock.funcStocksInfoBatch == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStocksInfoBatch.mock.afterStocksInfoBatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStocksInfoBatch.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StocksInfoBatch implements cart.LomsService
+func (mmStocksInfoBatch *LomsServiceMock) StocksInfoBatch(ctx context.Context, skus []uint32) (counts map[uint32]uint64, err error) {
+	mm_atomic.AddUint64(&mmStocksInfoBatch.beforeStocksInfoBatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmStocksInfoBatch.afterStocksInfoBatchCounter, 1)
+
+	if mmStocksInfoBatch.inspectFuncStocksInfoBatch != nil {
+		mmStocksInfoBatch.inspectFuncStocksInfoBatch(ctx, skus)
+	}
+
+	mm_params := LomsServiceMockStocksInfoBatchParams{ctx, skus}
+
+	// Record call args
+	mmStocksInfoBatch.StocksInfoBatchMock.mutex.Lock()
+	mmStocksInfoBatch.StocksInfoBatchMock.callArgs = append(mmStocksInfoBatch.StocksInfoBatchMock.callArgs, &mm_params)
+	mmStocksInfoBatch.StocksInfoBatchMock.mutex.Unlock()
+
+	for _, e := range mmStocksInfoBatch.StocksInfoBatchMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.counts, e.results.err
+		}
+	}
+
+	if mmStocksInfoBatch.StocksInfoBatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStocksInfoBatch.StocksInfoBatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmStocksInfoBatch.StocksInfoBatchMock.defaultExpectation.params
+		mm_want_ptrs := mmStocksInfoBatch.StocksInfoBatchMock.defaultExpectation.paramPtrs
+
+		mm_got := LomsServiceMockStocksInfoBatchParams{ctx, skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStocksInfoBatch.t.Errorf("LomsServiceMock.StocksInfoBatch got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmStocksInfoBatch.t.Errorf("LomsServiceMock.StocksInfoBatch got unexpected parameter skus, want: %#v, got: %#v%s\n", *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStocksInfoBatch.t.Errorf("LomsServiceMock.StocksInfoBatch got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStocksInfoBatch.StocksInfoBatchMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStocksInfoBatch.t.Fatal("No results are set for the LomsServiceMock.StocksInfoBatch")
+		}
+		return (*mm_results).counts, (*mm_results).err
+	}
+	if mmStocksInfoBatch.funcStocksInfoBatch != nil {
+		return mmStocksInfoBatch.funcStocksInfoBatch(ctx, skus)
+	}
+	mmStocksInfoBatch.t.Fatalf("Unexpected call to LomsServiceMock.StocksInfoBatch. %v %v", ctx, skus)
+	return
+}
+
+// StocksInfoBatchAfterCounter returns a count of finished LomsServiceMock.StocksInfoBatch invocations
+func (mmStocksInfoBatch *LomsServiceMock) StocksInfoBatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStocksInfoBatch.afterStocksInfoBatchCounter)
+}
+
+// StocksInfoBatchBeforeCounter returns a count of LomsServiceMock.StocksInfoBatch invocations
+func (mmStocksInfoBatch *LomsServiceMock) StocksInfoBatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStocksInfoBatch.beforeStocksInfoBatchCounter)
+}
+
+// Calls returns a list of arguments used in each call to LomsServiceMock.StocksInfoBatch.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStocksInfoBatch *mLomsServiceMockStocksInfoBatch) Calls() []*LomsServiceMockStocksInfoBatchParams {
+	mmStocksInfoBatch.mutex.RLock()
+
+	argCopy := make([]*LomsServiceMockStocksInfoBatchParams, len(mmStocksInfoBatch.callArgs))
+	copy(argCopy, mmStocksInfoBatch.callArgs)
+
+	mmStocksInfoBatch.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStocksInfoBatchDone returns true if the count of the StocksInfoBatch invocations corresponds
+// the number of defined expectations
+func (m *LomsServiceMock) MinimockStocksInfoBatchDone() bool {
+	if m.StocksInfoBatchMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StocksInfoBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StocksInfoBatchMock.invocationsDone()
+}
+
+// MinimockStocksInfoBatchInspect logs each unmet expectation
+func (m *LomsServiceMock) MinimockStocksInfoBatchInspect() {
+	for _, e := range m.StocksInfoBatchMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LomsServiceMock.StocksInfoBatch with params: %#v", *e.params)
+		}
+	}
+
+	afterStocksInfoBatchCounter := mm_atomic.LoadUint64(&m.afterStocksInfoBatchCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StocksInfoBatchMock.defaultExpectation != nil && afterStocksInfoBatchCounter < 1 {
+		if m.StocksInfoBatchMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LomsServiceMock.StocksInfoBatch")
+		} else {
+			m.t.Errorf("Expected call to LomsServiceMock.StocksInfoBatch with params: %#v", *m.StocksInfoBatchMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStocksInfoBatch != nil && afterStocksInfoBatchCounter < 1 {
+		m.t.Error("Expected call to LomsServiceMock.StocksInfoBatch")
+	}
+
+	if !m.StocksInfoBatchMock.invocationsDone() && afterStocksInfoBatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to LomsServiceMock.StocksInfoBatch but found %d calls",
+			mm_atomic.LoadUint64(&m.StocksInfoBatchMock.expectedInvocations), afterStocksInfoBatchCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *LomsServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockOrderCreateInspect()
 
+			m.MinimockOrdersListInspect()
+
 			m.MinimockStocksInfoInspect()
+
+			m.MinimockStocksInfoBatchInspect()
 		}
 	})
 }
@@ -724,5 +1388,7 @@ func (m *LomsServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockOrderCreateDone() &&
-		m.MinimockStocksInfoDone()
+		m.MinimockOrdersListDone() &&
+		m.MinimockStocksInfoDone() &&
+		m.MinimockStocksInfoBatchDone()
 }
@@ -0,0 +1,64 @@
+package cart
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	lomsserviceModels "github.com/BruteMors/marketplace-service/cart/pkg/lomsservice/models"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *Service) OrdersList(ctx context.Context, userID int64, filter models.OrdersFilter) (page *models.OrdersPage, err error) {
+	tr := otel.Tracer("cartService")
+	ctx, span := tr.Start(ctx, "OrdersList")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	response, err := s.lomsService.OrdersList(ctx, lomsserviceModels.OrdersList{
+		User:        userID,
+		Statuses:    filter.Statuses,
+		CreatedFrom: filter.CreatedFrom,
+		CreatedTo:   filter.CreatedTo,
+		Cursor:      filter.Cursor,
+		Limit:       filter.Limit,
+	})
+	if err != nil {
+		if errors.Is(err, lomsserviceModels.ErrInvalidArgument) {
+			err = models.ErrInvalidOrdersFilter
+			return nil, err
+		}
+		return nil, err
+	}
+
+	page = &models.OrdersPage{
+		Orders:     make([]models.Order, 0, len(response.Orders)),
+		NextCursor: response.NextCursor,
+	}
+
+	for _, order := range response.Orders {
+		items := make([]models.ItemCount, 0, len(order.Items))
+		for _, item := range order.Items {
+			items = append(items, models.ItemCount{
+				SkuID: int64(item.SkuID),
+				Count: uint16(item.Count),
+			})
+		}
+
+		page.Orders = append(page.Orders, models.Order{
+			ID:        order.ID,
+			Status:    order.Status,
+			Items:     items,
+			CreatedAt: order.CreatedAt,
+			UpdatedAt: order.UpdatedAt,
+		})
+	}
+
+	return page, nil
+}
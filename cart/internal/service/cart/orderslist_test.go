@@ -0,0 +1,122 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	"github.com/BruteMors/marketplace-service/cart/internal/service/cart/mock"
+	lomsserviceModels "github.com/BruteMors/marketplace-service/cart/pkg/lomsservice/models"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrdersList(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+
+	lomsServiceMock := mock.NewLomsServiceMock(mc)
+	s := &Service{
+		lomsService: lomsServiceMock,
+	}
+
+	ctx := context.Background()
+
+	createdFrom := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		userID           int64
+		filter           models.OrdersFilter
+		mockLomsFunc     func()
+		expectedResponse *models.OrdersPage
+		expectedError    error
+	}{
+		{
+			name:   "invalid filter",
+			userID: 1,
+			filter: models.OrdersFilter{Cursor: "broken"},
+			mockLomsFunc: func() {
+				lomsServiceMock.OrdersListMock.Expect(minimock.AnyContext, lomsserviceModels.OrdersList{
+					User:   1,
+					Cursor: "broken",
+				}).Return(nil, fmt.Errorf("%w: invalid cursor", lomsserviceModels.ErrInvalidArgument))
+			},
+			expectedResponse: nil,
+			expectedError:    models.ErrInvalidOrdersFilter,
+		},
+		{
+			name:   "loms service error",
+			userID: 2,
+			filter: models.OrdersFilter{},
+			mockLomsFunc: func() {
+				lomsServiceMock.OrdersListMock.Expect(minimock.AnyContext, lomsserviceModels.OrdersList{
+					User: 2,
+				}).Return(nil, errors.New("loms service error"))
+			},
+			expectedResponse: nil,
+			expectedError:    errors.New("loms service error"),
+		},
+		{
+			name:   "successful orders list",
+			userID: 3,
+			filter: models.OrdersFilter{
+				Statuses:    []string{"payed"},
+				CreatedFrom: &createdFrom,
+				Limit:       10,
+			},
+			mockLomsFunc: func() {
+				lomsServiceMock.OrdersListMock.Expect(minimock.AnyContext, lomsserviceModels.OrdersList{
+					User:        3,
+					Statuses:    []string{"payed"},
+					CreatedFrom: &createdFrom,
+					Limit:       10,
+				}).Return(&lomsserviceModels.OrdersListResponse{
+					Orders: []lomsserviceModels.Order{
+						{
+							ID:        10,
+							Status:    "payed",
+							User:      3,
+							Items:     []lomsserviceModels.OrderItem{{SkuID: 1000, Count: 2}},
+							CreatedAt: createdAt,
+							UpdatedAt: &createdAt,
+						},
+					},
+					NextCursor: "next",
+				}, nil)
+			},
+			expectedResponse: &models.OrdersPage{
+				Orders: []models.Order{
+					{
+						ID:        10,
+						Status:    "payed",
+						Items:     []models.ItemCount{{SkuID: 1000, Count: 2}},
+						CreatedAt: createdAt,
+						UpdatedAt: &createdAt,
+					},
+				},
+				NextCursor: "next",
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockLomsFunc()
+			response, err := s.OrdersList(ctx, tt.userID, tt.filter)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+		})
+	}
+}
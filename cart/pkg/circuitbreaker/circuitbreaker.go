@@ -0,0 +1,186 @@
+package circuitbreaker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+)
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// Outcome is the result of a call the breaker let through.
+type Outcome int
+
+const (
+	Success Outcome = iota
+	Failure
+	// Ignored releases the call without counting it, e.g. when the caller cancelled it.
+	Ignored
+)
+
+// Error is returned instead of calling the protected resource while the breaker is open.
+type Error struct {
+	Name string
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("circuit breaker %s is open", e.Name)
+}
+
+// Breaker opens after failureThreshold consecutive failures and rejects calls for openTimeout.
+// Then it lets up to halfOpenRequests trial calls through and closes once all of them succeed.
+type Breaker struct {
+	mutex            sync.Mutex
+	name             string
+	failureThreshold int
+	openTimeout      time.Duration
+	halfOpenRequests int
+	state            State
+	generation       uint64
+	failures         int
+	successes        int
+	inFlight         int
+	openedAt         time.Time
+	now              func() time.Time
+}
+
+func New(name string, failureThreshold int, openTimeout time.Duration, halfOpenRequests int) *Breaker {
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+
+	if halfOpenRequests < 1 {
+		halfOpenRequests = 1
+	}
+
+	b := &Breaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		halfOpenRequests: halfOpenRequests,
+		now:              time.Now,
+	}
+
+	metric.SetCircuitBreakerState(name, float64(StateClosed))
+
+	return b
+}
+
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+func (b *Breaker) State() State {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refresh()
+
+	return b.state
+}
+
+// Allow reports whether a call may proceed. On success the caller must invoke done
+// with the outcome of the call.
+func (b *Breaker) Allow() (done func(outcome Outcome), err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refresh()
+
+	switch b.state {
+	case StateOpen:
+		return nil, Error{Name: b.name}
+	case StateHalfOpen:
+		if b.inFlight >= b.halfOpenRequests {
+			return nil, Error{Name: b.name}
+		}
+		b.inFlight++
+	}
+
+	generation := b.generation
+
+	return func(outcome Outcome) {
+		b.done(generation, outcome)
+	}, nil
+}
+
+func (b *Breaker) done(generation uint64, outcome Outcome) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case StateClosed:
+		switch outcome {
+		case Success:
+			b.failures = 0
+			return
+		case Ignored:
+			return
+		}
+
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.setState(StateOpen)
+		}
+	case StateHalfOpen:
+		b.inFlight--
+
+		switch outcome {
+		case Ignored:
+			return
+		case Failure:
+			b.setState(StateOpen)
+			return
+		}
+
+		b.successes++
+		if b.successes >= b.halfOpenRequests {
+			b.setState(StateClosed)
+		}
+	}
+}
+
+func (b *Breaker) refresh() {
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.openTimeout {
+		b.setState(StateHalfOpen)
+	}
+}
+
+func (b *Breaker) setState(state State) {
+	b.state = state
+	b.generation++
+	b.failures = 0
+	b.successes = 0
+	b.inFlight = 0
+
+	if state == StateOpen {
+		b.openedAt = b.now()
+	}
+
+	metric.SetCircuitBreakerState(b.name, float64(state))
+}
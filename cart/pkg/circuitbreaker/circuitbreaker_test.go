@@ -0,0 +1,135 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv("NAMESPACE", "test")
+	_ = os.Setenv("APP_NAME", "cart")
+
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newTestBreaker(failureThreshold int, halfOpenRequests int) (*Breaker, *time.Time) {
+	now := time.Now()
+	b := New("test", failureThreshold, time.Second, halfOpenRequests)
+	b.now = func() time.Time { return now }
+
+	return b, &now
+}
+
+func call(t *testing.T, b *Breaker, outcome Outcome) {
+	t.Helper()
+
+	done, err := b.Allow()
+	require.NoError(t, err)
+	done(outcome)
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	b, _ := newTestBreaker(3, 1)
+
+	call(t, b, Failure)
+	call(t, b, Failure)
+	call(t, b, Success)
+	call(t, b, Failure)
+	call(t, b, Failure)
+	assert.Equal(t, StateClosed, b.State())
+
+	call(t, b, Failure)
+	assert.Equal(t, StateOpen, b.State())
+
+	_, err := b.Allow()
+	assert.True(t, errors.As(err, &Error{}))
+	assert.EqualError(t, err, "circuit breaker test is open")
+}
+
+func TestBreakerDoesNotCountIgnoredCalls(t *testing.T) {
+	b, _ := newTestBreaker(2, 1)
+
+	call(t, b, Failure)
+	call(t, b, Ignored)
+	call(t, b, Ignored)
+	assert.Equal(t, StateClosed, b.State())
+
+	call(t, b, Failure)
+	assert.Equal(t, StateOpen, b.State())
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name          string
+		results       []Outcome
+		expectedState State
+	}{
+		{
+			name:          "closes after successful trial calls",
+			results:       []Outcome{Success, Success},
+			expectedState: StateClosed,
+		},
+		{
+			name:          "reopens after failed trial call",
+			results:       []Outcome{Success, Failure},
+			expectedState: StateOpen,
+		},
+		{
+			name:          "stays half-open after ignored trial call",
+			results:       []Outcome{Success, Ignored},
+			expectedState: StateHalfOpen,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, now := newTestBreaker(1, 2)
+
+			call(t, b, Failure)
+			require.Equal(t, StateOpen, b.State())
+
+			*now = now.Add(time.Second)
+			require.Equal(t, StateHalfOpen, b.State())
+
+			dones := make([]func(Outcome), 0, len(tt.results))
+			for range tt.results {
+				done, err := b.Allow()
+				require.NoError(t, err)
+				dones = append(dones, done)
+			}
+
+			_, err := b.Allow()
+			assert.ErrorAs(t, err, &Error{})
+
+			for i, done := range dones {
+				done(tt.results[i])
+			}
+
+			assert.Equal(t, tt.expectedState, b.State())
+		})
+	}
+}
+
+func TestBreakerIgnoresResultsFromPreviousState(t *testing.T) {
+	b, _ := newTestBreaker(1, 1)
+
+	staleDone, err := b.Allow()
+	require.NoError(t, err)
+
+	call(t, b, Failure)
+	require.Equal(t, StateOpen, b.State())
+
+	staleDone(Success)
+	assert.Equal(t, StateOpen, b.State())
+}
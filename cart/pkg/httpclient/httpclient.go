@@ -1,10 +1,12 @@
 package httpclient
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/pkg/circuitbreaker"
 )
 
 type HttpClient struct {
@@ -12,46 +14,146 @@ type HttpClient struct {
 }
 
 type retryTransport struct {
-	transport  http.RoundTripper
-	retries    int
-	retryDelay time.Duration
+	transport http.RoundTripper
+	policy    RetryPolicy
+	now       func() time.Time
 }
 
 func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	var resp *http.Response
-	var err error
+	ctx := req.Context()
 
-	for i := 0; i <= rt.retries; i++ {
-		start := time.Now()
-		resp, err = rt.transport.RoundTrip(req)
-		duration := time.Since(start).Seconds()
+	var budgetDeadline time.Time
+	if rt.policy.Budget > 0 {
+		budgetDeadline = rt.now().Add(rt.policy.Budget)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := rt.roundTrip(req)
+		if attempt >= rt.policy.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
 
-		status := "error"
-		if resp != nil {
-			status = http.StatusText(resp.StatusCode)
+		delay := rt.policy.backoff(attempt)
+		if retryAfter, ok := rt.policy.retryAfter(resp, rt.now()); ok {
+			delay = retryAfter
 		}
 
-		metric.IncExternalRequestCounter(status, req.URL.Path)
-		metric.ObserveExternalResponseTime(status, req.URL.Path, duration)
+		wakeUp := rt.now().Add(delay)
+		if !budgetDeadline.IsZero() && wakeUp.After(budgetDeadline) {
+			return resp, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && wakeUp.After(deadline) {
+			return resp, err
+		}
 
-		if err == nil && resp != nil && resp.StatusCode != 420 && resp.StatusCode != 429 {
-			return resp, nil
+		nextReq, ok := rewindRequest(req)
+		if !ok {
+			return resp, err
 		}
 
-		time.Sleep(rt.retryDelay)
+		drainAndClose(resp)
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		req = nextReq
 	}
+}
+
+func (rt *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rt.transport.RoundTrip(req)
+	duration := time.Since(start).Seconds()
+
+	status := "error"
+	if resp != nil {
+		status = http.StatusText(resp.StatusCode)
+	}
+
+	metric.IncExternalRequestCounter(status, req.URL.Path)
+	metric.ObserveExternalResponseTime(status, req.URL.Path, duration)
 
 	return resp, err
 }
 
-func New(timeout time.Duration, retries int, retryDelay time.Duration) *HttpClient {
+// Limiter throttles outbound requests.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// limiterTransport sits under the retries, so every attempt waits for the limiter.
+type limiterTransport struct {
+	transport http.RoundTripper
+	limiter   Limiter
+}
+
+func (lt *limiterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := lt.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return lt.transport.RoundTrip(req)
+}
+
+type circuitBreakerTransport struct {
+	transport http.RoundTripper
+	breaker   *circuitbreaker.Breaker
+}
+
+func (ct *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	done, err := ct.breaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ct.transport.RoundTrip(req)
+	done(roundTripOutcome(req, resp, err))
+
+	return resp, err
+}
+
+// roundTripOutcome does not count requests the caller cancelled or let time out,
+// they say nothing about the upstream.
+func roundTripOutcome(req *http.Request, resp *http.Response, err error) circuitbreaker.Outcome {
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		return circuitbreaker.Ignored
+	case err != nil || resp.StatusCode >= http.StatusInternalServerError:
+		return circuitbreaker.Failure
+	default:
+		return circuitbreaker.Success
+	}
+}
+
+// New creates an HTTP client retrying requests according to policy. A nil breaker disables the circuit breaker,
+// a nil limiter disables throttling.
+func New(timeout time.Duration, policy RetryPolicy, breaker *circuitbreaker.Breaker, limiter Limiter) *HttpClient {
+	var transport http.RoundTripper = http.DefaultTransport
+	if limiter != nil {
+		transport = &limiterTransport{
+			transport: transport,
+			limiter:   limiter,
+		}
+	}
+
+	transport = &retryTransport{
+		transport: transport,
+		policy:    policy,
+		now:       time.Now,
+	}
+
+	if breaker != nil {
+		transport = &circuitBreakerTransport{
+			transport: transport,
+			breaker:   breaker,
+		}
+	}
+
 	httpClient := http.Client{
-		Timeout: timeout,
-		Transport: &retryTransport{
-			transport:  http.DefaultTransport,
-			retries:    retries,
-			retryDelay: retryDelay,
-		},
+		Timeout:   timeout,
+		Transport: transport,
 	}
 
 	return &HttpClient{
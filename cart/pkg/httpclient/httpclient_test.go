@@ -0,0 +1,372 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/pkg/circuitbreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv("NAMESPACE", "test")
+	_ = os.Setenv("APP_NAME", "cart")
+
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestHttpClientCircuitBreaker(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	breaker := circuitbreaker.New("test", 2, time.Minute, 1)
+	client := New(time.Second, RetryPolicy{}, breaker, nil)
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+
+	_, err := client.Get(server.URL)
+	require.Error(t, err)
+	assert.True(t, errors.As(err, &circuitbreaker.Error{}))
+	assert.Equal(t, int64(2), requests.Load())
+	assert.Equal(t, circuitbreaker.StateOpen, breaker.State())
+}
+
+func TestHttpClientCircuitBreakerIgnoresCancelledRequests(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	breaker := circuitbreaker.New("test", 1, time.Minute, 1)
+	client := New(time.Second, RetryPolicy{}, breaker, nil)
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		_, err = client.Do(req)
+		cancel()
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+
+	assert.Equal(t, circuitbreaker.StateClosed, breaker.State())
+}
+
+func newFlakyServer(t *testing.T, failures int64, status int, header http.Header) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		if requests.Add(1) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			return
+		}
+
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestHttpClientRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name             string
+		method           string
+		ctx              context.Context
+		failures         int64
+		status           int
+		expectedStatus   int
+		expectedRequests int64
+	}{
+		{
+			name:             "idempotent request retried on 5xx",
+			method:           http.MethodGet,
+			ctx:              context.Background(),
+			failures:         2,
+			status:           http.StatusServiceUnavailable,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "non-idempotent request not retried on 5xx",
+			method:           http.MethodPost,
+			ctx:              context.Background(),
+			failures:         2,
+			status:           http.StatusServiceUnavailable,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedRequests: 1,
+		},
+		{
+			name:             "request marked idempotent retried on 5xx",
+			method:           http.MethodPost,
+			ctx:              WithIdempotent(context.Background()),
+			failures:         2,
+			status:           http.StatusBadGateway,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "non-idempotent request retried on 429",
+			method:           http.MethodPost,
+			ctx:              context.Background(),
+			failures:         1,
+			status:           http.StatusTooManyRequests,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+		},
+		{
+			name:             "retries exhausted",
+			method:           http.MethodGet,
+			ctx:              context.Background(),
+			failures:         10,
+			status:           http.StatusInternalServerError,
+			expectedStatus:   http.StatusInternalServerError,
+			expectedRequests: 4,
+		},
+		{
+			name:             "client error not retried",
+			method:           http.MethodGet,
+			ctx:              context.Background(),
+			failures:         1,
+			status:           http.StatusBadRequest,
+			expectedStatus:   http.StatusBadRequest,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newFlakyServer(t, tt.failures, tt.status, nil)
+			client := New(time.Second, policy, nil, nil)
+
+			req, err := http.NewRequestWithContext(tt.ctx, tt.method, server.URL, strings.NewReader("payload"))
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedRequests, requests.Load())
+
+			if tt.expectedStatus == http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "payload", string(body))
+			}
+		})
+	}
+}
+
+func TestHttpClientRetryAfter(t *testing.T) {
+	server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, http.Header{"Retry-After": {"1"}})
+	client := New(5*time.Second, RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}, nil, nil)
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int64(2), requests.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestHttpClientRetryBudget(t *testing.T) {
+	tests := []struct {
+		name             string
+		maxDelay         time.Duration
+		expectedStatus   int
+		expectedRequests int64
+	}{
+		{
+			name:             "retry-after beyond budget gives up",
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedRequests: 1,
+		},
+		{
+			name:             "retry-after clamped to max delay",
+			maxDelay:         10 * time.Millisecond,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, http.Header{"Retry-After": {"10"}})
+			policy := RetryPolicy{
+				MaxRetries: 3,
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   tt.maxDelay,
+				Budget:     time.Second,
+			}
+			client := New(time.Second, policy, nil, nil)
+
+			start := time.Now()
+			resp, err := client.Get(server.URL)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tt.expectedRequests, requests.Load())
+			assert.Less(t, time.Since(start), time.Second)
+		})
+	}
+}
+
+func TestHttpClientRetryContextCancel(t *testing.T) {
+	server, requests := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	client := New(time.Minute, RetryPolicy{MaxRetries: 3, BaseDelay: time.Minute}, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = client.Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+
+	assert.Equal(t, int64(1), requests.Load())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+type countingLimiter struct {
+	waits atomic.Int64
+}
+
+func (l *countingLimiter) Wait(_ context.Context) error {
+	l.waits.Add(1)
+	return nil
+}
+
+func TestHttpClientLimiterPerAttempt(t *testing.T) {
+	server, requests := newFlakyServer(t, 2, http.StatusTooManyRequests, nil)
+	limiter := &countingLimiter{}
+	client := New(time.Second, RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}, nil, limiter)
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int64(3), requests.Load())
+	assert.Equal(t, int64(3), limiter.waits.Load())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		value         string
+		expectedDelay time.Duration
+		expectedOk    bool
+	}{
+		{
+			name:          "seconds",
+			value:         "3",
+			expectedDelay: 3 * time.Second,
+			expectedOk:    true,
+		},
+		{
+			name:          "http date",
+			value:         now.Add(5 * time.Second).Format(http.TimeFormat),
+			expectedDelay: 5 * time.Second,
+			expectedOk:    true,
+		},
+		{
+			name:          "date in the past",
+			value:         now.Add(-time.Minute).Format(http.TimeFormat),
+			expectedDelay: 0,
+			expectedOk:    true,
+		},
+		{
+			name:       "empty",
+			value:      "",
+			expectedOk: false,
+		},
+		{
+			name:       "negative seconds",
+			value:      "-1",
+			expectedOk: false,
+		},
+		{
+			name:       "garbage",
+			value:      "soon",
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tt.value, now)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedDelay, delay)
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	for attempt, expected := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	} {
+		for i := 0; i < 100; i++ {
+			delay := policy.backoff(attempt)
+			assert.GreaterOrEqual(t, delay, expected/2)
+			assert.LessOrEqual(t, delay, expected)
+		}
+	}
+}
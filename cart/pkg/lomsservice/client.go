@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/BruteMors/marketplace-service/cart/pkg/api/grpc/loms/v1"
+	"github.com/BruteMors/marketplace-service/cart/pkg/circuitbreaker"
 	"github.com/BruteMors/marketplace-service/cart/pkg/lomsservice/interceptor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -21,7 +22,8 @@ type Client struct {
 	stockClient loms.StockClient
 }
 
-func NewClient() (*Client, error) {
+// NewClient creates a LOMS gRPC client. A nil breaker disables the circuit breaker.
+func NewClient(breaker *circuitbreaker.Breaker) (*Client, error) {
 	host := os.Getenv(grpcLomsServiceHostEnvName)
 	if len(host) == 0 {
 		return nil, errors.New("grpc loms host not found")
@@ -34,9 +36,14 @@ func NewClient() (*Client, error) {
 
 	address := net.JoinHostPort(host, port)
 
+	interceptors := []grpc.UnaryClientInterceptor{interceptor.SetTraceID}
+	if breaker != nil {
+		interceptors = append(interceptors, interceptor.CircuitBreaker(breaker))
+	}
+
 	conn, err := grpc.NewClient(address,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(interceptor.SetTraceID),
+		grpc.WithChainUnaryInterceptor(interceptors...),
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/pkg/circuitbreaker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func CircuitBreaker(breaker *circuitbreaker.Breaker) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		done, err := breaker.Allow()
+		if err != nil {
+			return err
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(callOutcome(ctx, err))
+
+		return err
+	}
+}
+
+// callOutcome does not count calls the caller cancelled or let time out,
+// they say nothing about the service.
+func callOutcome(ctx context.Context, err error) circuitbreaker.Outcome {
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return circuitbreaker.Ignored
+	case isServiceFailure(err):
+		return circuitbreaker.Failure
+	default:
+		return circuitbreaker.Success
+	}
+}
+
+func isServiceFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,9 @@
+package models
+
+import "fmt"
+
+var (
+	ErrInvalidArgument = fmt.Errorf("invalid argument")
+	// ErrOrderRejected means LOMS did not place the order, e.g. because its items could not be reserved.
+	ErrOrderRejected = fmt.Errorf("order rejected")
+)
@@ -1,8 +1,9 @@
 package models
 
 type OrderCreate struct {
-	User  int64
-	Items []OrderItem
+	User           int64
+	Items          []OrderItem
+	IdempotencyKey string
 }
 
 type OrderItem struct {
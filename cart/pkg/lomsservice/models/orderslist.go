@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+type OrdersList struct {
+	User        int64
+	Statuses    []string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Cursor      string
+	Limit       uint32
+}
+
+type OrdersListResponse struct {
+	Orders     []Order
+	NextCursor string
+}
+
+type Order struct {
+	ID        int64
+	Status    string
+	User      int64
+	Items     []OrderItem
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
@@ -2,12 +2,15 @@ package lomsservice
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/BruteMors/marketplace-service/cart/pkg/api/grpc/loms/v1"
 	"github.com/BruteMors/marketplace-service/cart/pkg/lomsservice/models"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (c *Client) OrderCreate(ctx context.Context, order models.OrderCreate) (orderID int64, err error) {
@@ -46,12 +49,16 @@ func (c *Client) OrderCreate(ctx context.Context, order models.OrderCreate) (ord
 	)
 
 	request := loms.OrderCreateRequest{
-		User:  order.User,
-		Items: items,
+		User:           order.User,
+		Items:          items,
+		IdempotencyKey: order.IdempotencyKey,
 	}
 
 	response, err := c.orderClient.OrderCreate(ctx, &request)
 	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			return 0, fmt.Errorf("%w: %s", models.ErrOrderRejected, status.Convert(err).Message())
+		}
 		return 0, err
 	}
 
@@ -0,0 +1,95 @@
+package lomsservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/BruteMors/marketplace-service/cart/pkg/api/grpc/loms/v1"
+	"github.com/BruteMors/marketplace-service/cart/pkg/lomsservice/models"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OrdersList returns one page of user orders. Statuses are lower-cased LOMS enum names, e.g. "awaiting_payment".
+func (c *Client) OrdersList(ctx context.Context, list models.OrdersList) (resp *models.OrdersListResponse, err error) {
+	tr := otel.Tracer("lomsServiceClient")
+	ctx, span := tr.Start(ctx, "OrdersList")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("userID", list.User),
+		attribute.StringSlice("statuses", list.Statuses),
+	)
+
+	statuses := make([]loms.OrderStatus, 0, len(list.Statuses))
+	for _, s := range list.Statuses {
+		value, ok := loms.OrderStatus_value[strings.ToUpper(s)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown order status %q", models.ErrInvalidArgument, s)
+		}
+		statuses = append(statuses, loms.OrderStatus(value))
+	}
+
+	request := loms.OrdersListRequest{
+		User:     list.User,
+		Statuses: statuses,
+		Cursor:   list.Cursor,
+		Limit:    list.Limit,
+	}
+
+	if list.CreatedFrom != nil {
+		request.CreatedFrom = timestamppb.New(*list.CreatedFrom)
+	}
+
+	if list.CreatedTo != nil {
+		request.CreatedTo = timestamppb.New(*list.CreatedTo)
+	}
+
+	response, err := c.orderClient.OrdersList(ctx, &request)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", models.ErrInvalidArgument, status.Convert(err).Message())
+		}
+		return nil, err
+	}
+
+	resp = &models.OrdersListResponse{
+		Orders:     make([]models.Order, 0, len(response.Orders)),
+		NextCursor: response.NextCursor,
+	}
+
+	for _, o := range response.Orders {
+		items := make([]models.OrderItem, 0, len(o.Items))
+		for _, item := range o.Items {
+			items = append(items, models.OrderItem{
+				SkuID: item.Sku,
+				Count: item.Count,
+			})
+		}
+
+		order := models.Order{
+			ID:        o.OrderId,
+			Status:    strings.ToLower(o.Status.String()),
+			User:      o.User,
+			Items:     items,
+			CreatedAt: o.CreatedAt.AsTime(),
+		}
+
+		if o.UpdatedAt != nil {
+			updatedAt := o.UpdatedAt.AsTime()
+			order.UpdatedAt = &updatedAt
+		}
+
+		resp.Orders = append(resp.Orders, order)
+	}
+
+	return resp, nil
+}
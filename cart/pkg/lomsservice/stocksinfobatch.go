@@ -0,0 +1,38 @@
+package lomsservice
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/cart/pkg/api/grpc/loms/v1"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StocksInfoBatch returns available counts keyed by SKU. SKUs unknown to LOMS are absent from the result.
+func (c *Client) StocksInfoBatch(ctx context.Context, skus []uint32) (counts map[uint32]uint64, err error) {
+	tr := otel.Tracer("lomsServiceClient")
+	ctx, span := tr.Start(ctx, "StocksInfoBatch")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sku_count", len(skus)))
+
+	request := loms.StocksInfoBatchRequest{
+		Skus: skus,
+	}
+
+	response, err := c.stockClient.StocksInfoBatch(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	counts = make(map[uint32]uint64, len(response.Stocks))
+	for _, stock := range response.Stocks {
+		counts[stock.Sku] = stock.Count
+	}
+
+	return counts, nil
+}
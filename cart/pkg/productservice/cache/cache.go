@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/pkg/productservice/models"
+	"golang.org/x/sync/singleflight"
+)
+
+type Loader func(ctx context.Context, sku int64) (*models.GetProductResponse, error)
+
+type entry struct {
+	sku       int64
+	product   models.GetProductResponse
+	notFound  bool
+	expiresAt time.Time
+}
+
+// Cache is a size-bounded LRU cache of products with per-entry TTL.
+// Concurrent misses for the same SKU share one upstream request,
+// and not found responses are cached for negativeTTL. The shared request
+// is not cancelled with the caller that started it and is bounded by loadTimeout.
+type Cache struct {
+	mutex       sync.Mutex
+	capacity    int
+	ttl         time.Duration
+	negativeTTL time.Duration
+	loadTimeout time.Duration
+	items       map[int64]*list.Element
+	order       *list.List
+	group       singleflight.Group
+	now         func() time.Time
+}
+
+func New(capacity int, ttl time.Duration, negativeTTL time.Duration, loadTimeout time.Duration) *Cache {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &Cache{
+		capacity:    capacity,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		loadTimeout: loadTimeout,
+		items:       make(map[int64]*list.Element, capacity),
+		order:       list.New(),
+		now:         time.Now,
+	}
+}
+
+func (c *Cache) Get(ctx context.Context, sku int64, load Loader) (*models.GetProductResponse, error) {
+	if e, ok := c.lookup(sku); ok {
+		metric.IncProductCacheCounter("hit")
+		if e.notFound {
+			return nil, models.ErrNotFound
+		}
+
+		product := e.product
+		return &product, nil
+	}
+
+	metric.IncProductCacheCounter("miss")
+
+	// Every caller waits for the shared load only as long as its own ctx allows.
+	ch := c.group.DoChan(strconv.FormatInt(sku, 10), func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.loadTimeout)
+		defer cancel()
+
+		product, err := load(loadCtx, sku)
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				c.store(sku, models.GetProductResponse{}, true)
+			}
+			return nil, err
+		}
+
+		c.store(sku, *product, false)
+
+		return *product, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		product := res.Val.(models.GetProductResponse)
+		return &product, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache) lookup(sku int64) (entry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	elem, ok := c.items[sku]
+	if !ok {
+		return entry{}, false
+	}
+
+	e := elem.Value.(*entry)
+	if !c.now().Before(e.expiresAt) {
+		c.removeElement(elem)
+		return entry{}, false
+	}
+
+	c.order.MoveToFront(elem)
+
+	return *e, true
+}
+
+func (c *Cache) store(sku int64, product models.GetProductResponse, notFound bool) {
+	ttl := c.ttl
+	if notFound {
+		ttl = c.negativeTTL
+	}
+
+	if ttl <= 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	e := &entry{
+		sku:       sku,
+		product:   product,
+		notFound:  notFound,
+		expiresAt: c.now().Add(ttl),
+	}
+
+	if elem, ok := c.items[sku]; ok {
+		elem.Value = e
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.items[sku] = c.order.PushFront(e)
+
+	for c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+	}
+
+	metric.SetProductCacheSize(float64(c.order.Len()))
+}
+
+func (c *Cache) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.items, elem.Value.(*entry).sku)
+
+	metric.SetProductCacheSize(float64(c.order.Len()))
+}
@@ -0,0 +1,239 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/pkg/productservice/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv("NAMESPACE", "test")
+	_ = os.Setenv("APP_NAME", "cart")
+
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+type countingLoader struct {
+	calls atomic.Int64
+	err   error
+}
+
+func (l *countingLoader) load(_ context.Context, sku int64) (*models.GetProductResponse, error) {
+	l.calls.Add(1)
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	return &models.GetProductResponse{Sku: sku, Name: "product", Price: sku * 10}, nil
+}
+
+func TestCacheGet(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		loaderErr     error
+		expectedCalls int64
+		expectedError error
+	}{
+		{
+			name:          "product is cached",
+			expectedCalls: 1,
+		},
+		{
+			name:          "not found is cached",
+			loaderErr:     models.ErrNotFound,
+			expectedCalls: 1,
+			expectedError: models.ErrNotFound,
+		},
+		{
+			name:          "other errors are not cached",
+			loaderErr:     errors.New("unexpected status"),
+			expectedCalls: 2,
+			expectedError: errors.New("unexpected status"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(10, time.Minute, time.Minute, time.Second)
+			loader := &countingLoader{err: tt.loaderErr}
+
+			for i := 0; i < 2; i++ {
+				product, err := c.Get(ctx, 100, loader.load)
+				if tt.expectedError != nil {
+					assert.EqualError(t, err, tt.expectedError.Error())
+					assert.Nil(t, product)
+				} else {
+					require.NoError(t, err)
+					assert.Equal(t, &models.GetProductResponse{Sku: 100, Name: "product", Price: 1000}, product)
+				}
+			}
+
+			assert.Equal(t, tt.expectedCalls, loader.calls.Load())
+		})
+	}
+}
+
+func TestCacheExpiration(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	c := New(10, time.Minute, 10*time.Second, time.Second)
+	c.now = func() time.Time { return now }
+
+	found := &countingLoader{}
+	notFound := &countingLoader{err: models.ErrNotFound}
+
+	_, err := c.Get(ctx, 1, found.load)
+	require.NoError(t, err)
+	_, err = c.Get(ctx, 2, notFound.load)
+	require.ErrorIs(t, err, models.ErrNotFound)
+
+	now = now.Add(30 * time.Second)
+
+	_, err = c.Get(ctx, 1, found.load)
+	require.NoError(t, err)
+	_, err = c.Get(ctx, 2, notFound.load)
+	require.ErrorIs(t, err, models.ErrNotFound)
+
+	assert.Equal(t, int64(1), found.calls.Load())
+	assert.Equal(t, int64(2), notFound.calls.Load())
+
+	now = now.Add(time.Minute)
+
+	_, err = c.Get(ctx, 1, found.load)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), found.calls.Load())
+}
+
+func TestCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	c := New(2, time.Minute, time.Minute, time.Second)
+	loader := &countingLoader{}
+
+	for _, sku := range []int64{1, 2, 1, 3} {
+		_, err := c.Get(ctx, sku, loader.load)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, int64(3), loader.calls.Load())
+
+	_, err := c.Get(ctx, 1, loader.load)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), loader.calls.Load())
+
+	_, err = c.Get(ctx, 2, loader.load)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), loader.calls.Load())
+}
+
+func TestCacheCoalescesConcurrentLoads(t *testing.T) {
+	ctx := context.Background()
+	c := New(10, time.Minute, time.Minute, time.Second)
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+	load := func(_ context.Context, sku int64) (*models.GetProductResponse, error) {
+		calls.Add(1)
+		<-release
+		return &models.GetProductResponse{Sku: sku}, nil
+	}
+
+	var wg sync.WaitGroup
+	numRoutines := 50
+
+	for i := 0; i < numRoutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			product, err := c.Get(ctx, 100, load)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), product.Sku)
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestCacheLoadOutlivesCancelledCaller(t *testing.T) {
+	c := New(10, time.Minute, time.Minute, time.Second)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var loadErr atomic.Value
+	load := func(ctx context.Context, sku int64) (*models.GetProductResponse, error) {
+		close(started)
+		<-release
+		if err := ctx.Err(); err != nil {
+			loadErr.Store(err)
+			return nil, err
+		}
+		return &models.GetProductResponse{Sku: sku}, nil
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := c.Get(firstCtx, 100, load)
+		firstErr <- err
+	}()
+
+	<-started
+
+	secondResult := make(chan *models.GetProductResponse, 1)
+	go func() {
+		product, err := c.Get(context.Background(), 100, load)
+		assert.NoError(t, err)
+		secondResult <- product
+	}()
+
+	cancelFirst()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	product := <-secondResult
+	require.NotNil(t, product)
+	assert.Equal(t, int64(100), product.Sku)
+	assert.Nil(t, loadErr.Load())
+
+	// The product is cached, a later caller does not load it again.
+	product, err := c.Get(context.Background(), 100, func(context.Context, int64) (*models.GetProductResponse, error) {
+		return nil, errors.New("unexpected load")
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), product.Sku)
+}
+
+func TestCacheLoadTimeout(t *testing.T) {
+	c := New(10, time.Minute, time.Minute, 20*time.Millisecond)
+
+	load := func(ctx context.Context, _ int64) (*models.GetProductResponse, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	_, err := c.Get(context.Background(), 100, load)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, c.Len())
+}
@@ -8,15 +8,14 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
-	"time"
 
 	"github.com/BruteMors/marketplace-service/cart/pkg/errorgroup"
+	"github.com/BruteMors/marketplace-service/cart/pkg/httpclient"
 	"github.com/BruteMors/marketplace-service/cart/pkg/productservice/models"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
-	"golang.org/x/time/rate"
 )
 
 func (s *ProductService) GetProduct(ctx context.Context, sku int64) (response *models.GetProductResponse, err error) {
@@ -29,6 +28,14 @@ func (s *ProductService) GetProduct(ctx context.Context, sku int64) (response *m
 
 	span.SetAttributes(attribute.Int64("sku", sku))
 
+	if s.cache == nil {
+		return s.fetchProduct(ctx, sku)
+	}
+
+	return s.cache.Get(ctx, sku, s.fetchProduct)
+}
+
+func (s *ProductService) fetchProduct(ctx context.Context, sku int64) (*models.GetProductResponse, error) {
 	url := fmt.Sprintf("%s/get_product", s.address)
 
 	reqBody := models.GetProductRequest{
@@ -50,7 +57,8 @@ func (s *ProductService) GetProduct(ctx context.Context, sku int64) (response *m
 
 	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(httpReq.Header))
 
-	httpReq = httpReq.WithContext(ctx)
+	// The lookup is read-only, so it is safe to retry despite being a POST.
+	httpReq = httpReq.WithContext(httpclient.WithIdempotent(ctx))
 
 	resp, err := s.client.Do(httpReq)
 	if err != nil {
@@ -97,17 +105,11 @@ func (s *ProductService) GetProducts(ctx context.Context, skus []int64) (respons
 
 	responses = make([]models.GetProductResponse, len(skus))
 
-	limiter := rate.NewLimiter(rate.Every(time.Second/time.Duration(s.getProductRPSLimit)), 1)
-
 	eg := errorgroup.NewErrGroup(ctx)
 
 	for i, sku := range skus {
 		i, sku := i, sku
 		eg.Go(func() error {
-			if err := limiter.Wait(eg.Context()); err != nil {
-				return err
-			}
-
 			product, err := s.GetProduct(eg.Context(), sku)
 			if err != nil {
 				return err
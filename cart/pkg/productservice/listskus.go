@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/BruteMors/marketplace-service/cart/pkg/httpclient"
 	"github.com/BruteMors/marketplace-service/cart/pkg/productservice/models"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"go.opentelemetry.io/otel"
@@ -51,7 +52,8 @@ func (s *ProductService) GetListSkus(ctx context.Context, startAfterSku, count i
 
 	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(httpReq.Header))
 
-	httpReq = httpReq.WithContext(ctx)
+	// The lookup is read-only, so it is safe to retry despite being a POST.
+	httpReq = httpReq.WithContext(httpclient.WithIdempotent(ctx))
 
 	resp, err := s.client.Do(httpReq)
 	if err != nil {
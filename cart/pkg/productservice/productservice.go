@@ -1,21 +1,55 @@
 package productservice
 
 import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
 	"github.com/BruteMors/marketplace-service/cart/pkg/httpclient"
+	"github.com/BruteMors/marketplace-service/cart/pkg/productservice/cache"
+	"golang.org/x/time/rate"
 )
 
 type ProductService struct {
-	client             *httpclient.HttpClient
-	address            string
-	accessToken        string
-	getProductRPSLimit int
+	client      *httpclient.HttpClient
+	address     string
+	accessToken string
+	cache       *cache.Cache
 }
 
-func New(client *httpclient.HttpClient, address string, accessToken string, getProductRPSLimit int) *ProductService {
+// New creates a Product Service client. A nil productCache disables caching.
+func New(
+	client *httpclient.HttpClient,
+	address string,
+	accessToken string,
+	productCache *cache.Cache,
+) *ProductService {
 	return &ProductService{
-		client:             client,
-		address:            address,
-		accessToken:        accessToken,
-		getProductRPSLimit: getProductRPSLimit,
+		client:      client,
+		address:     address,
+		accessToken: accessToken,
+		cache:       productCache,
+	}
+}
+
+// Limiter allows rpsLimit Product Service requests per second with the given burst.
+// It is meant to be shared by the client's transport, so retries wait for it too.
+type Limiter struct {
+	limiter *rate.Limiter
+}
+
+func NewLimiter(rpsLimit int, rpsBurst int) *Limiter {
+	return &Limiter{
+		limiter: rate.NewLimiter(rate.Limit(rpsLimit), rpsBurst),
 	}
 }
+
+func (l *Limiter) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := l.limiter.Wait(ctx)
+	duration := time.Since(start).Seconds()
+
+	metric.ObserveRateLimiterWaitTime("product_service", duration)
+
+	return err
+}
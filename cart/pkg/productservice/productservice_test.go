@@ -0,0 +1,103 @@
+package productservice
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/metric"
+	"github.com/BruteMors/marketplace-service/cart/pkg/httpclient"
+	"github.com/BruteMors/marketplace-service/cart/pkg/productservice/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv("NAMESPACE", "test")
+	_ = os.Setenv("APP_NAME", "cart")
+
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newProductServer(t *testing.T, requests *atomic.Int64) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		var req models.GetProductRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(models.GetProductResponse{Name: "product", Price: req.Sku})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestProductServiceSharedRateLimit(t *testing.T) {
+	var requests atomic.Int64
+	server := newProductServer(t, &requests)
+
+	rps := 20
+	client := httpclient.New(time.Second, httpclient.RetryPolicy{}, nil, NewLimiter(rps, 1))
+	service := New(client, server.URL, "token", nil)
+
+	ctx := context.Background()
+	numCalls := 3
+	numCarts := 3
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < numCarts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.GetProducts(ctx, []int64{1, 2, 3})
+			assert.NoError(t, err)
+		}()
+	}
+
+	for i := 0; i < numCalls; i++ {
+		_, err := service.GetProduct(ctx, int64(i))
+		require.NoError(t, err)
+	}
+
+	wg.Wait()
+
+	total := numCalls + numCarts*3
+	minDuration := time.Duration(total-1) * time.Second / time.Duration(rps)
+
+	assert.Equal(t, int64(total), requests.Load())
+	assert.GreaterOrEqual(t, time.Since(start), minDuration)
+}
+
+func TestProductServiceRateLimitRespectsContext(t *testing.T) {
+	var requests atomic.Int64
+	server := newProductServer(t, &requests)
+
+	client := httpclient.New(time.Second, httpclient.RetryPolicy{}, nil, NewLimiter(1, 1))
+	service := New(client, server.URL, "token", nil)
+
+	_, err := service.GetProduct(context.Background(), 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = service.GetProduct(ctx, 2)
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), requests.Load())
+}
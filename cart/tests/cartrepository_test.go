@@ -0,0 +1,135 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/cart/internal/models"
+	"github.com/BruteMors/marketplace-service/cart/internal/repository"
+	"github.com/BruteMors/marketplace-service/cart/internal/repository/postgres/cart"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartRepositoryAddAndGet(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := cart.NewRepository(conn)
+
+	require.NoError(t, repo.Add(ctx, 1, 1148162, 2))
+	require.NoError(t, repo.Add(ctx, 1, 1076963, 1))
+	require.NoError(t, repo.Add(ctx, 1, 1148162, 3))
+	require.NoError(t, repo.Add(ctx, 2, 1148162, 7))
+
+	items, err := repo.GetCart(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []models.ItemCount{
+		{SkuID: 1076963, Count: 1},
+		{SkuID: 1148162, Count: 5},
+	}, items)
+}
+
+func TestCartRepositoryGetMissingCart(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	_, err := cart.NewRepository(conn).GetCart(context.Background(), 1)
+	require.ErrorIs(t, err, repository.ErrCartNotFound)
+}
+
+func TestCartRepositoryAddOverflow(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := cart.NewRepository(conn)
+
+	require.NoError(t, repo.Add(ctx, 1, 1148162, math.MaxUint16))
+	require.Error(t, repo.Add(ctx, 1, 1148162, 1))
+
+	items, err := repo.GetCart(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []models.ItemCount{{SkuID: 1148162, Count: math.MaxUint16}}, items)
+}
+
+func TestCartRepositoryDeleteItem(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := cart.NewRepository(conn)
+
+	require.NoError(t, repo.Add(ctx, 1, 1148162, 2))
+	require.NoError(t, repo.Add(ctx, 1, 1076963, 1))
+
+	require.NoError(t, repo.DeleteItem(ctx, 1, 1148162))
+	require.NoError(t, repo.DeleteItem(ctx, 1, 1148162))
+
+	items, err := repo.GetCart(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []models.ItemCount{{SkuID: 1076963, Count: 1}}, items)
+}
+
+func TestCartRepositoryDeleteItemsByUserID(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := cart.NewRepository(conn)
+
+	require.NoError(t, repo.Add(ctx, 1, 1148162, 2))
+	require.NoError(t, repo.Add(ctx, 1, 1076963, 1))
+	require.NoError(t, repo.Add(ctx, 2, 1148162, 7))
+
+	count, err := repo.DeleteItemsByUserID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	_, err = repo.GetCart(ctx, 1)
+	require.ErrorIs(t, err, repository.ErrCartNotFound)
+
+	items, err := repo.GetCart(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []models.ItemCount{{SkuID: 1148162, Count: 7}}, items)
+}
+
+func TestCartRepositoryCheckoutKey(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := cart.NewRepository(conn)
+
+	require.NoError(t, repo.Add(ctx, 1, 1148162, 2))
+
+	key, err := repo.CheckoutKey(ctx, 1, "first")
+	require.NoError(t, err)
+	require.Equal(t, "first", key)
+
+	key, err = repo.CheckoutKey(ctx, 1, "second")
+	require.NoError(t, err)
+	require.Equal(t, "first", key)
+
+	require.NoError(t, repo.Add(ctx, 1, 1076963, 1))
+
+	key, err = repo.CheckoutKey(ctx, 1, "third")
+	require.NoError(t, err)
+	require.Equal(t, "third", key)
+
+	_, err = repo.DeleteItemsByUserID(ctx, 1)
+	require.NoError(t, err)
+
+	key, err = repo.CheckoutKey(ctx, 1, "fourth")
+	require.NoError(t, err)
+	require.Equal(t, "fourth", key)
+
+	require.NoError(t, repo.DropCheckoutKey(ctx, 1))
+
+	key, err = repo.CheckoutKey(ctx, 1, "fifth")
+	require.NoError(t, err)
+	require.Equal(t, "fifth", key)
+}
@@ -0,0 +1,238 @@
+package consumergroup
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// workerQueueSize is how many messages may wait for a busy worker
+// before the claim stops reading new ones.
+const workerQueueSize = 16
+
+type result struct {
+	offset int64
+	err    error
+}
+
+// claimConsumer handles the messages of one claim on several workers and marks an offset
+// only when every message before it is handled, so a commit never skips an unhandled message.
+type claimConsumer struct {
+	handler *Handler
+	session sarama.ConsumerGroupSession
+	claim   sarama.ConsumerGroupClaim
+
+	queues  []chan *sarama.ConsumerMessage
+	results chan result
+	wg      sync.WaitGroup
+	next    int
+
+	// pending are offsets of dispatched messages that are not marked yet, in order.
+	pending     []int64
+	done        map[int64]struct{}
+	received    int64
+	marked      int64
+	committed   int64
+	uncommitted int
+	failed      error
+}
+
+func newClaimConsumer(h *Handler, session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) *claimConsumer {
+	return &claimConsumer{
+		handler:   h,
+		session:   session,
+		claim:     claim,
+		queues:    make([]chan *sarama.ConsumerMessage, h.workers),
+		results:   make(chan result, h.workers),
+		done:      make(map[int64]struct{}),
+		received:  -1,
+		marked:    -1,
+		committed: -1,
+	}
+}
+
+func (c *claimConsumer) run() error {
+	ctx, cancel := context.WithCancel(c.session.Context())
+	defer cancel()
+
+	for i := range c.queues {
+		c.queues[i] = make(chan *sarama.ConsumerMessage, workerQueueSize)
+		c.wg.Add(1)
+		go c.work(ctx, c.queues[i])
+	}
+
+	var tick <-chan time.Time
+	if c.handler.commitInterval > 0 {
+		ticker := time.NewTicker(c.handler.commitInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for c.failed == nil {
+		select {
+		case message, ok := <-c.claim.Messages():
+			if !ok {
+				return c.stop(cancel, false)
+			}
+			c.dispatch(ctx, message)
+		case r := <-c.results:
+			c.complete(r)
+		case <-tick:
+			c.commit()
+		case <-ctx.Done():
+			return c.stop(cancel, true)
+		}
+	}
+
+	return c.stop(cancel, true)
+}
+
+// work handles the queue until a message fails. The messages queued after the failed one
+// may have its key, so the worker skips them instead of handling them out of order
+// before the claim notices the failure.
+func (c *claimConsumer) work(ctx context.Context, queue <-chan *sarama.ConsumerMessage) {
+	defer c.wg.Done()
+
+	var failed error
+	for message := range queue {
+		err := failed
+		if err == nil {
+			err = ctx.Err()
+		}
+
+		if err == nil {
+			err = c.handler.process(ctx, convertMsg(message))
+			failed = err
+		}
+
+		c.results <- result{offset: message.Offset, err: err}
+	}
+}
+
+// dispatch hands the message to its worker, collecting results while the worker is busy.
+func (c *claimConsumer) dispatch(ctx context.Context, message *sarama.ConsumerMessage) {
+	if c.committed < 0 {
+		c.committed = message.Offset
+	}
+	c.pending = append(c.pending, message.Offset)
+	c.received = message.Offset + 1
+	c.observeLag()
+
+	queue := c.queues[c.workerFor(message)]
+	for {
+		select {
+		case queue <- message:
+			return
+		case r := <-c.results:
+			c.complete(r)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// workerFor keeps messages with the same key on the same worker. Messages without a key go round-robin.
+func (c *claimConsumer) workerFor(message *sarama.ConsumerMessage) int {
+	if len(c.queues) == 1 {
+		return 0
+	}
+
+	if len(message.Key) == 0 {
+		c.next = (c.next + 1) % len(c.queues)
+		return c.next
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write(message.Key)
+
+	return int(hash.Sum32() % uint32(len(c.queues)))
+}
+
+// complete marks the highest offset up to which every message is handled.
+// A failed message is never marked, so nothing after it is committed either.
+func (c *claimConsumer) complete(r result) {
+	if r.err != nil {
+		if c.failed == nil {
+			c.failed = r.err
+		}
+		return
+	}
+
+	c.done[r.offset] = struct{}{}
+
+	advanced := false
+	for len(c.pending) > 0 {
+		offset := c.pending[0]
+		if _, ok := c.done[offset]; !ok {
+			break
+		}
+
+		delete(c.done, offset)
+		c.pending = c.pending[1:]
+		c.marked = offset + 1
+		c.uncommitted++
+		advanced = true
+	}
+
+	if !advanced {
+		return
+	}
+
+	c.session.MarkOffset(c.claim.Topic(), c.claim.Partition(), c.marked, "")
+
+	if c.uncommitted >= c.handler.commitBatch {
+		c.commit()
+	}
+}
+
+func (c *claimConsumer) commit() {
+	if c.uncommitted == 0 {
+		return
+	}
+
+	c.session.Commit()
+	c.committed = c.marked
+	c.uncommitted = 0
+	c.observeLag()
+}
+
+func (c *claimConsumer) observeLag() {
+	if c.handler.lagObserver == nil {
+		return
+	}
+
+	c.handler.lagObserver(c.claim.Topic(), c.claim.Partition(), c.received-c.committed)
+}
+
+// stop waits for the workers and commits what they have handled. With abort the workers
+// skip the queued messages and the ones in a backoff give up.
+func (c *claimConsumer) stop(cancel context.CancelFunc, abort bool) error {
+	if abort {
+		cancel()
+	}
+
+	for _, queue := range c.queues {
+		close(queue)
+	}
+
+	go func() {
+		c.wg.Wait()
+		close(c.results)
+	}()
+
+	for r := range c.results {
+		c.complete(r)
+	}
+
+	c.commit()
+
+	if c.failed != nil && !errors.Is(c.failed, context.Canceled) {
+		return c.failed
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package consumergroup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	claimTopic     = "loms.order-events"
+	claimPartition = 3
+)
+
+type fakeSession struct {
+	ctx context.Context
+
+	mu      sync.Mutex
+	marks   []int64
+	commits []int64
+}
+
+func newFakeSession(ctx context.Context) *fakeSession {
+	return &fakeSession{ctx: ctx}
+}
+
+func (s *fakeSession) Claims() map[string][]int32 {
+	return map[string][]int32{claimTopic: {claimPartition}}
+}
+
+func (s *fakeSession) MemberID() string {
+	return "member"
+}
+
+func (s *fakeSession) GenerationID() int32 {
+	return 1
+}
+
+func (s *fakeSession) MarkOffset(topic string, partition int32, offset int64, _ string) {
+	if topic != claimTopic || partition != claimPartition {
+		panic("offset marked for another claim")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marks = append(s.marks, offset)
+}
+
+// Commit remembers the last marked offset it committed.
+func (s *fakeSession) Commit() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	committed := int64(-1)
+	if len(s.marks) > 0 {
+		committed = s.marks[len(s.marks)-1]
+	}
+	s.commits = append(s.commits, committed)
+}
+
+func (s *fakeSession) ResetOffset(string, int32, int64, string) {}
+
+func (s *fakeSession) MarkMessage(*sarama.ConsumerMessage, string) {}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) state() ([]int64, []int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]int64(nil), s.marks...), append([]int64(nil), s.commits...)
+}
+
+type fakeClaim struct {
+	messages chan *sarama.ConsumerMessage
+}
+
+func newFakeClaim() *fakeClaim {
+	return &fakeClaim{messages: make(chan *sarama.ConsumerMessage, 100)}
+}
+
+func (c *fakeClaim) Topic() string {
+	return claimTopic
+}
+
+func (c *fakeClaim) Partition() int32 {
+	return claimPartition
+}
+
+func (c *fakeClaim) InitialOffset() int64 {
+	return 0
+}
+
+func (c *fakeClaim) HighWaterMarkOffset() int64 {
+	return 0
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func (c *fakeClaim) send(offset int64, key string) {
+	message := &sarama.ConsumerMessage{Topic: claimTopic, Partition: claimPartition, Offset: offset}
+	if key != "" {
+		message.Key = []byte(key)
+	}
+	c.messages <- message
+}
+
+// offsetHandler waits on the gate of a message offset before handling it and fails the offsets in fail.
+type offsetHandler struct {
+	gates map[int64]chan struct{}
+	fail  map[int64]bool
+
+	mu      sync.Mutex
+	handled []int64
+}
+
+func (h *offsetHandler) Handle(msg Msg) error {
+	if gate, ok := h.gates[msg.Offset]; ok {
+		<-gate
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handled = append(h.handled, msg.Offset)
+	if h.fail[msg.Offset] {
+		return errors.New("handle error")
+	}
+
+	return nil
+}
+
+func (h *offsetHandler) handledOffsets() []int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]int64(nil), h.handled...)
+}
+
+func consume(h *Handler, session *fakeSession, claim *fakeClaim) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- h.ConsumeClaim(session, claim)
+	}()
+
+	return done
+}
+
+func TestClaimMarksContiguousOffsets(t *testing.T) {
+	gate := make(chan struct{})
+	topicHandler := &offsetHandler{gates: map[int64]chan struct{}{10: gate}}
+	h := NewConsumerGroupHandler(map[string]TopicHandler{claimTopic: topicHandler}, WithConcurrency(2))
+
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+	done := consume(h, session, claim)
+
+	// messages without a key go to the workers in turn
+	claim.send(10, "")
+	claim.send(11, "")
+
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]int64{11}, topicHandler.handledOffsets())
+	}, time.Second, 5*time.Millisecond)
+
+	// 11 is handled, but 10 before it is not
+	time.Sleep(20 * time.Millisecond)
+	marks, _ := session.state()
+	assert.Empty(t, marks)
+
+	close(gate)
+	require.Eventually(t, func() bool {
+		marks, _ := session.state()
+		return assert.ObjectsAreEqual([]int64{12}, marks)
+	}, time.Second, 5*time.Millisecond)
+
+	close(claim.messages)
+	require.NoError(t, <-done)
+}
+
+func TestClaimCommitsInBatches(t *testing.T) {
+	topicHandler := &offsetHandler{}
+	h := NewConsumerGroupHandler(map[string]TopicHandler{claimTopic: topicHandler}, WithCommitBatch(3, 0))
+
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+
+	for offset := int64(0); offset < 7; offset++ {
+		claim.send(offset, "10")
+	}
+	close(claim.messages)
+
+	require.NoError(t, <-consume(h, session, claim))
+
+	marks, commits := session.state()
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, marks)
+	// the rest is committed when the claim stops
+	assert.Equal(t, []int64{3, 6, 7}, commits)
+}
+
+func TestClaimCommitsByInterval(t *testing.T) {
+	topicHandler := &offsetHandler{}
+	h := NewConsumerGroupHandler(map[string]TopicHandler{claimTopic: topicHandler}, WithCommitBatch(100, 20*time.Millisecond))
+
+	var lagMu sync.Mutex
+	var lags []int64
+	h.lagObserver = func(topic string, partition int32, lag int64) {
+		lagMu.Lock()
+		defer lagMu.Unlock()
+		lags = append(lags, lag)
+	}
+
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+	done := consume(h, session, claim)
+
+	claim.send(0, "10")
+	claim.send(1, "10")
+
+	require.Eventually(t, func() bool {
+		_, commits := session.state()
+		return assert.ObjectsAreEqual([]int64{2}, commits)
+	}, time.Second, 5*time.Millisecond)
+
+	lagMu.Lock()
+	assert.Equal(t, []int64{1, 2, 0}, lags)
+	lagMu.Unlock()
+
+	close(claim.messages)
+	require.NoError(t, <-done)
+
+	// nothing new to commit
+	_, commits := session.state()
+	assert.Equal(t, []int64{2}, commits)
+}
+
+func TestClaimStopsMarkingOnFailure(t *testing.T) {
+	// no publisher for the DLQ, so the failed message has to be consumed again
+	topicHandler := &offsetHandler{fail: map[int64]bool{1: true}}
+	h := NewConsumerGroupHandler(
+		map[string]TopicHandler{claimTopic: topicHandler},
+		WithConcurrency(2),
+		WithErrorPolicy(ErrorPolicy{Attempts: 1, DLQ: true}),
+	)
+
+	session := newFakeSession(context.Background())
+	claim := newFakeClaim()
+
+	for offset := int64(0); offset < 4; offset++ {
+		claim.send(offset, "10")
+	}
+
+	err := <-consume(h, session, claim)
+	assert.ErrorContains(t, err, "no publisher")
+
+	// the messages of the key after the failed one are left for the next session
+	assert.Equal(t, []int64{0, 1}, topicHandler.handledOffsets())
+
+	marks, commits := session.state()
+	assert.Equal(t, []int64{1}, marks)
+	assert.Equal(t, []int64{1}, commits)
+}
+
+func TestClaimStopsOnSessionEnd(t *testing.T) {
+	gate := make(chan struct{})
+	topicHandler := &offsetHandler{gates: map[int64]chan struct{}{1: gate}}
+	h := NewConsumerGroupHandler(map[string]TopicHandler{claimTopic: topicHandler})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	session := newFakeSession(ctx)
+	claim := newFakeClaim()
+	done := consume(h, session, claim)
+
+	claim.send(0, "10")
+	claim.send(1, "10")
+	claim.send(2, "10")
+
+	require.Eventually(t, func() bool {
+		marks, _ := session.state()
+		return assert.ObjectsAreEqual([]int64{1}, marks)
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	close(gate)
+
+	require.NoError(t, <-done)
+
+	// the message handled while stopping is committed, the queued one is skipped
+	marks, commits := session.state()
+	assert.Equal(t, []int64{1, 2}, marks)
+	assert.Equal(t, []int64{1, 2}, commits)
+	assert.Equal(t, []int64{0, 1}, topicHandler.handledOffsets())
+}
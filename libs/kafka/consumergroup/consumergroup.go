@@ -22,10 +22,10 @@ func (c *ConsumerGroup) Run(ctx context.Context, wg *sync.WaitGroup) {
 
 		for {
 			if err := c.ConsumerGroup.Consume(ctx, c.topics, c.handler); err != nil {
-				slog.ErrorContext(ctx, "Error from consume: %v\n", err)
+				slog.ErrorContext(ctx, "Error from consume", "error", err)
 			}
 			if ctx.Err() != nil {
-				slog.InfoContext(ctx, "[consumer-group]: ctx closed: %s\n", ctx.Err().Error())
+				slog.InfoContext(ctx, "[consumer-group]: ctx closed", "error", ctx.Err())
 				return
 			}
 		}
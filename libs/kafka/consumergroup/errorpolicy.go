@@ -0,0 +1,121 @@
+package consumergroup
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers set on messages republished to the retry and dead-letter topics.
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	HeaderRetryStage        = "x-retry-stage"
+	HeaderRetryNotBefore    = "x-retry-not-before"
+	HeaderError             = "x-error"
+	HeaderFailedAt          = "x-failed-at"
+)
+
+const (
+	retryTopicInfix = ".retry."
+	dlqTopicSuffix  = ".dlq"
+)
+
+// Publisher sends a message to a topic, producer.SyncProducer implements it.
+type Publisher interface {
+	SendMessage(topic string, key []byte, message []byte, headers map[string]string) (int32, int64, error)
+}
+
+// ErrorPolicy tells the handler what to do with a message that failed to be handled.
+//
+// The message is first handled up to Attempts times in place, waiting Backoff before
+// the second attempt and twice as long before every next one, up to MaxBackoff.
+// Then it moves to the next retry stage: the message is republished to <topic>.retry.N,
+// where it is handled again not earlier than RetryDelays[N-1] after the failure.
+// A message that failed every stage is republished to <topic>.dlq when DLQ is set
+// and dropped otherwise. Retry topics and the DLQ need a Publisher.
+type ErrorPolicy struct {
+	Attempts    int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	RetryDelays []time.Duration
+	DLQ         bool
+	Publisher   Publisher
+}
+
+// DefaultErrorPolicy handles a message once and drops it on failure.
+var DefaultErrorPolicy = ErrorPolicy{
+	Attempts: 1,
+}
+
+// RetryTopic is the topic of the retry stage, stages start from 1.
+func RetryTopic(topic string, stage int) string {
+	return topic + retryTopicInfix + strconv.Itoa(stage)
+}
+
+func DLQTopic(topic string) string {
+	return topic + dlqTopicSuffix
+}
+
+// Topics returns the topic and its retry topics, the ones a consumer of the topic subscribes to.
+func (p ErrorPolicy) Topics(topic string) []string {
+	topics := make([]string, 0, len(p.RetryDelays)+1)
+	topics = append(topics, topic)
+	for stage := 1; stage <= len(p.RetryDelays); stage++ {
+		topics = append(topics, RetryTopic(topic, stage))
+	}
+
+	return topics
+}
+
+// backoff returns the delay before the given in-place attempt, attempts start from 1.
+func (p ErrorPolicy) backoff(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 2; i < attempt && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return delay
+}
+
+// sourceTopic returns the topic the message was first published to and its retry stage.
+func sourceTopic(msg Msg) (string, int) {
+	if original, ok := msg.Headers[HeaderOriginalTopic]; ok {
+		stage, err := strconv.Atoi(string(msg.Headers[HeaderRetryStage]))
+		if err == nil {
+			return string(original), stage
+		}
+	}
+
+	index := strings.LastIndex(msg.Topic, retryTopicInfix)
+	if index < 0 {
+		return msg.Topic, 0
+	}
+
+	stage, err := strconv.Atoi(msg.Topic[index+len(retryTopicInfix):])
+	if err != nil {
+		return msg.Topic, 0
+	}
+
+	return msg.Topic[:index], stage
+}
+
+// notBefore returns when a retried message may be handled again.
+func notBefore(msg Msg) (time.Time, bool) {
+	raw, ok := msg.Headers[HeaderRetryNotBefore]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	millis, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.UnixMilli(millis), true
+}
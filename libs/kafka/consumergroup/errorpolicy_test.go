@@ -0,0 +1,146 @@
+package consumergroup
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   ErrorPolicy
+		expected []time.Duration
+	}{
+		{
+			name:     "doubles from the third attempt",
+			policy:   ErrorPolicy{Backoff: 100 * time.Millisecond},
+			expected: []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+		},
+		{
+			name:     "capped by max backoff",
+			policy:   ErrorPolicy{Backoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond},
+			expected: []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond},
+		},
+		{
+			name:     "backoff above max backoff",
+			policy:   ErrorPolicy{Backoff: time.Second, MaxBackoff: 300 * time.Millisecond},
+			expected: []time.Duration{300 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond},
+		},
+		{
+			name:     "no backoff",
+			policy:   ErrorPolicy{},
+			expected: []time.Duration{0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, expected := range tt.expected {
+				assert.Equal(t, expected, tt.policy.backoff(i+1), "attempt %d", i+1)
+			}
+		})
+	}
+}
+
+func TestErrorPolicyTopics(t *testing.T) {
+	assert.Equal(t, []string{"loms.order-events"}, DefaultErrorPolicy.Topics("loms.order-events"))
+
+	policy := ErrorPolicy{RetryDelays: []time.Duration{time.Second, time.Minute}}
+	assert.Equal(t,
+		[]string{"loms.order-events", "loms.order-events.retry.1", "loms.order-events.retry.2"},
+		policy.Topics("loms.order-events"),
+	)
+}
+
+func TestSourceTopic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   Msg
+		topic string
+		stage int
+	}{
+		{
+			name:  "source topic",
+			msg:   Msg{Topic: "loms.order-events"},
+			topic: "loms.order-events",
+		},
+		{
+			name:  "retry topic",
+			msg:   Msg{Topic: "loms.order-events.retry.2"},
+			topic: "loms.order-events",
+			stage: 2,
+		},
+		{
+			name:  "retry topic without stage",
+			msg:   Msg{Topic: "loms.order-events.retry.x"},
+			topic: "loms.order-events.retry.x",
+		},
+		{
+			name: "headers",
+			msg: Msg{
+				Topic: "loms.order-events.retry.1",
+				Headers: map[string][]byte{
+					HeaderOriginalTopic: []byte("loms.stock-events"),
+					HeaderRetryStage:    []byte("3"),
+				},
+			},
+			topic: "loms.stock-events",
+			stage: 3,
+		},
+		{
+			name: "headers without stage",
+			msg: Msg{
+				Topic: "loms.order-events.retry.1",
+				Headers: map[string][]byte{
+					HeaderOriginalTopic: []byte("loms.stock-events"),
+				},
+			},
+			topic: "loms.order-events",
+			stage: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topic, stage := sourceTopic(tt.msg)
+			assert.Equal(t, tt.topic, topic)
+			assert.Equal(t, tt.stage, stage)
+		})
+	}
+}
+
+func TestNotBefore(t *testing.T) {
+	at := time.UnixMilli(1728388800123)
+
+	tests := []struct {
+		name     string
+		headers  map[string][]byte
+		expected time.Time
+		ok       bool
+	}{
+		{
+			name: "no header",
+		},
+		{
+			name:     "unix millis",
+			headers:  map[string][]byte{HeaderRetryNotBefore: []byte(strconv.FormatInt(at.UnixMilli(), 10))},
+			expected: at,
+			ok:       true,
+		},
+		{
+			name:    "invalid",
+			headers: map[string][]byte{HeaderRetryNotBefore: []byte("2024-10-08")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notBeforeAt, ok := notBefore(Msg{Headers: tt.headers})
+			assert.Equal(t, tt.ok, ok)
+			assert.True(t, tt.expected.Equal(notBeforeAt))
+		})
+	}
+}
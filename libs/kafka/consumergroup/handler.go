@@ -1,7 +1,11 @@
 package consumergroup
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
 
 	"github.com/IBM/sarama"
 )
@@ -21,16 +25,47 @@ type TopicHandler interface {
 	Handle(msg Msg) error
 }
 
+// CommitLagObserver receives how many messages of the partition were received but not committed yet.
+type CommitLagObserver func(topic string, partition int32, lag int64)
+
 type Handler struct {
-	topicHandlers map[string]TopicHandler
+	topicHandlers  map[string]TopicHandler
+	errorPolicy    ErrorPolicy
+	workers        int
+	commitBatch    int
+	commitInterval time.Duration
+	lagObserver    CommitLagObserver
 }
 
 func NewConsumerGroupHandler(
 	topicHandlers map[string]TopicHandler,
+	opts ...HandlerOption,
 ) *Handler {
-	return &Handler{
+	h := &Handler{
 		topicHandlers: topicHandlers,
+		errorPolicy:   DefaultErrorPolicy,
+		workers:       1,
+		commitBatch:   1,
+	}
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt.Apply(h)
+	}
+
+	return h
+}
+
+// Topics returns the handled topics together with their retry topics.
+func (h *Handler) Topics() []string {
+	topics := make([]string, 0, len(h.topicHandlers)*(len(h.errorPolicy.RetryDelays)+1))
+	for topic := range h.topicHandlers {
+		topics = append(topics, h.errorPolicy.Topics(topic)...)
 	}
+
+	return topics
 }
 
 func (h *Handler) Setup(_ sarama.ConsumerGroupSession) error {
@@ -41,30 +76,124 @@ func (h *Handler) Cleanup(_ sarama.ConsumerGroupSession) error {
 	return nil
 }
 
+// ConsumeClaim commits a message once it is handled or passed on to a retry topic or the DLQ.
+// When the message can be neither, the claim stops without committing it
+// and the message is consumed again after the next rebalance.
 func (h *Handler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	for {
-		select {
-		case message, ok := <-claim.Messages():
-			if !ok {
-				return nil
-			}
+	return newClaimConsumer(h, session, claim).run()
+}
 
-			msg := convertMsg(message)
-			if handler, exists := h.topicHandlers[message.Topic]; exists {
-				if err := handler.Handle(msg); err != nil {
-					slog.Error("Error handling message: %v", err)
-				}
-			} else {
-				slog.Error("No handler for message claimed from topic", slog.String("topic", message.Topic))
-				break
-			}
+// process handles the message according to the error policy. It returns an error only
+// when the message has to be consumed again.
+func (h *Handler) process(ctx context.Context, msg Msg) error {
+	topic, stage := sourceTopic(msg)
 
-			session.MarkMessage(message, "")
-			session.Commit()
+	handler, exists := h.topicHandlers[topic]
+	if !exists {
+		slog.Error("No handler for message claimed from topic", slog.String("topic", msg.Topic))
+		return nil
+	}
+
+	if at, ok := notBefore(msg); ok {
+		err := sleep(ctx, time.Until(at))
+		if err != nil {
+			return err
+		}
+	}
 
-		case <-session.Context().Done():
+	var err error
+	for attempt := 1; attempt <= max(h.errorPolicy.Attempts, 1); attempt++ {
+		if attempt > 1 {
+			errSleep := sleep(ctx, h.errorPolicy.backoff(attempt))
+			if errSleep != nil {
+				return errSleep
+			}
+		}
+
+		err = handler.Handle(msg)
+		if err == nil {
 			return nil
 		}
+
+		slog.Error("Error handling message",
+			"topic", msg.Topic,
+			"partition", msg.Partition,
+			"offset", msg.Offset,
+			"attempt", attempt,
+			"error", err,
+		)
+	}
+
+	return h.giveUp(topic, stage, msg, err)
+}
+
+// giveUp passes the failed message on to the next retry stage or to the DLQ.
+func (h *Handler) giveUp(topic string, stage int, msg Msg, handleErr error) error {
+	policy := h.errorPolicy
+
+	var target string
+	headers := failureHeaders(topic, msg, handleErr)
+
+	switch {
+	case stage < len(policy.RetryDelays):
+		target = RetryTopic(topic, stage+1)
+		headers[HeaderRetryStage] = strconv.Itoa(stage + 1)
+		headers[HeaderRetryNotBefore] = strconv.FormatInt(time.Now().Add(policy.RetryDelays[stage]).UnixMilli(), 10)
+	case policy.DLQ:
+		target = DLQTopic(topic)
+		headers[HeaderRetryStage] = strconv.Itoa(stage)
+		delete(headers, HeaderRetryNotBefore)
+	default:
+		slog.Error("Dropping message after failed attempts", "topic", msg.Topic, "offset", msg.Offset)
+		return nil
+	}
+
+	if policy.Publisher == nil {
+		return fmt.Errorf("no publisher to send message to %s", target)
+	}
+
+	_, _, err := policy.Publisher.SendMessage(target, msg.Key, msg.Payload, headers)
+	if err != nil {
+		return fmt.Errorf("failed to send message to %s: %w", target, err)
+	}
+
+	slog.Warn("Message sent on after failed attempts", "topic", msg.Topic, "offset", msg.Offset, "target", target)
+
+	return nil
+}
+
+// failureHeaders keeps the message headers, trace context included, and adds
+// where the message came from and why it failed.
+func failureHeaders(topic string, msg Msg, handleErr error) map[string]string {
+	headers := make(map[string]string, len(msg.Headers)+6)
+	for k, v := range msg.Headers {
+		headers[k] = string(v)
+	}
+
+	headers[HeaderOriginalTopic] = topic
+	if _, ok := msg.Headers[HeaderOriginalPartition]; !ok {
+		headers[HeaderOriginalPartition] = strconv.FormatInt(int64(msg.Partition), 10)
+		headers[HeaderOriginalOffset] = strconv.FormatInt(msg.Offset, 10)
+	}
+	headers[HeaderError] = handleErr.Error()
+	headers[HeaderFailedAt] = time.Now().UTC().Format(time.RFC3339Nano)
+
+	return headers
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
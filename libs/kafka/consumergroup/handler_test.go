@@ -0,0 +1,246 @@
+package consumergroup
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentMessage struct {
+	topic   string
+	key     []byte
+	payload []byte
+	headers map[string]string
+}
+
+type fakePublisher struct {
+	mu   sync.Mutex
+	sent []sentMessage
+	err  error
+}
+
+func (p *fakePublisher) SendMessage(topic string, key []byte, message []byte, headers map[string]string) (int32, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return 0, 0, p.err
+	}
+
+	p.sent = append(p.sent, sentMessage{topic: topic, key: key, payload: message, headers: headers})
+
+	return 0, int64(len(p.sent) - 1), nil
+}
+
+// fakeTopicHandler fails the first fails calls.
+type fakeTopicHandler struct {
+	mu    sync.Mutex
+	fails int
+	calls int
+}
+
+func (h *fakeTopicHandler) Handle(Msg) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.calls++
+	if h.calls <= h.fails {
+		return errors.New("handle error")
+	}
+
+	return nil
+}
+
+func TestHandlerProcess(t *testing.T) {
+	const topic = "loms.order-events"
+
+	failed := Msg{
+		Topic:     topic,
+		Partition: 2,
+		Offset:    15,
+		Key:       []byte("10"),
+		Payload:   []byte(`{"id":1}`),
+		Headers:   map[string][]byte{"traceparent": []byte("00-trace")},
+	}
+
+	retried := Msg{
+		Topic:     RetryTopic(topic, 1),
+		Partition: 0,
+		Offset:    3,
+		Key:       []byte("10"),
+		Payload:   []byte(`{"id":1}`),
+		Headers: map[string][]byte{
+			"traceparent":           []byte("00-trace"),
+			HeaderOriginalTopic:     []byte(topic),
+			HeaderOriginalPartition: []byte("2"),
+			HeaderOriginalOffset:    []byte("15"),
+			HeaderRetryStage:        []byte("1"),
+			HeaderRetryNotBefore:    []byte(strconv.FormatInt(time.Now().Add(-time.Second).UnixMilli(), 10)),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		policy    ErrorPolicy
+		publisher bool
+		fails     int
+		msg       Msg
+		calls     int
+		target    string
+		stage     string
+		notBefore bool
+		err       bool
+	}{
+		{
+			name:      "handled after retries in place",
+			publisher: true,
+			policy:    ErrorPolicy{Attempts: 3, Backoff: time.Millisecond},
+			fails:     2,
+			msg:       failed,
+			calls:     3,
+		},
+		{
+			name:      "next retry stage",
+			publisher: true,
+			policy:    ErrorPolicy{Attempts: 2, RetryDelays: []time.Duration{time.Minute}, DLQ: true},
+			fails:     2,
+			msg:       failed,
+			calls:     2,
+			target:    RetryTopic(topic, 1),
+			stage:     "1",
+			notBefore: true,
+		},
+		{
+			name:      "dlq after the last stage",
+			publisher: true,
+			policy:    ErrorPolicy{Attempts: 1, RetryDelays: []time.Duration{time.Minute}, DLQ: true},
+			fails:     1,
+			msg:       retried,
+			calls:     1,
+			target:    DLQTopic(topic),
+			stage:     "1",
+		},
+		{
+			name:      "dlq without retry stages",
+			publisher: true,
+			policy:    ErrorPolicy{Attempts: 1, DLQ: true},
+			fails:     1,
+			msg:       failed,
+			calls:     1,
+			target:    DLQTopic(topic),
+			stage:     "0",
+		},
+		{
+			name:      "dropped without dlq",
+			publisher: true,
+			policy:    ErrorPolicy{Attempts: 1, RetryDelays: []time.Duration{time.Minute}},
+			fails:     1,
+			msg:       retried,
+			calls:     1,
+		},
+		{
+			name:   "no publisher",
+			policy: ErrorPolicy{Attempts: 1, DLQ: true},
+			fails:  1,
+			msg:    failed,
+			calls:  1,
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := &fakePublisher{}
+			if tt.publisher {
+				tt.policy.Publisher = publisher
+			}
+
+			topicHandler := &fakeTopicHandler{fails: tt.fails}
+			h := NewConsumerGroupHandler(map[string]TopicHandler{topic: topicHandler}, WithErrorPolicy(tt.policy))
+
+			err := h.process(context.Background(), tt.msg)
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.calls, topicHandler.calls)
+
+			if tt.target == "" {
+				assert.Empty(t, publisher.sent)
+				return
+			}
+
+			require.Len(t, publisher.sent, 1)
+			sent := publisher.sent[0]
+			assert.Equal(t, tt.target, sent.topic)
+			assert.Equal(t, tt.msg.Key, sent.key)
+			assert.Equal(t, tt.msg.Payload, sent.payload)
+
+			assert.Equal(t, "00-trace", sent.headers["traceparent"])
+			assert.Equal(t, topic, sent.headers[HeaderOriginalTopic])
+			assert.Equal(t, "2", sent.headers[HeaderOriginalPartition])
+			assert.Equal(t, "15", sent.headers[HeaderOriginalOffset])
+			assert.Equal(t, tt.stage, sent.headers[HeaderRetryStage])
+			assert.Equal(t, "handle error", sent.headers[HeaderError])
+			assert.NotEmpty(t, sent.headers[HeaderFailedAt])
+
+			if !tt.notBefore {
+				assert.NotContains(t, sent.headers, HeaderRetryNotBefore)
+				return
+			}
+
+			millis, err := strconv.ParseInt(sent.headers[HeaderRetryNotBefore], 10, 64)
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), time.UnixMilli(millis), 5*time.Second)
+		})
+	}
+}
+
+func TestHandlerProcessPublisherError(t *testing.T) {
+	publisher := &fakePublisher{err: errors.New("kafka is down")}
+	h := NewConsumerGroupHandler(
+		map[string]TopicHandler{"loms.order-events": &fakeTopicHandler{fails: 1}},
+		WithErrorPolicy(ErrorPolicy{Attempts: 1, DLQ: true, Publisher: publisher}),
+	)
+
+	err := h.process(context.Background(), Msg{Topic: "loms.order-events"})
+	assert.ErrorIs(t, err, publisher.err)
+}
+
+func TestHandlerProcessUnknownTopic(t *testing.T) {
+	topicHandler := &fakeTopicHandler{}
+	h := NewConsumerGroupHandler(map[string]TopicHandler{"loms.order-events": topicHandler})
+
+	err := h.process(context.Background(), Msg{Topic: "loms.stock-events"})
+	assert.NoError(t, err)
+	assert.Zero(t, topicHandler.calls)
+}
+
+func TestHandlerProcessWaitsForRetryTime(t *testing.T) {
+	topicHandler := &fakeTopicHandler{}
+	h := NewConsumerGroupHandler(
+		map[string]TopicHandler{"loms.order-events": topicHandler},
+		WithErrorPolicy(ErrorPolicy{Attempts: 1, RetryDelays: []time.Duration{time.Hour}}),
+	)
+
+	msg := Msg{
+		Topic: RetryTopic("loms.order-events", 1),
+		Headers: map[string][]byte{
+			HeaderRetryNotBefore: []byte(strconv.FormatInt(time.Now().Add(time.Hour).UnixMilli(), 10)),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := h.process(ctx, msg)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Zero(t, topicHandler.calls)
+}
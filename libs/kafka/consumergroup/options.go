@@ -1,6 +1,8 @@
 package consumergroup
 
 import (
+	"time"
+
 	"github.com/IBM/sarama"
 )
 
@@ -27,3 +29,42 @@ func WithReturnSuccessesEnabled(isEnabled bool) Option {
 		return nil
 	})
 }
+
+type HandlerOption interface {
+	Apply(*Handler)
+}
+
+type handlerOptionFn func(*Handler)
+
+func (fn handlerOptionFn) Apply(h *Handler) {
+	fn(h)
+}
+
+func WithErrorPolicy(policy ErrorPolicy) HandlerOption {
+	return handlerOptionFn(func(h *Handler) {
+		h.errorPolicy = policy
+	})
+}
+
+// WithConcurrency handles up to workers messages of a claim at once. Messages with
+// the same key go to the same worker, so they are handled in the order they came in.
+func WithConcurrency(workers int) HandlerOption {
+	return handlerOptionFn(func(h *Handler) {
+		h.workers = max(workers, 1)
+	})
+}
+
+// WithCommitBatch commits offsets after size handled messages or every interval,
+// whichever comes first. A zero interval commits by size only.
+func WithCommitBatch(size int, interval time.Duration) HandlerOption {
+	return handlerOptionFn(func(h *Handler) {
+		h.commitBatch = max(size, 1)
+		h.commitInterval = interval
+	})
+}
+
+func WithCommitLagObserver(observer CommitLagObserver) HandlerOption {
+	return handlerOptionFn(func(h *Handler) {
+		h.lagObserver = observer
+	})
+}
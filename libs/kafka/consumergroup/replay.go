@@ -0,0 +1,165 @@
+package consumergroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// Replay republishes messages of the DLQ of the topic back to the topic they were first
+// published to, without the failure headers, and returns how many were sent.
+// The DLQ is read as the consumer group groupID and the progress is committed, so a message
+// is replayed once. Replay stops when it reaches the messages that were in the DLQ when it started.
+func Replay(
+	ctx context.Context,
+	brokers []string,
+	groupID string,
+	topic string,
+	publisher Publisher,
+	opts ...Option,
+) (int, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.MaxVersion
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = false
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		err := opt.Apply(config)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	offsetManager, err := sarama.NewOffsetManagerFromClient(groupID, client)
+	if err != nil {
+		return 0, err
+	}
+	defer offsetManager.Close()
+
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return 0, err
+	}
+	defer consumer.Close()
+
+	dlq := DLQTopic(topic)
+
+	partitions, err := client.Partitions(dlq)
+	if err != nil {
+		return 0, err
+	}
+
+	replayed := 0
+	for _, partition := range partitions {
+		n, err := replayPartition(ctx, client, offsetManager, consumer, dlq, partition, topic, publisher)
+		replayed += n
+		if err != nil {
+			return replayed, err
+		}
+	}
+
+	return replayed, nil
+}
+
+func replayPartition(
+	ctx context.Context,
+	client sarama.Client,
+	offsetManager sarama.OffsetManager,
+	consumer sarama.Consumer,
+	dlq string,
+	partition int32,
+	topic string,
+	publisher Publisher,
+) (int, error) {
+	oldest, err := client.GetOffset(dlq, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, err
+	}
+
+	newest, err := client.GetOffset(dlq, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, err
+	}
+
+	partitionOffsetManager, err := offsetManager.ManagePartition(dlq, partition)
+	if err != nil {
+		return 0, err
+	}
+	// Close would wait for the manager to release the partition, which with auto commit
+	// disabled happens only on a commit, so the commit flushes the offset and releases it.
+	defer func() {
+		partitionOffsetManager.AsyncClose()
+		offsetManager.Commit()
+	}()
+
+	next, _ := partitionOffsetManager.NextOffset()
+	if next < oldest {
+		next = oldest
+	}
+
+	if next >= newest {
+		return 0, nil
+	}
+
+	partitionConsumer, err := consumer.ConsumePartition(dlq, partition, next)
+	if err != nil {
+		return 0, err
+	}
+	defer partitionConsumer.Close()
+
+	replayed := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return replayed, ctx.Err()
+		case message, ok := <-partitionConsumer.Messages():
+			if !ok {
+				return replayed, nil
+			}
+
+			target, headers := replayMessage(topic, convertMsg(message))
+
+			_, _, err = publisher.SendMessage(target, message.Key, message.Value, headers)
+			if err != nil {
+				return replayed, fmt.Errorf("failed to replay message %d of partition %d: %w", message.Offset, partition, err)
+			}
+
+			partitionOffsetManager.MarkOffset(message.Offset+1, "")
+			replayed++
+
+			if message.Offset+1 >= newest {
+				return replayed, nil
+			}
+		}
+	}
+}
+
+// replayMessage returns where to send a DLQ message and its headers without the failure metadata.
+func replayMessage(topic string, msg Msg) (string, map[string]string) {
+	target := topic
+	if original, ok := msg.Headers[HeaderOriginalTopic]; ok {
+		target = string(original)
+	}
+
+	headers := make(map[string]string, len(msg.Headers))
+	for k, v := range msg.Headers {
+		switch k {
+		case HeaderOriginalTopic, HeaderOriginalPartition, HeaderOriginalOffset,
+			HeaderRetryStage, HeaderRetryNotBefore, HeaderError, HeaderFailedAt:
+			continue
+		}
+		headers[k] = string(v)
+	}
+
+	return target, headers
+}
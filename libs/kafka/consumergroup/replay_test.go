@@ -0,0 +1,179 @@
+package consumergroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     Msg
+		target  string
+		headers map[string]string
+	}{
+		{
+			name: "failure headers removed",
+			msg: Msg{
+				Topic: "loms.order-events.dlq",
+				Headers: map[string][]byte{
+					"traceparent":           []byte("00-trace"),
+					HeaderOriginalTopic:     []byte("loms.order-events"),
+					HeaderOriginalPartition: []byte("2"),
+					HeaderOriginalOffset:    []byte("15"),
+					HeaderRetryStage:        []byte("1"),
+					HeaderRetryNotBefore:    []byte("1728388800123"),
+					HeaderError:             []byte("handle error"),
+					HeaderFailedAt:          []byte("2024-10-08T12:00:00Z"),
+				},
+			},
+			target:  "loms.order-events",
+			headers: map[string]string{"traceparent": "00-trace"},
+		},
+		{
+			name:    "no original topic",
+			msg:     Msg{Topic: "loms.order-events.dlq"},
+			target:  "loms.stock-events",
+			headers: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, headers := replayMessage("loms.stock-events", tt.msg)
+			assert.Equal(t, tt.target, target)
+			assert.Equal(t, tt.headers, headers)
+		})
+	}
+}
+
+// replayBroker serves a DLQ partition holding offsets [oldest, newest) to the consumer group
+// that has committed committed.
+func replayBroker(t *testing.T, dlq string, oldest, newest, committed int64) *sarama.MockBroker {
+	broker := sarama.NewMockBroker(t, 1)
+
+	fetch := sarama.NewMockFetchResponse(t, 1)
+	for offset := oldest; offset < newest; offset++ {
+		fetch.SetMessageWithKey(dlq, 0, offset, sarama.StringEncoder("10"), sarama.StringEncoder("payload"))
+	}
+	fetch.SetHighWaterMark(dlq, 0, newest)
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(dlq, 0, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset(dlq, 0, sarama.OffsetOldest, oldest).
+			SetOffset(dlq, 0, sarama.OffsetNewest, newest),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, "replay", broker),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset("replay", dlq, 0, committed, "", sarama.ErrNoError),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+		"FetchRequest":        fetch,
+	})
+
+	return broker
+}
+
+// committedOffset returns the last offset the broker was asked to commit for the partition.
+func committedOffset(broker *sarama.MockBroker, topic string) (int64, bool) {
+	offset, ok := int64(0), false
+	for _, rr := range broker.History() {
+		req, isCommit := rr.Request.(*sarama.OffsetCommitRequest)
+		if !isCommit {
+			continue
+		}
+
+		committed, _, err := req.Offset(topic, 0)
+		if err == nil {
+			offset, ok = committed, true
+		}
+	}
+
+	return offset, ok
+}
+
+var testVersion = optionFn(func(c *sarama.Config) error {
+	c.Version = sarama.V2_1_0_0
+	c.Metadata.Retry.Max = 0
+	return nil
+})
+
+func TestReplay(t *testing.T) {
+	topic := "loms.order-events"
+	dlq := DLQTopic(topic)
+
+	tests := []struct {
+		name      string
+		committed int64
+		replayed  int
+	}{
+		{
+			name:      "from the oldest message",
+			committed: -1,
+			replayed:  3,
+		},
+		{
+			name:      "from the committed offset",
+			committed: 4,
+			replayed:  1,
+		},
+		{
+			name:      "nothing left",
+			committed: 5,
+			replayed:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := replayBroker(t, dlq, 2, 5, tt.committed)
+			defer broker.Close()
+
+			publisher := &fakePublisher{}
+
+			replayed, err := Replay(context.Background(), []string{broker.Addr()}, "replay", topic, publisher, testVersion)
+			require.NoError(t, err)
+			assert.Equal(t, tt.replayed, replayed)
+
+			require.Len(t, publisher.sent, tt.replayed)
+			for _, sent := range publisher.sent {
+				assert.Equal(t, topic, sent.topic)
+				assert.Equal(t, []byte("10"), sent.key)
+				assert.Equal(t, []byte("payload"), sent.payload)
+			}
+
+			offset, ok := committedOffset(broker, dlq)
+			if tt.replayed == 0 {
+				assert.False(t, ok)
+				return
+			}
+
+			require.True(t, ok)
+			assert.Equal(t, int64(5), offset)
+		})
+	}
+}
+
+func TestReplayPublisherError(t *testing.T) {
+	topic := "loms.order-events"
+	dlq := DLQTopic(topic)
+
+	broker := replayBroker(t, dlq, 0, 3, -1)
+	defer broker.Close()
+
+	publisher := &fakePublisher{err: errors.New("kafka is down")}
+
+	replayed, err := Replay(context.Background(), []string{broker.Addr()}, "replay", topic, publisher, testVersion)
+	assert.ErrorIs(t, err, publisher.err)
+	assert.Zero(t, replayed)
+
+	_, ok := committedOffset(broker, dlq)
+	assert.False(t, ok)
+}
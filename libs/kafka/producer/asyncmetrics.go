@@ -0,0 +1,68 @@
+package producer
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// AsyncProducerMetrics counts messages of an AsyncProducer by topic:
+// <namespace>_producer_<appName>_in_flight and <namespace>_producer_<appName>_messages_total
+// with the status label success or error.
+type AsyncProducerMetrics struct {
+	inFlightGauge   *prometheus.GaugeVec
+	messagesCounter *prometheus.CounterVec
+}
+
+// NewAsyncProducerMetrics registers the metrics in the default registry, so it is called once per app.
+func NewAsyncProducerMetrics(namespace, appName string) *AsyncProducerMetrics {
+	return &AsyncProducerMetrics{
+		inFlightGauge: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "producer",
+				Name:      appName + "_in_flight",
+				Help:      "Количество отправленных сообщений без результата",
+			},
+			[]string{"topic"},
+		),
+		messagesCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "producer",
+				Name:      appName + "_messages_total",
+				Help:      "Количество отправленных сообщений по результату",
+			},
+			[]string{"topic", "status"},
+		),
+	}
+}
+
+func (m *AsyncProducerMetrics) incInFlight(topic string) {
+	if m == nil {
+		return
+	}
+
+	m.inFlightGauge.WithLabelValues(topic).Inc()
+}
+
+func (m *AsyncProducerMetrics) decInFlight(topic string) {
+	if m == nil {
+		return
+	}
+
+	m.inFlightGauge.WithLabelValues(topic).Dec()
+}
+
+func (m *AsyncProducerMetrics) record(topic string, err error) {
+	if m == nil {
+		return
+	}
+
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+
+	m.inFlightGauge.WithLabelValues(topic).Dec()
+	m.messagesCounter.WithLabelValues(topic, status).Inc()
+}
@@ -0,0 +1,286 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	"github.com/IBM/sarama"
+)
+
+const (
+	defaultAsyncFlushMessages  = 100
+	defaultAsyncFlushFrequency = 10 * time.Millisecond
+)
+
+var ErrProducerClosed = errors.New("producer is closed")
+
+// Result is the outcome of an asynchronously sent message. Metadata is the value
+// passed to SendMessage, it tells the caller which message the result is for.
+type Result struct {
+	Topic     string
+	Key       []byte
+	Partition int32
+	Offset    int64
+	Metadata  any
+	Err       error
+}
+
+type Message struct {
+	Topic   string
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// batchAck collects the results of a batch, every message carries a batchItem as its metadata.
+type batchAck struct {
+	errs    []error
+	pending atomic.Int64
+	done    chan struct{}
+}
+
+type batchItem struct {
+	ack   *batchAck
+	index int
+}
+
+// ResultHandler receives the result of every sent message. It is called from
+// a single goroutine, so a slow handler slows down the producer.
+type ResultHandler func(Result)
+
+// AsyncProducer batches messages and sends them in the background. Messages are flushed
+// every defaultAsyncFlushMessages messages or defaultAsyncFlushFrequency, whichever comes
+// first, unless WithProducerFlushMessages or WithProducerFlushFrequency say otherwise.
+type AsyncProducer struct {
+	asyncProducer sarama.AsyncProducer
+	onResult      ResultHandler
+	metrics       *AsyncProducerMetrics
+
+	mu       sync.RWMutex
+	closed   bool
+	closing  chan struct{}
+	sending  sync.WaitGroup
+	inFlight atomic.Int64
+	wg       sync.WaitGroup
+}
+
+// NewAsyncProducer creates a producer that reports results to onResult.
+// Both onResult and metrics may be nil.
+func NewAsyncProducer(
+	conf kafka.Config,
+	onResult ResultHandler,
+	metrics *AsyncProducerMetrics,
+	opts ...Option,
+) (*AsyncProducer, error) {
+	config := PrepareConfig(append([]Option{
+		WithProducerFlushMessages(defaultAsyncFlushMessages),
+		WithProducerFlushFrequency(defaultAsyncFlushFrequency),
+	}, opts...)...)
+
+	// results are read from both channels, without them the producer would not know when a message is done
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+
+	asyncProducer, err := sarama.NewAsyncProducer(conf.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("NewAsyncProducer failed: %w", err)
+	}
+
+	return newAsyncProducer(asyncProducer, onResult, metrics), nil
+}
+
+// newAsyncProducer wraps a sarama producer that returns both successes and errors.
+func newAsyncProducer(asyncProducer sarama.AsyncProducer, onResult ResultHandler, metrics *AsyncProducerMetrics) *AsyncProducer {
+	p := &AsyncProducer{
+		asyncProducer: asyncProducer,
+		onResult:      onResult,
+		metrics:       metrics,
+		closing:       make(chan struct{}),
+	}
+
+	p.wg.Add(2)
+	go p.readSuccesses()
+	go p.readErrors()
+
+	return p
+}
+
+// SendMessage queues the message and returns without waiting for the broker.
+// It blocks only when the producer input is full, until ctx is done or the producer is closed.
+func (p *AsyncProducer) SendMessage(
+	ctx context.Context,
+	topicName string,
+	key []byte,
+	message []byte,
+	headers map[string]string,
+	metadata any,
+) error {
+	return p.send(ctx, Message{
+		Topic:   topicName,
+		Key:     key,
+		Value:   message,
+		Headers: headers,
+	}, metadata)
+}
+
+// SendBatch queues the messages and waits for all of them to be sent or failed.
+// errs[i] is the result of msgs[i]. The results of a batch are not passed to the ResultHandler.
+// When a message can not be queued, it and the messages after it fail with that error
+// and the batch waits only for the queued ones. The returned error means ctx is done,
+// the queued messages may still be sent.
+func (p *AsyncProducer) SendBatch(ctx context.Context, msgs []Message) (errs []error, err error) {
+	ack := &batchAck{
+		errs: make([]error, len(msgs)),
+		done: make(chan struct{}),
+	}
+	ack.pending.Store(int64(len(msgs)))
+
+	if len(msgs) == 0 {
+		return ack.errs, nil
+	}
+
+	for i, msg := range msgs {
+		err = p.send(ctx, msg, batchItem{ack: ack, index: i})
+		if err != nil {
+			for j := i; j < len(msgs); j++ {
+				ack.errs[j] = err
+			}
+			if ack.pending.Add(-int64(len(msgs)-i)) == 0 {
+				close(ack.done)
+			}
+			break
+		}
+	}
+
+	select {
+	case <-ack.done:
+		return ack.errs, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// send does not hold the lock while it waits for the input, Close waits for it through sending instead.
+func (p *AsyncProducer) send(ctx context.Context, message Message, metadata any) error {
+	kafkaHeaders := make([]sarama.RecordHeader, 0, len(message.Headers))
+	for k, v := range message.Headers {
+		kafkaHeaders = append(kafkaHeaders, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic:     message.Topic,
+		Key:       sarama.ByteEncoder(message.Key),
+		Value:     sarama.ByteEncoder(message.Value),
+		Headers:   kafkaHeaders,
+		Timestamp: time.Now().UTC(),
+		Metadata:  metadata,
+	}
+
+	p.mu.RLock()
+	if p.closed {
+		p.mu.RUnlock()
+		return ErrProducerClosed
+	}
+	p.sending.Add(1)
+	p.mu.RUnlock()
+	defer p.sending.Done()
+
+	// counted before the message is queued, so its result never comes first
+	p.inFlight.Add(1)
+	p.metrics.incInFlight(message.Topic)
+
+	select {
+	case p.asyncProducer.Input() <- msg:
+		return nil
+	case <-ctx.Done():
+		p.unqueued(message.Topic)
+		return ctx.Err()
+	case <-p.closing:
+		p.unqueued(message.Topic)
+		return ErrProducerClosed
+	}
+}
+
+func (p *AsyncProducer) unqueued(topic string) {
+	p.inFlight.Add(-1)
+	p.metrics.decInFlight(topic)
+}
+
+// InFlight is how many messages are sent but have no result yet.
+func (p *AsyncProducer) InFlight() int64 {
+	return p.inFlight.Load()
+}
+
+func (p *AsyncProducer) readSuccesses() {
+	defer p.wg.Done()
+
+	for msg := range p.asyncProducer.Successes() {
+		p.report(msg, nil)
+	}
+}
+
+func (p *AsyncProducer) readErrors() {
+	defer p.wg.Done()
+
+	for producerErr := range p.asyncProducer.Errors() {
+		p.report(producerErr.Msg, producerErr.Err)
+	}
+}
+
+func (p *AsyncProducer) report(msg *sarama.ProducerMessage, err error) {
+	p.inFlight.Add(-1)
+	p.metrics.record(msg.Topic, err)
+
+	if item, ok := msg.Metadata.(batchItem); ok {
+		item.ack.errs[item.index] = err
+		if item.ack.pending.Add(-1) == 0 {
+			close(item.ack.done)
+		}
+		return
+	}
+
+	if p.onResult == nil {
+		return
+	}
+
+	var key []byte
+	if msg.Key != nil {
+		key, _ = msg.Key.Encode()
+	}
+
+	p.onResult(Result{
+		Topic:     msg.Topic,
+		Key:       key,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Metadata:  msg.Metadata,
+		Err:       err,
+	})
+}
+
+// Close stops accepting messages, flushes the queued ones and waits until
+// the result of every sent message is reported.
+func (p *AsyncProducer) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.closing)
+	p.mu.Unlock()
+
+	// AsyncClose closes the input, so every sender has to give up or finish first
+	p.sending.Wait()
+
+	// errors of the drained messages are reported through the errors channel, not returned by AsyncClose
+	p.asyncProducer.AsyncClose()
+	p.wg.Wait()
+
+	return nil
+}
@@ -0,0 +1,276 @@
+package producer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+
+	return config
+}
+
+type results struct {
+	mu      sync.Mutex
+	results []Result
+}
+
+func (r *results) handle(result Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, result)
+}
+
+// all returns the results ordered by metadata, successes and errors are reported
+// from different goroutines, so they may come in any order.
+func (r *results) all() []Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	all := append([]Result(nil), r.results...)
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Metadata.(int) < all[j].Metadata.(int)
+	})
+
+	return all
+}
+
+// stuckProducer keeps up to size messages in its input and never sends them,
+// on AsyncClose it reports them as sent like sarama drains its queue.
+type stuckProducer struct {
+	sarama.AsyncProducer
+
+	input     chan *sarama.ProducerMessage
+	successes chan *sarama.ProducerMessage
+	errors    chan *sarama.ProducerError
+}
+
+func newStuckProducer(size int) *stuckProducer {
+	return &stuckProducer{
+		input:     make(chan *sarama.ProducerMessage, size),
+		successes: make(chan *sarama.ProducerMessage),
+		errors:    make(chan *sarama.ProducerError),
+	}
+}
+
+func (p *stuckProducer) Input() chan<- *sarama.ProducerMessage {
+	return p.input
+}
+
+func (p *stuckProducer) Successes() <-chan *sarama.ProducerMessage {
+	return p.successes
+}
+
+func (p *stuckProducer) Errors() <-chan *sarama.ProducerError {
+	return p.errors
+}
+
+func (p *stuckProducer) AsyncClose() {
+	close(p.input)
+
+	go func() {
+		for msg := range p.input {
+			p.successes <- msg
+		}
+		close(p.successes)
+		close(p.errors)
+	}()
+}
+
+func TestAsyncProducerResults(t *testing.T) {
+	mock := mocks.NewAsyncProducer(t, mockConfig())
+	mock.ExpectInputAndSucceed()
+	mock.ExpectInputAndFail(sarama.ErrOutOfBrokers)
+
+	var r results
+	p := newAsyncProducer(mock, r.handle, nil)
+
+	ctx := context.Background()
+	require.NoError(t, p.SendMessage(ctx, "loms.order-events", []byte("10"), []byte("payload"), nil, 1))
+	require.NoError(t, p.SendMessage(ctx, "loms.order-events", []byte("11"), []byte("payload"), nil, 2))
+	require.NoError(t, p.Close())
+
+	all := r.all()
+	require.Len(t, all, 2)
+
+	assert.Equal(t, "loms.order-events", all[0].Topic)
+	assert.Equal(t, []byte("10"), all[0].Key)
+	assert.Equal(t, 1, all[0].Metadata)
+	assert.NoError(t, all[0].Err)
+
+	assert.Equal(t, []byte("11"), all[1].Key)
+	assert.Equal(t, 2, all[1].Metadata)
+	assert.ErrorIs(t, all[1].Err, sarama.ErrOutOfBrokers)
+
+	assert.Zero(t, p.InFlight())
+}
+
+func TestAsyncProducerSendBatch(t *testing.T) {
+	mock := mocks.NewAsyncProducer(t, mockConfig())
+	mock.ExpectInputAndSucceed()
+	mock.ExpectInputAndFail(sarama.ErrMessageSizeTooLarge)
+	mock.ExpectInputAndSucceed()
+
+	var r results
+	p := newAsyncProducer(mock, r.handle, nil)
+	defer p.Close()
+
+	errs, err := p.SendBatch(context.Background(), []Message{
+		{Topic: "loms.order-events", Key: []byte("10")},
+		{Topic: "loms.order-events", Key: []byte("11")},
+		{Topic: "loms.stock-events", Key: []byte("1076963")},
+	})
+	require.NoError(t, err)
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], sarama.ErrMessageSizeTooLarge)
+	assert.NoError(t, errs[2])
+
+	// results of a batch go only to its caller
+	assert.Empty(t, r.all())
+	assert.Zero(t, p.InFlight())
+
+	errs, err = p.SendBatch(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, errs)
+}
+
+func TestAsyncProducerCloseDrains(t *testing.T) {
+	const count = 50
+
+	mock := mocks.NewAsyncProducer(t, mockConfig())
+	for i := 0; i < count; i++ {
+		mock.ExpectInputAndSucceed()
+	}
+
+	var r results
+	p := newAsyncProducer(mock, r.handle, nil)
+
+	for i := 0; i < count; i++ {
+		require.NoError(t, p.SendMessage(context.Background(), "loms.order-events", nil, nil, nil, i))
+	}
+	require.NoError(t, p.Close())
+
+	// every result is reported before Close returns
+	all := r.all()
+	require.Len(t, all, count)
+	for i, result := range all {
+		assert.Equal(t, i, result.Metadata)
+	}
+
+	err := p.SendMessage(context.Background(), "loms.order-events", nil, nil, nil, count)
+	assert.ErrorIs(t, err, ErrProducerClosed)
+
+	_, err = p.SendBatch(context.Background(), []Message{{Topic: "loms.order-events"}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, p.Close())
+}
+
+func TestAsyncProducerSendCanceled(t *testing.T) {
+	p := newAsyncProducer(newStuckProducer(0), nil, nil)
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := p.SendMessage(ctx, "loms.order-events", nil, nil, nil, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Zero(t, p.InFlight())
+}
+
+func TestAsyncProducerCloseUnblocksSend(t *testing.T) {
+	p := newAsyncProducer(newStuckProducer(0), nil, nil)
+
+	sent := make(chan error, 1)
+	go func() {
+		sent <- p.SendMessage(context.Background(), "loms.order-events", nil, nil, nil, nil)
+	}()
+
+	// let the message wait for the input
+	time.Sleep(20 * time.Millisecond)
+
+	closed := make(chan error, 1)
+	go func() {
+		closed <- p.Close()
+	}()
+
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Close is blocked by a message waiting for the input")
+	}
+
+	assert.ErrorIs(t, <-sent, ErrProducerClosed)
+	assert.Zero(t, p.InFlight())
+}
+
+func TestAsyncProducerSendBatchPartlyQueued(t *testing.T) {
+	p := newAsyncProducer(newStuckProducer(2), nil, nil)
+
+	type batchResult struct {
+		errs []error
+		err  error
+	}
+
+	done := make(chan batchResult, 1)
+	go func() {
+		errs, err := p.SendBatch(context.Background(), []Message{
+			{Topic: "loms.order-events", Key: []byte("10")},
+			{Topic: "loms.order-events", Key: []byte("10")},
+			{Topic: "loms.order-events", Key: []byte("10")},
+			{Topic: "loms.order-events", Key: []byte("10")},
+		})
+		done <- batchResult{errs: errs, err: err}
+	}()
+
+	// two messages fit into the input, the third one waits for it
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, p.Close())
+
+	var result batchResult
+	select {
+	case result = <-done:
+	case <-time.After(time.Second):
+		t.Fatal("batch is still waiting for messages that were not queued")
+	}
+
+	// the queued messages have their results, the rest failed to be queued
+	require.NoError(t, result.err)
+	require.Len(t, result.errs, 4)
+	assert.NoError(t, result.errs[0])
+	assert.NoError(t, result.errs[1])
+	assert.ErrorIs(t, result.errs[2], ErrProducerClosed)
+	assert.ErrorIs(t, result.errs[3], ErrProducerClosed)
+	assert.Zero(t, p.InFlight())
+}
+
+func TestAsyncProducerSendBatchCanceled(t *testing.T) {
+	p := newAsyncProducer(newStuckProducer(1), nil, nil)
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	errs, err := p.SendBatch(ctx, []Message{
+		{Topic: "loms.order-events"},
+		{Topic: "loms.order-events"},
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, errs)
+	// the first message is queued and still waits for its result
+	assert.Equal(t, int64(1), p.InFlight())
+}
@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var _ propagation.TextMapCarrier = HeaderCarrier(nil)
+
+// HeaderCarrier lets the otel propagator read and write the headers of a consumed message.
+type HeaderCarrier map[string][]byte
+
+func (c HeaderCarrier) Get(key string) string {
+	if val, ok := c[key]; ok {
+		return string(val)
+	}
+	return ""
+}
+
+func (c HeaderCarrier) Set(key string, value string) {
+	c[key] = []byte(value)
+}
+
+func (c HeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// InjectTraceContext writes the trace context of ctx to the headers with the global propagator
+// and returns them. A nil map is allocated.
+func InjectTraceContext(ctx context.Context, headers map[string]string) map[string]string {
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.MapCarrier(headers))
+
+	return headers
+}
+
+// ExtractTraceContext returns ctx with the trace context read from the headers of a consumed message.
+func ExtractTraceContext(ctx context.Context, headers map[string][]byte) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, HeaderCarrier(headers))
+}
@@ -0,0 +1,353 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultBatchSize       = 100
+	defaultMinPollInterval = 100 * time.Millisecond
+	defaultMaxPollInterval = 5 * time.Second
+)
+
+type BacklogObserver func(Backlog)
+
+// Dispatcher publishes messages of a Store. A worker locks a batch of messages in a transaction,
+// publishes it and marks the sent messages. Messages that failed to be published stay
+// in the outbox for the next batch.
+type Dispatcher struct {
+	store     *Store
+	publisher Publisher
+
+	workers         int
+	batchSize       int32
+	minPollInterval time.Duration
+	maxPollInterval time.Duration
+
+	retention        time.Duration
+	cleanupInterval  time.Duration
+	cleanupBatchSize int32
+	archive          bool
+
+	backlogObserver BacklogObserver
+	backlogInterval time.Duration
+
+	wake      chan struct{}
+	stopChan  chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewDispatcher(store *Store, publisher Publisher, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		store:           store,
+		publisher:       publisher,
+		workers:         1,
+		batchSize:       defaultBatchSize,
+		minPollInterval: defaultMinPollInterval,
+		maxPollInterval: defaultMaxPollInterval,
+		stopChan:        make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt.Apply(d)
+	}
+
+	d.wake = make(chan struct{}, d.workers)
+
+	return d
+}
+
+// Start runs the workers, and the cleaner and the backlog reporter when they are configured,
+// until ctx is done or the dispatcher is closed.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for i := 0; i < d.workers; i++ {
+		d.run(func() { d.runWorker(ctx) })
+	}
+
+	if d.retention > 0 && d.cleanupInterval > 0 {
+		d.run(func() { d.every(ctx, d.cleanupInterval, d.clean) })
+	}
+
+	if d.backlogObserver != nil && d.backlogInterval > 0 {
+		d.run(func() {
+			d.reportBacklog(ctx)
+			d.every(ctx, d.backlogInterval, d.reportBacklog)
+		})
+	}
+}
+
+// Wake ends the poll wait of the workers, call it when new messages were added,
+// e.g. on a notification of Store.Channel.
+func (d *Dispatcher) Wake() {
+	for i := 0; i < d.workers; i++ {
+		select {
+		case d.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Close stops taking new batches and waits until the batches being published are marked as sent.
+func (d *Dispatcher) Close() {
+	d.closeOnce.Do(func() {
+		close(d.stopChan)
+	})
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) run(f func()) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		f()
+	}()
+}
+
+func (d *Dispatcher) stopped(ctx context.Context) bool {
+	select {
+	case <-d.stopChan:
+		return true
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *Dispatcher) every(ctx context.Context, interval time.Duration, f func(context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f(ctx)
+		}
+	}
+}
+
+// runWorker publishes batches back to back while they come full. Otherwise it waits
+// minPollInterval, and while the outbox stays empty the wait doubles up to maxPollInterval.
+func (d *Dispatcher) runWorker(ctx context.Context) {
+	interval := d.minPollInterval
+
+	for !d.stopped(ctx) {
+		count, err := d.dispatch(ctx)
+		if err != nil {
+			slog.Error("Error dispatching outbox messages", "table", d.store.table, "error", err)
+		}
+
+		var wait bool
+		interval, wait = d.nextPollInterval(interval, count, err)
+		if !wait {
+			continue
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+		case <-d.stopChan:
+		case <-d.wake:
+			interval = d.minPollInterval
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// nextPollInterval returns the wait after a batch of count messages and whether to wait at all.
+func (d *Dispatcher) nextPollInterval(interval time.Duration, count int, err error) (time.Duration, bool) {
+	switch {
+	case err != nil:
+		return min(interval*2, d.maxPollInterval), true
+	case count == int(d.batchSize):
+		return d.minPollInterval, false
+	case count > 0:
+		return d.minPollInterval, true
+	default:
+		return min(interval*2, d.maxPollInterval), true
+	}
+}
+
+// dispatch publishes one batch and returns how many messages it had.
+func (d *Dispatcher) dispatch(ctx context.Context) (count int, err error) {
+	tx, err := d.store.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	msgs, err := d.store.Fetch(ctx, tx, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+
+	sentIDs, publishErr := d.publish(ctx, msgs)
+	if len(sentIDs) == 0 {
+		return len(msgs), publishErr
+	}
+
+	err = d.store.MarkSent(ctx, tx, sentIDs)
+	if err != nil {
+		return len(msgs), err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return len(msgs), err
+	}
+
+	return len(msgs), publishErr
+}
+
+func (d *Dispatcher) publish(ctx context.Context, msgs []Message) (sentIDs []int64, err error) {
+	tr := otel.Tracer("outbox")
+	ctx, span := tr.Start(ctx, "PublishOutboxMessages")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("table", d.store.table),
+		attribute.Int("batchSize", len(msgs)),
+	)
+
+	// Every message gets its own producer span, a child of the trace the message was created in.
+	spans := make([]trace.Span, len(msgs))
+	headers := make([]map[string]string, len(msgs))
+	for i, msg := range msgs {
+		headers[i], spans[i] = d.startMessageSpan(ctx, tr, msg)
+	}
+	defer func() {
+		for _, span := range spans {
+			span.End()
+		}
+	}()
+
+	errs := make([]error, len(msgs))
+	if batcher, ok := d.publisher.(BatchPublisher); ok {
+		batch := make([]producer.Message, 0, len(msgs))
+		for i, msg := range msgs {
+			batch = append(batch, producer.Message{
+				Topic:   msg.Topic,
+				Key:     msg.Key,
+				Value:   msg.Payload,
+				Headers: headers[i],
+			})
+		}
+
+		errs, err = batcher.SendBatch(ctx, batch)
+		if err != nil {
+			for _, span := range spans {
+				tracing.RecordSpanError(span, err)
+			}
+			return nil, err
+		}
+	} else {
+		for i, msg := range msgs {
+			_, _, errs[i] = d.publisher.SendMessage(msg.Topic, msg.Key, msg.Payload, headers[i])
+		}
+	}
+
+	for i, span := range spans {
+		tracing.RecordSpanError(span, errs[i])
+	}
+
+	sentIDs = make([]int64, 0, len(msgs))
+	var sendErrs []error
+	for i, msg := range msgs {
+		if errs[i] != nil {
+			sendErrs = append(sendErrs, fmt.Errorf("message %d: %w", msg.ID, errs[i]))
+			continue
+		}
+		sentIDs = append(sentIDs, msg.ID)
+	}
+
+	span.SetAttributes(attribute.Int("sent", len(sentIDs)))
+
+	return sentIDs, errors.Join(sendErrs...)
+}
+
+// clean removes old messages batch by batch, so a large backlog does not hold locks for long,
+// and stops on the first batch that is not full.
+func (d *Dispatcher) clean(ctx context.Context) {
+	var total int64
+	defer func() {
+		if total > 0 {
+			slog.Info("Old outbox messages removed", "table", d.store.table, "count", total, "archive", d.archive)
+		}
+	}()
+
+	for !d.stopped(ctx) {
+		deleted, err := d.store.DeleteSent(ctx, d.retention, d.cleanupBatchSize, d.archive)
+		if err != nil {
+			slog.Error("Error removing old outbox messages", "table", d.store.table, "error", err)
+			return
+		}
+
+		total += deleted
+		if deleted < int64(d.cleanupBatchSize) {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) reportBacklog(ctx context.Context) {
+	backlog, err := d.store.Backlog(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("Error getting outbox backlog", "table", d.store.table, "error", err)
+		}
+		return
+	}
+
+	d.backlogObserver(backlog)
+}
+
+// startMessageSpan starts the span of sending the message. The span continues the trace
+// stored in the message headers and is linked to the batch span. The returned headers
+// carry the context of the new span.
+func (d *Dispatcher) startMessageSpan(ctx context.Context, tr trace.Tracer, msg Message) (map[string]string, trace.Span) {
+	msgCtx := otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(msg.Headers))
+
+	msgCtx, span := tr.Start(msgCtx, "PublishOutboxMessage",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithLinks(trace.LinkFromContext(ctx)),
+		trace.WithAttributes(
+			attribute.Int64("id", msg.ID),
+			attribute.String("topic", msg.Topic),
+		),
+	)
+
+	headers := make(map[string]string, len(msg.Headers)+2)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+
+	return kafka.InjectTraceContext(msgCtx, headers), span
+}
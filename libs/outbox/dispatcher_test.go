@@ -0,0 +1,521 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDB keeps the outbox in memory and answers the statements of a Store with the default table.
+// Messages marked in a transaction become sent when it is committed.
+type fakeDB struct {
+	mu      sync.Mutex
+	queries queries
+
+	msgs     []Message
+	sent     map[int64]bool
+	fetchErr error
+	markErr  error
+
+	// deleted are the results of DeleteSent calls, deleteErr is returned after them.
+	deleted     []int64
+	deleteErr   error
+	deleteCalls []deleteCall
+
+	begins  int
+	commits int
+}
+
+type deleteCall struct {
+	query        string
+	retentionMks float64
+	limit        int32
+}
+
+func newFakeDB(msgs ...Message) *fakeDB {
+	return &fakeDB{
+		queries: buildQueries(DefaultTable),
+		msgs:    msgs,
+		sent:    make(map[int64]bool),
+	}
+}
+
+func (db *fakeDB) add(msgs ...Message) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.msgs = append(db.msgs, msgs...)
+}
+
+func (db *fakeDB) sentIDs() []int64 {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var ids []int64
+	for _, msg := range db.msgs {
+		if db.sent[msg.ID] {
+			ids = append(ids, msg.ID)
+		}
+	}
+	return ids
+}
+
+func (db *fakeDB) beginCount() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.begins
+}
+
+func (db *fakeDB) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch sql {
+	case db.queries.deleteSent, db.queries.archiveSent:
+		db.deleteCalls = append(db.deleteCalls, deleteCall{
+			query:        sql,
+			retentionMks: args[0].(float64),
+			limit:        args[1].(int32),
+		})
+		if len(db.deleteCalls) > len(db.deleted) {
+			return pgconn.CommandTag{}, db.deleteErr
+		}
+		return pgconn.NewCommandTag(fmt.Sprintf("DELETE %d", db.deleted[len(db.deleteCalls)-1])), nil
+	default:
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected statement: %s", sql)
+	}
+}
+
+func (db *fakeDB) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, errors.New("query out of a transaction")
+}
+
+func (db *fakeDB) QueryRow(context.Context, string, ...any) pgx.Row {
+	return nil
+}
+
+func (db *fakeDB) Begin(context.Context) (pgx.Tx, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.begins++
+	return &fakeTx{db: db}, nil
+}
+
+type fakeTx struct {
+	pgx.Tx
+	db     *fakeDB
+	marked []int64
+}
+
+func (tx *fakeTx) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+
+	if sql != tx.db.queries.fetch {
+		return nil, fmt.Errorf("unexpected query: %s", sql)
+	}
+	if tx.db.fetchErr != nil {
+		return nil, tx.db.fetchErr
+	}
+
+	limit := int(args[0].(int32))
+	rows := &fakeRows{}
+	for _, msg := range tx.db.msgs {
+		if len(rows.msgs) == limit {
+			break
+		}
+		if !tx.db.sent[msg.ID] {
+			rows.msgs = append(rows.msgs, msg)
+		}
+	}
+
+	return rows, nil
+}
+
+func (tx *fakeTx) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if sql != tx.db.queries.markSent {
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected statement: %s", sql)
+	}
+	if tx.db.markErr != nil {
+		return pgconn.CommandTag{}, tx.db.markErr
+	}
+
+	tx.marked = append(tx.marked, args[0].([]int64)...)
+	return pgconn.NewCommandTag(fmt.Sprintf("UPDATE %d", len(tx.marked))), nil
+}
+
+func (tx *fakeTx) Commit(context.Context) error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+
+	for _, id := range tx.marked {
+		tx.db.sent[id] = true
+	}
+	tx.db.commits++
+	return nil
+}
+
+func (tx *fakeTx) Rollback(context.Context) error {
+	return nil
+}
+
+type fakeRows struct {
+	pgx.Rows
+	msgs []Message
+	next int
+}
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.msgs)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	msg := r.msgs[r.next-1]
+	*dest[0].(*int64) = msg.ID
+	*dest[1].(*string) = msg.Topic
+	*dest[2].(*[]byte) = msg.Key
+	*dest[3].(*[]byte) = msg.Payload
+	*dest[4].(*map[string]string) = msg.Headers
+	*dest[5].(*time.Time) = msg.CreatedAt
+	return nil
+}
+
+func (r *fakeRows) Close() {}
+
+func (r *fakeRows) Err() error {
+	return nil
+}
+
+// fakePublisher fails the messages whose keys are in errs.
+type fakePublisher struct {
+	mu   sync.Mutex
+	errs map[string]error
+	sent []string
+}
+
+func (p *fakePublisher) SendMessage(_ string, key []byte, _ []byte, _ map[string]string) (int32, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.errs[string(key)]; err != nil {
+		return 0, 0, err
+	}
+	p.sent = append(p.sent, string(key))
+	return 0, 0, nil
+}
+
+func (p *fakePublisher) sentKeys() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]string(nil), p.sent...)
+}
+
+type fakeBatchPublisher struct {
+	errs    []error
+	err     error
+	batches [][]producer.Message
+}
+
+func (p *fakeBatchPublisher) SendBatch(_ context.Context, msgs []producer.Message) ([]error, error) {
+	p.batches = append(p.batches, msgs)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.errs, nil
+}
+
+func testMessages() []Message {
+	return []Message{
+		{ID: 1, Topic: "loms.order-events", Key: []byte("10"), Payload: []byte(`{"id":1}`), Headers: map[string]string{"x-source": "loms"}},
+		{ID: 2, Topic: "loms.order-events", Key: []byte("20"), Payload: []byte(`{"id":2}`)},
+	}
+}
+
+func TestDispatcherDispatch(t *testing.T) {
+	tests := []struct {
+		name            string
+		batchSize       int32
+		msgs            []Message
+		fetchErr        error
+		sendErrs        []error
+		sendErr         error
+		markErr         error
+		expectedCount   int
+		expectedSent    []int64
+		expectedCommits int
+		expectedError   bool
+	}{
+		{
+			name:      "empty outbox",
+			batchSize: 2,
+		},
+		{
+			name:          "fetch error",
+			batchSize:     2,
+			msgs:          testMessages(),
+			fetchErr:      errors.New("db error"),
+			expectedError: true,
+		},
+		{
+			name:            "full batch",
+			batchSize:       2,
+			msgs:            testMessages(),
+			sendErrs:        []error{nil, nil},
+			expectedCount:   2,
+			expectedSent:    []int64{1, 2},
+			expectedCommits: 1,
+		},
+		{
+			name:            "partial batch",
+			batchSize:       10,
+			msgs:            testMessages(),
+			sendErrs:        []error{nil, nil},
+			expectedCount:   2,
+			expectedSent:    []int64{1, 2},
+			expectedCommits: 1,
+		},
+		{
+			name:            "failed message stays in outbox",
+			batchSize:       2,
+			msgs:            testMessages(),
+			sendErrs:        []error{errors.New("broker error"), nil},
+			expectedCount:   2,
+			expectedSent:    []int64{2},
+			expectedCommits: 1,
+			expectedError:   true,
+		},
+		{
+			name:          "no message sent",
+			batchSize:     2,
+			msgs:          testMessages(),
+			sendErrs:      []error{errors.New("broker error"), errors.New("broker error")},
+			expectedCount: 2,
+			expectedError: true,
+		},
+		{
+			name:          "batch not sent",
+			batchSize:     2,
+			msgs:          testMessages(),
+			sendErr:       context.DeadlineExceeded,
+			expectedCount: 2,
+			expectedError: true,
+		},
+		{
+			name:          "mark error",
+			batchSize:     2,
+			msgs:          testMessages(),
+			sendErrs:      []error{nil, nil},
+			markErr:       errors.New("db error"),
+			expectedCount: 2,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeDB(tt.msgs...)
+			db.fetchErr = tt.fetchErr
+			db.markErr = tt.markErr
+
+			publisher := &fakeBatchPublisher{errs: tt.sendErrs, err: tt.sendErr}
+			d := NewDispatcher(NewStore(db), Batched(publisher), WithBatchSize(tt.batchSize))
+
+			count, err := d.dispatch(context.Background())
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCount, count)
+			assert.Equal(t, tt.expectedSent, db.sentIDs())
+			assert.Equal(t, tt.expectedCommits, db.commits)
+
+			if tt.expectedCount > 0 && tt.fetchErr == nil {
+				assert.Len(t, publisher.batches, 1)
+				assert.Len(t, publisher.batches[0], tt.expectedCount)
+				assert.Equal(t, []byte("10"), publisher.batches[0][0].Key)
+				assert.Equal(t, "loms", publisher.batches[0][0].Headers["x-source"])
+			}
+		})
+	}
+}
+
+func TestDispatcherDispatchMessageByMessage(t *testing.T) {
+	db := newFakeDB(testMessages()...)
+	brokerErr := errors.New("broker error")
+	publisher := &fakePublisher{errs: map[string]error{"10": brokerErr}}
+
+	d := NewDispatcher(NewStore(db), publisher, WithBatchSize(10))
+
+	count, err := d.dispatch(context.Background())
+	assert.ErrorIs(t, err, brokerErr)
+	assert.ErrorContains(t, err, "message 1")
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"20"}, publisher.sentKeys())
+	assert.Equal(t, []int64{2}, db.sentIDs())
+}
+
+func TestDispatcherNextPollInterval(t *testing.T) {
+	const (
+		minInterval = 100 * time.Millisecond
+		maxInterval = time.Second
+	)
+
+	d := NewDispatcher(NewStore(newFakeDB()), &fakePublisher{},
+		WithBatchSize(10),
+		WithPollInterval(minInterval, maxInterval),
+	)
+
+	tests := []struct {
+		name             string
+		interval         time.Duration
+		count            int
+		err              error
+		expectedInterval time.Duration
+		expectedWait     bool
+	}{
+		{
+			name:             "full batch is followed by the next one",
+			interval:         400 * time.Millisecond,
+			count:            10,
+			expectedInterval: minInterval,
+		},
+		{
+			name:             "partial batch resets the interval",
+			interval:         400 * time.Millisecond,
+			count:            3,
+			expectedInterval: minInterval,
+			expectedWait:     true,
+		},
+		{
+			name:             "empty outbox doubles the interval",
+			interval:         200 * time.Millisecond,
+			expectedInterval: 400 * time.Millisecond,
+			expectedWait:     true,
+		},
+		{
+			name:             "interval is not above the max",
+			interval:         800 * time.Millisecond,
+			expectedInterval: maxInterval,
+			expectedWait:     true,
+		},
+		{
+			name:             "error doubles the interval",
+			interval:         minInterval,
+			count:            10,
+			err:              errors.New("broker error"),
+			expectedInterval: 200 * time.Millisecond,
+			expectedWait:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interval, wait := d.nextPollInterval(tt.interval, tt.count, tt.err)
+			assert.Equal(t, tt.expectedInterval, interval)
+			assert.Equal(t, tt.expectedWait, wait)
+		})
+	}
+}
+
+func TestDispatcherWake(t *testing.T) {
+	db := newFakeDB()
+	publisher := &fakePublisher{}
+
+	d := NewDispatcher(NewStore(db), publisher, WithPollInterval(time.Hour, time.Hour))
+	d.Start(context.Background())
+	defer d.Close()
+
+	// the worker found the outbox empty and waits for the poll interval
+	assert.Eventually(t, func() bool { return db.beginCount() == 1 }, time.Second, 5*time.Millisecond)
+
+	db.add(testMessages()...)
+	d.Wake()
+
+	assert.Eventually(t, func() bool { return len(db.sentIDs()) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"10", "20"}, publisher.sentKeys())
+}
+
+func TestDispatcherWakeDoesNotBlock(t *testing.T) {
+	d := NewDispatcher(NewStore(newFakeDB()), &fakePublisher{}, WithWorkers(2))
+
+	d.Wake()
+	d.Wake()
+
+	assert.Len(t, d.wake, 2)
+}
+
+func TestDispatcherClean(t *testing.T) {
+	const (
+		retention = 24 * time.Hour
+		batchSize = 10
+	)
+
+	tests := []struct {
+		name          string
+		deleted       []int64
+		deleteErr     error
+		archive       bool
+		expectedCalls int
+	}{
+		{
+			name:          "nothing to delete",
+			deleted:       []int64{0},
+			expectedCalls: 1,
+		},
+		{
+			name:          "deletes until a batch is not full",
+			deleted:       []int64{10, 10, 3},
+			expectedCalls: 3,
+		},
+		{
+			name:          "last full batch is followed by an empty one",
+			deleted:       []int64{10, 0},
+			archive:       true,
+			expectedCalls: 2,
+		},
+		{
+			name:          "stops on error",
+			deleted:       []int64{10},
+			deleteErr:     errors.New("db error"),
+			expectedCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeDB()
+			db.deleted = tt.deleted
+			db.deleteErr = tt.deleteErr
+
+			d := NewDispatcher(NewStore(db), &fakePublisher{},
+				WithRetention(retention, time.Hour, batchSize, tt.archive),
+			)
+
+			d.clean(context.Background())
+
+			assert.Len(t, db.deleteCalls, tt.expectedCalls)
+			for _, call := range db.deleteCalls {
+				if tt.archive {
+					assert.Equal(t, db.queries.archiveSent, call.query)
+				} else {
+					assert.Equal(t, db.queries.deleteSent, call.query)
+				}
+				assert.Equal(t, float64(retention.Microseconds()), call.retentionMks)
+				assert.Equal(t, int32(batchSize), call.limit)
+			}
+		})
+	}
+}
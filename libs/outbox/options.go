@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type StoreOption interface {
+	Apply(*Store)
+}
+
+type storeOptionFn func(*Store)
+
+func (fn storeOptionFn) Apply(s *Store) {
+	fn(s)
+}
+
+// WithTable keeps messages in the table instead of DefaultTable, the archive is <table>_archive.
+func WithTable(table string) StoreOption {
+	return storeOptionFn(func(s *Store) {
+		s.table = table
+	})
+}
+
+// WithTxFromContext makes Add, NextID, DeleteSent and Reset run in the transaction
+// the service keeps in ctx.
+func WithTxFromContext(txFromContext func(ctx context.Context) (pgx.Tx, bool)) StoreOption {
+	return storeOptionFn(func(s *Store) {
+		s.txFromContext = txFromContext
+	})
+}
+
+type DispatcherOption interface {
+	Apply(*Dispatcher)
+}
+
+type dispatcherOptionFn func(*Dispatcher)
+
+func (fn dispatcherOptionFn) Apply(d *Dispatcher) {
+	fn(d)
+}
+
+// WithWorkers sends up to workers batches at once. Workers lock different messages,
+// so several service instances may dispatch one outbox. A message is fetched only when
+// the earlier messages of its topic and key are sent, so messages of one key are never
+// published by two workers at once or out of order.
+func WithWorkers(workers int) DispatcherOption {
+	return dispatcherOptionFn(func(d *Dispatcher) {
+		d.workers = max(workers, 1)
+	})
+}
+
+func WithBatchSize(size int32) DispatcherOption {
+	return dispatcherOptionFn(func(d *Dispatcher) {
+		d.batchSize = max(size, 1)
+	})
+}
+
+// WithPollInterval polls an outbox with messages every minInterval. While the outbox stays empty
+// the interval doubles up to maxInterval.
+func WithPollInterval(minInterval, maxInterval time.Duration) DispatcherOption {
+	return dispatcherOptionFn(func(d *Dispatcher) {
+		d.minPollInterval = minInterval
+		d.maxPollInterval = maxInterval
+	})
+}
+
+// WithRetention removes sent messages older than retention every interval, batchSize rows
+// at a time. With archive they are moved to the archive table.
+func WithRetention(retention, interval time.Duration, batchSize int32, archive bool) DispatcherOption {
+	return dispatcherOptionFn(func(d *Dispatcher) {
+		d.retention = retention
+		d.cleanupInterval = interval
+		d.cleanupBatchSize = max(batchSize, 1)
+		d.archive = archive
+	})
+}
+
+// WithBacklogObserver reports the backlog of the outbox every interval.
+func WithBacklogObserver(observer BacklogObserver, interval time.Duration) DispatcherOption {
+	return dispatcherOptionFn(func(d *Dispatcher) {
+		d.backlogObserver = observer
+		d.backlogInterval = interval
+	})
+}
@@ -0,0 +1,71 @@
+// Package outbox is a transactional outbox on postgres. Messages are written to one table
+// in the transaction that changes the data and a Dispatcher publishes them to kafka later.
+//
+// The table and its archive are described in schema.sql, a service creates them with its migrations.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const DefaultTable = "outbox_messages"
+
+var ErrInvalidResetFilter = errors.New("reset filter must select a key or a time range")
+
+// Message is a kafka message waiting in the outbox. Messages of one topic with the same key
+// are published in the order they were added, messages without a key in any order.
+type Message struct {
+	// ID is assigned by the outbox when it is zero, see Store.NextID.
+	ID      int64
+	Topic   string
+	Key     []byte
+	Payload []byte
+	// Headers keep the trace context of the code that added the message, see kafka.InjectTraceContext.
+	// The Dispatcher continues that trace when it publishes the message.
+	Headers   map[string]string
+	CreatedAt time.Time
+}
+
+// Querier runs statements, *pgxpool.Pool and pgx.Tx implement it.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// DB is the database of the outbox, *pgxpool.Pool implements it.
+type DB interface {
+	Querier
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Backlog describes messages that are not sent yet.
+type Backlog struct {
+	Size int64
+	// Age is how long the oldest unsent message waits, zero when there are none.
+	Age time.Duration
+}
+
+// ResetFilter selects sent messages to publish again. Zero fields are not applied,
+// the range is [From, To).
+type ResetFilter struct {
+	Topic string
+	Key   []byte
+	From  *time.Time
+	To    *time.Time
+}
+
+// Valid reports whether the filter selects a key or a non-empty time range,
+// so the whole outbox is not republished by mistake.
+func (f ResetFilter) Valid() bool {
+	if f.Key == nil && f.From == nil && f.To == nil {
+		return false
+	}
+
+	return f.From == nil || f.To == nil || f.From.Before(*f.To)
+}
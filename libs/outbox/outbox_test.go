@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResetFilterValid(t *testing.T) {
+	from := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		filter   ResetFilter
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			filter:   ResetFilter{},
+			expected: false,
+		},
+		{
+			name:     "topic only",
+			filter:   ResetFilter{Topic: "loms.order-events"},
+			expected: false,
+		},
+		{
+			name:     "key",
+			filter:   ResetFilter{Topic: "loms.order-events", Key: []byte("10")},
+			expected: true,
+		},
+		{
+			name:     "empty key",
+			filter:   ResetFilter{Key: []byte{}},
+			expected: true,
+		},
+		{
+			name:     "from only",
+			filter:   ResetFilter{From: &from},
+			expected: true,
+		},
+		{
+			name:     "to only",
+			filter:   ResetFilter{To: &to},
+			expected: true,
+		},
+		{
+			name:     "time range",
+			filter:   ResetFilter{From: &from, To: &to},
+			expected: true,
+		},
+		{
+			name:     "empty time range",
+			filter:   ResetFilter{From: &from, To: &from},
+			expected: false,
+		},
+		{
+			name:     "reversed time range",
+			filter:   ResetFilter{Key: []byte("10"), From: &to, To: &from},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Valid())
+		})
+	}
+}
+
+func TestStoreResetInvalidFilter(t *testing.T) {
+	store := NewStore(newFakeDB())
+
+	reset, err := store.Reset(context.Background(), ResetFilter{Topic: "loms.order-events"})
+	assert.ErrorIs(t, err, ErrInvalidResetFilter)
+	assert.Zero(t, reset)
+}
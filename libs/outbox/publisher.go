@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
+)
+
+// Publisher sends a message to a topic, producer.SyncProducer implements it.
+type Publisher interface {
+	SendMessage(topic string, key []byte, message []byte, headers map[string]string) (int32, int64, error)
+}
+
+// BatchPublisher sends messages at once and reports the result of each one,
+// producer.AsyncProducer implements it. A Publisher that is also a BatchPublisher
+// gets whole batches from the Dispatcher.
+type BatchPublisher interface {
+	SendBatch(ctx context.Context, msgs []producer.Message) ([]error, error)
+}
+
+type batchPublisher struct {
+	BatchPublisher
+}
+
+// Batched makes a Publisher of a BatchPublisher.
+func Batched(p BatchPublisher) Publisher {
+	return batchPublisher{BatchPublisher: p}
+}
+
+func (p batchPublisher) SendMessage(topic string, key []byte, message []byte, headers map[string]string) (int32, int64, error) {
+	errs, err := p.SendBatch(context.Background(), []producer.Message{{
+		Topic:   topic,
+		Key:     key,
+		Value:   message,
+		Headers: headers,
+	}})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return 0, 0, errs[0]
+}
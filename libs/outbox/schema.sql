@@ -0,0 +1,42 @@
+-- Tables of the outbox with the default name, a service copies them to its migrations.
+-- The trigger wakes dispatchers listening to the channel named after the table.
+
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    topic TEXT NOT NULL,
+    key BYTEA,
+    payload BYTEA NOT NULL,
+    headers JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS outbox_messages_unsent_idx
+    ON outbox_messages (topic, key, id)
+    WHERE sent = FALSE;
+
+CREATE INDEX IF NOT EXISTS outbox_messages_sent_created_at_idx
+    ON outbox_messages (created_at)
+    WHERE sent = TRUE;
+
+CREATE TABLE IF NOT EXISTS outbox_messages_archive (
+    id BIGINT PRIMARY KEY,
+    topic TEXT NOT NULL,
+    key BYTEA,
+    payload BYTEA NOT NULL,
+    headers JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    archived_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION notify_outbox_messages() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_messages', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_messages_notify
+    AFTER INSERT ON outbox_messages
+    FOR EACH STATEMENT
+EXECUTE FUNCTION notify_outbox_messages();
@@ -0,0 +1,247 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Store reads and writes messages of an outbox table.
+type Store struct {
+	db            DB
+	table         string
+	txFromContext func(ctx context.Context) (pgx.Tx, bool)
+	queries       queries
+}
+
+type queries struct {
+	insert       string
+	insertWithID string
+	nextID       string
+	fetch        string
+	markSent     string
+	backlog      string
+	deleteSent   string
+	archiveSent  string
+	resetSent    string
+}
+
+func NewStore(db DB, opts ...StoreOption) *Store {
+	s := &Store{
+		db:    db,
+		table: DefaultTable,
+	}
+
+	for _, opt := range opts {
+		opt.Apply(s)
+	}
+
+	s.queries = buildQueries(s.table)
+
+	return s
+}
+
+// Channel is the postgres notification channel the table trigger notifies on insert.
+func (s *Store) Channel() string {
+	return s.table
+}
+
+// Begin starts a transaction for Fetch and MarkSent.
+func (s *Store) Begin(ctx context.Context) (pgx.Tx, error) {
+	return s.db.Begin(ctx)
+}
+
+// Add writes the messages in the transaction of ctx, if there is one.
+func (s *Store) Add(ctx context.Context, msgs ...Message) error {
+	q := s.querier(ctx)
+
+	for _, msg := range msgs {
+		headers := msg.Headers
+		if headers == nil {
+			headers = map[string]string{}
+		}
+
+		var err error
+		if msg.ID != 0 {
+			_, err = q.Exec(ctx, s.queries.insertWithID, msg.ID, msg.Topic, msg.Key, msg.Payload, headers)
+		} else {
+			_, err = q.Exec(ctx, s.queries.insert, msg.Topic, msg.Key, msg.Payload, headers)
+		}
+		if err != nil {
+			return fmt.Errorf("add outbox message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NextID reserves an id for a message whose payload has to carry it.
+func (s *Store) NextID(ctx context.Context) (id int64, err error) {
+	err = s.querier(ctx).QueryRow(ctx, s.queries.nextID, s.table).Scan(&id)
+	return id, err
+}
+
+// Fetch locks up to limit unsent messages in tx. Messages locked by another transaction are
+// skipped, and so is a message while an earlier unsent message of its topic and key exists.
+func (s *Store) Fetch(ctx context.Context, tx Querier, limit int32) ([]Message, error) {
+	rows, err := tx.Query(ctx, s.queries.fetch, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var msgs []Message
+	for rows.Next() {
+		var msg Message
+		err = rows.Scan(&msg.ID, &msg.Topic, &msg.Key, &msg.Payload, &msg.Headers, &msg.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, rows.Err()
+}
+
+func (s *Store) MarkSent(ctx context.Context, tx Querier, ids []int64) error {
+	_, err := tx.Exec(ctx, s.queries.markSent, ids)
+	return err
+}
+
+func (s *Store) Backlog(ctx context.Context) (Backlog, error) {
+	var (
+		size       int64
+		ageSeconds float64
+	)
+
+	err := s.db.QueryRow(ctx, s.queries.backlog).Scan(&size, &ageSeconds)
+	if err != nil {
+		return Backlog{}, err
+	}
+
+	return Backlog{
+		Size: size,
+		Age:  time.Duration(ageSeconds * float64(time.Second)),
+	}, nil
+}
+
+// DeleteSent removes up to limit sent messages older than retention and returns how many
+// were removed. With archive the messages are moved to the <table>_archive table.
+func (s *Store) DeleteSent(ctx context.Context, retention time.Duration, limit int32, archive bool) (int64, error) {
+	query := s.queries.deleteSent
+	if archive {
+		query = s.queries.archiveSent
+	}
+
+	tag, err := s.querier(ctx).Exec(ctx, query, float64(retention.Microseconds()), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Reset marks sent messages matching the filter as unsent, so a dispatcher publishes them again
+// with the same ids. Messages already removed by DeleteSent can not be reset.
+func (s *Store) Reset(ctx context.Context, filter ResetFilter) (int64, error) {
+	if !filter.Valid() {
+		return 0, ErrInvalidResetFilter
+	}
+
+	tag, err := s.querier(ctx).Exec(ctx, s.queries.resetSent,
+		filter.Topic, filter.Key, toUTC(filter.From), toUTC(filter.To),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (s *Store) querier(ctx context.Context) Querier {
+	if s.txFromContext != nil {
+		if tx, found := s.txFromContext(ctx); found {
+			return tx
+		}
+	}
+
+	return s.db
+}
+
+// toUTC matches timestamps of the table, they are written by NOW() of a server in UTC.
+func toUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	utc := t.UTC()
+	return &utc
+}
+
+func buildQueries(table string) queries {
+	t := pgx.Identifier{table}.Sanitize()
+	archive := pgx.Identifier{table + "_archive"}.Sanitize()
+
+	sentBatch := fmt.Sprintf(`SELECT id
+  FROM %s
+  WHERE sent = TRUE
+    AND created_at < LOCALTIMESTAMP - $1::float8 * INTERVAL '1 microsecond'
+  ORDER BY id
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED`, t)
+
+	return queries{
+		insert: fmt.Sprintf(`INSERT INTO %s (topic, key, payload, headers)
+VALUES ($1, $2, $3, $4)`, t),
+		insertWithID: fmt.Sprintf(`INSERT INTO %s (id, topic, key, payload, headers)
+VALUES ($1, $2, $3, $4, $5)`, t),
+		nextID: `SELECT nextval(pg_get_serial_sequence(quote_ident($1), 'id'))`,
+		fetch: fmt.Sprintf(`SELECT e.id, e.topic, e.key, e.payload, e.headers, e.created_at
+FROM %[1]s e
+WHERE e.sent = FALSE
+  AND NOT EXISTS (
+    SELECT 1
+    FROM %[1]s p
+    WHERE p.topic = e.topic
+      AND p.key = e.key
+      AND p.sent = FALSE
+      AND p.id < e.id
+  )
+ORDER BY e.id ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED`, t),
+		markSent: fmt.Sprintf(`UPDATE %s
+SET sent = TRUE
+WHERE id = ANY($1::bigint[])`, t),
+		backlog: fmt.Sprintf(`SELECT COUNT(*),
+       COALESCE(EXTRACT(EPOCH FROM LOCALTIMESTAMP - MIN(created_at)), 0)::float8
+FROM %s
+WHERE sent = FALSE`, t),
+		deleteSent: fmt.Sprintf(`WITH batch AS (
+  %[2]s
+)
+DELETE FROM %[1]s e
+USING batch
+WHERE e.id = batch.id`, t, sentBatch),
+		archiveSent: fmt.Sprintf(`WITH batch AS (
+  %[3]s
+), deleted AS (
+  DELETE FROM %[1]s e
+  USING batch
+  WHERE e.id = batch.id
+  RETURNING e.id, e.topic, e.key, e.payload, e.headers, e.created_at
+)
+INSERT INTO %[2]s (id, topic, key, payload, headers, created_at)
+SELECT id, topic, key, payload, headers, created_at
+FROM deleted`, t, archive, sentBatch),
+		resetSent: fmt.Sprintf(`UPDATE %s
+SET sent = FALSE
+WHERE sent = TRUE
+  AND ($1::text = '' OR topic = $1::text)
+  AND ($2::bytea IS NULL OR key = $2::bytea)
+  AND ($3::timestamp IS NULL OR created_at >= $3::timestamp)
+  AND ($4::timestamp IS NULL OR created_at < $4::timestamp)`, t),
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/BruteMors/marketplace-service/libs/logger"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	outboxRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	stockRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock"
+	stockService "github.com/BruteMors/marketplace-service/loms/internal/service/stock"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+)
+
+// reconcile reports SKUs whose items.reserved differs from the sum of their active reservations
+// and exits with a non-zero code when any drift is found.
+func main() {
+	envPath := flag.String("env", ".env", "path to the env file")
+	flag.Parse()
+
+	handler := logger.NewCustomTextHandler(os.Stderr, "loms-reconcile", nil)
+
+	slog.SetDefault(slog.New(handler))
+
+	driftCount, err := run(context.Background(), *envPath)
+	if err != nil {
+		log.Fatalf("failed to reconcile reservations: %s", err.Error())
+	}
+
+	if driftCount > 0 {
+		slog.Warn("reservation drift found", "sku_count", driftCount)
+		os.Exit(1)
+	}
+
+	slog.Info("items.reserved matches the reservations ledger")
+}
+
+func run(ctx context.Context, envPath string) (int, error) {
+	err := config.Load(envPath)
+	if err != nil {
+		return 0, err
+	}
+
+	pgConfig, err := config.NewPGConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	dbClient, err := pg.New(ctx, pgConfig.MasterDSN(), pgConfig.ReplicaDSNs())
+	if err != nil {
+		return 0, err
+	}
+	defer dbClient.Close()
+
+	stockSrv := stockService.NewService(
+		stockRepository.NewRepository(dbClient),
+		transaction.NewTransactionManager(dbClient),
+		outboxRepository.NewRepository(dbClient),
+	)
+
+	drifts, err := stockSrv.ReservationDrift(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(drifts) == 0 {
+		return 0, nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SKU\tRESERVED\tLEDGER\tDIFF")
+	for _, d := range drifts {
+		fmt.Fprintf(w, "%d\t%d\t%d\t%+d\n", d.SKU, d.Reserved, d.LedgerReserved, d.Reserved-d.LedgerReserved)
+	}
+
+	return len(drifts), w.Flush()
+}
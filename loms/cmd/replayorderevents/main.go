@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/logger"
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	outboxRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+)
+
+// replayorderevents marks sent order status events as unsent, so running loms instances
+// publish them to kafka again. Events are selected by an order id and/or a time range.
+func main() {
+	envPath := flag.String("env", ".env", "path to the env file")
+	orderID := flag.Int64("order-id", 0, "replay events of the order")
+	from := flag.String("from", "", "replay events at or after the time, RFC3339")
+	to := flag.String("to", "", "replay events before the time, RFC3339")
+	flag.Parse()
+
+	handler := logger.NewCustomTextHandler(os.Stderr, "loms-replay-order-events", nil)
+
+	slog.SetDefault(slog.New(handler))
+
+	filter, err := parseFilter(*orderID, *from, *to)
+	if err != nil {
+		log.Fatalf("failed to parse flags: %s", err.Error())
+	}
+
+	reset, err := run(context.Background(), *envPath, filter)
+	if err != nil {
+		log.Fatalf("failed to replay order status events: %s", err.Error())
+	}
+
+	slog.Info("order status events are queued for publishing", "count", reset)
+}
+
+func parseFilter(orderID int64, from, to string) (liboutbox.ResetFilter, error) {
+	var filter liboutbox.ResetFilter
+
+	if orderID != 0 {
+		filter.Key = []byte(strconv.FormatInt(orderID, 10))
+	}
+
+	if from != "" {
+		parsed, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return liboutbox.ResetFilter{}, err
+		}
+		filter.From = &parsed
+	}
+
+	if to != "" {
+		parsed, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return liboutbox.ResetFilter{}, err
+		}
+		filter.To = &parsed
+	}
+
+	if !filter.Valid() {
+		return liboutbox.ResetFilter{}, liboutbox.ErrInvalidResetFilter
+	}
+
+	return filter, nil
+}
+
+func run(ctx context.Context, envPath string, filter liboutbox.ResetFilter) (int64, error) {
+	err := config.Load(envPath)
+	if err != nil {
+		return 0, err
+	}
+
+	pgConfig, err := config.NewPGConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	dbClient, err := pg.New(ctx, pgConfig.MasterDSN(), pgConfig.ReplicaDSNs())
+	if err != nil {
+		return 0, err
+	}
+	defer dbClient.Close()
+
+	filter.Topic = config.GetSendStatusChangedEventTopic()
+
+	return outboxRepository.NewStore(dbClient).Reset(ctx, filter)
+}
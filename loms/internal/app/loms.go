@@ -87,6 +87,7 @@ func (l *LomsApp) initDeps(ctx context.Context) error {
 		l.initServiceProvider,
 		l.initMetrics,
 		l.initTracing,
+		l.initOutboxDispatcher,
 		l.initGRPCServer,
 		l.initGRPCGatewayServer,
 	}
@@ -128,6 +129,11 @@ func (l *LomsApp) initTracing(ctx context.Context) error {
 	return nil
 }
 
+func (l *LomsApp) initOutboxDispatcher(ctx context.Context) error {
+	l.serviceProvider.OutboxDispatcher(ctx)
+	return nil
+}
+
 func (l *LomsApp) initGRPCServer(ctx context.Context) error {
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -146,6 +152,7 @@ func (l *LomsApp) initGRPCServer(ctx context.Context) error {
 
 	loms.RegisterOrdersServer(grpcServer, l.serviceProvider.OrderGRPCApi(ctx))
 	loms.RegisterStockServer(grpcServer, l.serviceProvider.StockGRPCApi(ctx))
+	loms.RegisterStockAdminServer(grpcServer, l.serviceProvider.StockAdminGRPCApi(ctx))
 
 	return nil
 }
@@ -170,19 +177,24 @@ func (l *LomsApp) runGRPCServer() error {
 func (l *LomsApp) initGRPCGatewayServer(ctx context.Context) error {
 	conn, err := grpc.NewClient(":50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		slog.Error("Failed to dial:", err)
+		slog.Error("Failed to dial", "error", err)
 		return err
 	}
 
 	gwmux := runtime.NewServeMux()
 
 	if err = loms.RegisterOrdersHandler(ctx, gwmux, conn); err != nil {
-		slog.Error("Failed to register orders gateway:", err)
+		slog.Error("Failed to register orders gateway", "error", err)
 		return err
 	}
 
 	if err = loms.RegisterStockHandler(ctx, gwmux, conn); err != nil {
-		slog.Error("Failed to register stock gateway:", err)
+		slog.Error("Failed to register stock gateway", "error", err)
+		return err
+	}
+
+	if err = loms.RegisterStockAdminHandler(ctx, gwmux, conn); err != nil {
+		slog.Error("Failed to register stock admin gateway", "error", err)
 		return err
 	}
 
@@ -3,12 +3,16 @@ package app
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/BruteMors/marketplace-service/libs/kafka"
 	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
 	"github.com/BruteMors/marketplace-service/loms/internal/config"
 	"github.com/BruteMors/marketplace-service/loms/internal/controller/grpcapi/handlers/order"
 	"github.com/BruteMors/marketplace-service/loms/internal/controller/grpcapi/handlers/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/controller/grpcapi/handlers/stockadmin"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
 	inMemoryorderRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/inmemory/order"
 	inMemorystockRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/inmemory/stock"
 	orderRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order"
@@ -16,20 +20,27 @@ import (
 	stockRepository "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock"
 	orderService "github.com/BruteMors/marketplace-service/loms/internal/service/order"
 	stockService "github.com/BruteMors/marketplace-service/loms/internal/service/stock"
+	stockAdminService "github.com/BruteMors/marketplace-service/loms/internal/service/stockadmin"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 	"github.com/BruteMors/marketplace-service/loms/pkg/closer"
 )
 
+const outboxBacklogReportInterval = 10 * time.Second
+
 type serviceProvider struct {
 	grpcConfig              *config.GRPCServerConfig
 	httpConfig              *config.HTTPServerConfig
 	kafkaConfig             *config.KafkaConfig
-	mqSyncProducer          *producer.SyncProducer
+	orderConfig             *config.OrderConfig
+	outboxConfig            *config.OutboxConfig
+	mqAsyncProducer         *producer.AsyncProducer
 	stockGrpcApi            *stock.GRPCApi
 	stockService            *stockService.Service
 	inMemoryStockRepository *inMemorystockRepository.Repository
 	stockRepository         *stockRepository.Repository
+	stockAdminGrpcApi       *stockadmin.GRPCApi
+	stockAdminService       *stockAdminService.Service
 	orderGrpcApi            *order.GRPCApi
 	orderService            *orderService.Service
 	inMemoryOrderRepository *inMemoryorderRepository.Repository
@@ -38,6 +49,7 @@ type serviceProvider struct {
 	dbClient                *pg.Client
 	txManager               transaction.TxManager
 	outboxRepository        *outbox.Repository
+	outboxDispatcher        *liboutbox.Dispatcher
 }
 
 func newServiceProvider() *serviceProvider {
@@ -83,19 +95,49 @@ func (s *serviceProvider) KafkaConfig() *config.KafkaConfig {
 	return s.kafkaConfig
 }
 
-func (s *serviceProvider) KafkaSyncProducer(_ context.Context) *producer.SyncProducer {
-	if s.mqSyncProducer == nil {
-		syncProducer, err := producer.NewSyncProducer(kafka.Config{Brokers: s.KafkaConfig().Brokers()}, nil)
+func (s *serviceProvider) OrderConfig() *config.OrderConfig {
+	if s.orderConfig == nil {
+		cfg, err := config.NewOrderConfig()
 		if err != nil {
-			log.Fatalf("failed to create kafka producer: %s", err.Error())
+			log.Fatalf("failed to get order config: %s", err.Error())
 		}
 
-		s.mqSyncProducer = syncProducer
+		s.orderConfig = cfg
 	}
 
-	closer.Add(s.mqSyncProducer.Close)
+	return s.orderConfig
+}
+
+func (s *serviceProvider) OutboxConfig() *config.OutboxConfig {
+	if s.outboxConfig == nil {
+		cfg, err := config.NewOutboxConfig()
+		if err != nil {
+			log.Fatalf("failed to get outbox config: %s", err.Error())
+		}
+
+		s.outboxConfig = cfg
+	}
+
+	return s.outboxConfig
+}
+
+func (s *serviceProvider) KafkaAsyncProducer(_ context.Context) *producer.AsyncProducer {
+	if s.mqAsyncProducer == nil {
+		asyncProducer, err := producer.NewAsyncProducer(
+			kafka.Config{Brokers: s.KafkaConfig().Brokers()},
+			nil,
+			metric.ProducerMetrics(),
+		)
+		if err != nil {
+			log.Fatalf("failed to create kafka async producer: %s", err.Error())
+		}
+
+		s.mqAsyncProducer = asyncProducer
 
-	return s.mqSyncProducer
+		closer.Add(s.mqAsyncProducer.Close)
+	}
+
+	return s.mqAsyncProducer
 }
 
 func (s *serviceProvider) OrderRepository(ctx context.Context) *orderRepository.Repository {
@@ -127,8 +169,9 @@ func (s *serviceProvider) OrderService(ctx context.Context) *orderService.Servic
 			s.OrderRepository(ctx),
 			s.StockService(ctx),
 			s.TxManager(ctx),
-			s.KafkaSyncProducer(ctx),
 			s.OutboxRepository(ctx),
+			s.OrderConfig().PaymentTimeout(),
+			s.OrderConfig().ExpiredCheckInterval(),
 		)
 
 		s.orderService = orderSrv
@@ -179,6 +222,7 @@ func (s *serviceProvider) StockService(ctx context.Context) *stockService.Servic
 		stockSvc := stockService.NewService(
 			s.StockRepository(ctx),
 			s.TxManager(ctx),
+			s.OutboxRepository(ctx),
 		)
 
 		s.stockService = stockSvc
@@ -197,6 +241,28 @@ func (s *serviceProvider) StockGRPCApi(ctx context.Context) *stock.GRPCApi {
 	return s.stockGrpcApi
 }
 
+func (s *serviceProvider) StockAdminService(ctx context.Context) *stockAdminService.Service {
+	if s.stockAdminService == nil {
+		s.stockAdminService = stockAdminService.NewService(
+			s.StockRepository(ctx),
+			s.TxManager(ctx),
+			s.OutboxRepository(ctx),
+		)
+	}
+
+	return s.stockAdminService
+}
+
+func (s *serviceProvider) StockAdminGRPCApi(ctx context.Context) *stockadmin.GRPCApi {
+	if s.stockAdminGrpcApi == nil {
+		s.stockAdminGrpcApi = stockadmin.NewStockAdminGRPCApi(
+			s.StockAdminService(ctx),
+		)
+	}
+
+	return s.stockAdminGrpcApi
+}
+
 func (s *serviceProvider) OutboxRepository(ctx context.Context) *outbox.Repository {
 	if s.outboxRepository == nil {
 		outboxRepo := outbox.NewRepository(s.DBClient(ctx))
@@ -206,6 +272,44 @@ func (s *serviceProvider) OutboxRepository(ctx context.Context) *outbox.Reposito
 	return s.outboxRepository
 }
 
+// OutboxDispatcher publishes outbox_messages to kafka until the app is closed.
+func (s *serviceProvider) OutboxDispatcher(ctx context.Context) *liboutbox.Dispatcher {
+	if s.outboxDispatcher == nil {
+		cfg := s.OutboxConfig()
+		store := outbox.NewStore(s.DBClient(ctx))
+
+		dispatcher := liboutbox.NewDispatcher(
+			store,
+			liboutbox.Batched(s.KafkaAsyncProducer(ctx)),
+			liboutbox.WithWorkers(cfg.Workers()),
+			liboutbox.WithBatchSize(cfg.BatchSize()),
+			liboutbox.WithPollInterval(cfg.MinPollInterval(), cfg.MaxPollInterval()),
+			liboutbox.WithRetention(cfg.Retention(), cfg.CleanupInterval(), cfg.CleanupBatchSize(), cfg.Archive()),
+			liboutbox.WithBacklogObserver(func(backlog liboutbox.Backlog) {
+				metric.SetOutboxBacklog(liboutbox.DefaultTable, backlog.Size, backlog.Age)
+			}, outboxBacklogReportInterval),
+		)
+
+		ctx, cancel := context.WithCancel(ctx)
+		dispatcher.Start(ctx)
+
+		if cfg.Listen() {
+			go s.DBClient(ctx).Listen(ctx, store.Channel(), dispatcher.Wake)
+		}
+
+		s.outboxDispatcher = dispatcher
+
+		// added after the producer and the db client, so it is closed before them
+		closer.Add(func() error {
+			dispatcher.Close()
+			cancel()
+			return nil
+		})
+	}
+
+	return s.outboxDispatcher
+}
+
 func (s *serviceProvider) PGConfig() config.PGConfig {
 	if s.pgConfig == nil {
 		cfg, err := config.NewPGConfig()
@@ -6,8 +6,10 @@ import (
 )
 
 const (
-	kafkaBrokersEnvName     = "KAFKA_BROKERS"
-	orderEventsTopicEnvName = "ORDER_EVENTS_TOPIC"
+	kafkaBrokersEnvName          = "KAFKA_BROKERS"
+	orderEventsTopicEnvName      = "ORDER_EVENTS_TOPIC"
+	stockEventsTopicEnvName      = "STOCK_EVENTS_TOPIC"
+	stockLevelEventsTopicEnvName = "STOCK_LEVEL_EVENTS_TOPIC"
 )
 
 type KafkaConfig struct {
@@ -32,3 +34,11 @@ func (c *KafkaConfig) Brokers() []string {
 func GetSendStatusChangedEventTopic() string {
 	return os.Getenv(orderEventsTopicEnvName)
 }
+
+func GetSendStockChangedEventTopic() string {
+	return os.Getenv(stockEventsTopicEnvName)
+}
+
+func GetSendStockLevelChangedEventTopic() string {
+	return os.Getenv(stockLevelEventsTopicEnvName)
+}
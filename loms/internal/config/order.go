@@ -0,0 +1,42 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	orderPaymentTimeoutEnvName       = "ORDER_PAYMENT_TIMEOUT"
+	orderExpiredCheckIntervalEnvName = "ORDER_EXPIRED_CHECK_INTERVAL"
+)
+
+type OrderConfig struct {
+	paymentTimeout       time.Duration
+	expiredCheckInterval time.Duration
+}
+
+func NewOrderConfig() (*OrderConfig, error) {
+	paymentTimeout, err := time.ParseDuration(os.Getenv(orderPaymentTimeoutEnvName))
+	if err != nil || paymentTimeout <= 0 {
+		return nil, errors.New("order payment timeout not found")
+	}
+
+	expiredCheckInterval, err := time.ParseDuration(os.Getenv(orderExpiredCheckIntervalEnvName))
+	if err != nil || expiredCheckInterval <= 0 {
+		return nil, errors.New("order expired check interval not found")
+	}
+
+	return &OrderConfig{
+		paymentTimeout:       paymentTimeout,
+		expiredCheckInterval: expiredCheckInterval,
+	}, nil
+}
+
+func (c *OrderConfig) PaymentTimeout() time.Duration {
+	return c.paymentTimeout
+}
+
+func (c *OrderConfig) ExpiredCheckInterval() time.Duration {
+	return c.expiredCheckInterval
+}
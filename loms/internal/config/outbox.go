@@ -0,0 +1,177 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	orderOutboxWorkersEnvName         = "ORDER_OUTBOX_WORKERS"
+	orderOutboxBatchSizeEnvName       = "ORDER_OUTBOX_BATCH_SIZE"
+	orderOutboxMinPollIntervalEnvName = "ORDER_OUTBOX_MIN_POLL_INTERVAL"
+	orderOutboxMaxPollIntervalEnvName = "ORDER_OUTBOX_MAX_POLL_INTERVAL"
+	orderOutboxListenEnvName          = "ORDER_OUTBOX_LISTEN"
+	orderOutboxRetentionEnvName       = "ORDER_OUTBOX_RETENTION"
+	orderOutboxCleanupIntervalEnvName = "ORDER_OUTBOX_CLEANUP_INTERVAL"
+	orderOutboxCleanupBatchEnvName    = "ORDER_OUTBOX_CLEANUP_BATCH_SIZE"
+	orderOutboxArchiveEnvName         = "ORDER_OUTBOX_ARCHIVE"
+
+	defaultOrderOutboxWorkers         = 1
+	defaultOrderOutboxBatchSize       = 100
+	defaultOrderOutboxMinPollInterval = 100 * time.Millisecond
+	defaultOrderOutboxMaxPollInterval = 5 * time.Second
+	defaultOrderOutboxRetention       = 7 * 24 * time.Hour
+	defaultOrderOutboxCleanupInterval = time.Hour
+	defaultOrderOutboxCleanupBatch    = 1000
+)
+
+type OutboxConfig struct {
+	workers          int
+	batchSize        int32
+	minPollInterval  time.Duration
+	maxPollInterval  time.Duration
+	listen           bool
+	retention        time.Duration
+	cleanupInterval  time.Duration
+	cleanupBatchSize int32
+	archive          bool
+}
+
+func NewOutboxConfig() (*OutboxConfig, error) {
+	workers := defaultOrderOutboxWorkers
+	if raw := os.Getenv(orderOutboxWorkersEnvName); len(raw) != 0 {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("order outbox workers is invalid")
+		}
+		workers = parsed
+	}
+
+	batchSize := int32(defaultOrderOutboxBatchSize)
+	if raw := os.Getenv(orderOutboxBatchSizeEnvName); len(raw) != 0 {
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("order outbox batch size is invalid")
+		}
+		batchSize = int32(parsed)
+	}
+
+	minPollInterval := defaultOrderOutboxMinPollInterval
+	if raw := os.Getenv(orderOutboxMinPollIntervalEnvName); len(raw) != 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("order outbox min poll interval is invalid")
+		}
+		minPollInterval = parsed
+	}
+
+	maxPollInterval := defaultOrderOutboxMaxPollInterval
+	if raw := os.Getenv(orderOutboxMaxPollIntervalEnvName); len(raw) != 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < minPollInterval {
+			return nil, errors.New("order outbox max poll interval is invalid")
+		}
+		maxPollInterval = parsed
+	}
+
+	listen := false
+	if raw := os.Getenv(orderOutboxListenEnvName); len(raw) != 0 {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.New("order outbox listen is invalid")
+		}
+		listen = parsed
+	}
+
+	retention := defaultOrderOutboxRetention
+	if raw := os.Getenv(orderOutboxRetentionEnvName); len(raw) != 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed < 0 {
+			return nil, errors.New("order outbox retention is invalid")
+		}
+		retention = parsed
+	}
+
+	cleanupInterval := defaultOrderOutboxCleanupInterval
+	if raw := os.Getenv(orderOutboxCleanupIntervalEnvName); len(raw) != 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("order outbox cleanup interval is invalid")
+		}
+		cleanupInterval = parsed
+	}
+
+	cleanupBatchSize := int32(defaultOrderOutboxCleanupBatch)
+	if raw := os.Getenv(orderOutboxCleanupBatchEnvName); len(raw) != 0 {
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("order outbox cleanup batch size is invalid")
+		}
+		cleanupBatchSize = int32(parsed)
+	}
+
+	archive := false
+	if raw := os.Getenv(orderOutboxArchiveEnvName); len(raw) != 0 {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.New("order outbox archive is invalid")
+		}
+		archive = parsed
+	}
+
+	return &OutboxConfig{
+		workers:          workers,
+		batchSize:        batchSize,
+		minPollInterval:  minPollInterval,
+		maxPollInterval:  maxPollInterval,
+		listen:           listen,
+		retention:        retention,
+		cleanupInterval:  cleanupInterval,
+		cleanupBatchSize: cleanupBatchSize,
+		archive:          archive,
+	}, nil
+}
+
+// Workers is how many batches of outbox messages are sent at once.
+func (c *OutboxConfig) Workers() int {
+	return c.workers
+}
+
+func (c *OutboxConfig) BatchSize() int32 {
+	return c.batchSize
+}
+
+// MinPollInterval is how often an outbox with events is polled. An empty outbox
+// is polled less often, the interval doubles up to MaxPollInterval.
+func (c *OutboxConfig) MinPollInterval() time.Duration {
+	return c.minPollInterval
+}
+
+func (c *OutboxConfig) MaxPollInterval() time.Duration {
+	return c.maxPollInterval
+}
+
+// Listen wakes the dispatcher by postgres notifications about new messages.
+func (c *OutboxConfig) Listen() bool {
+	return c.listen
+}
+
+// Retention is how long sent messages stay in the outbox, zero keeps them forever.
+func (c *OutboxConfig) Retention() time.Duration {
+	return c.retention
+}
+
+func (c *OutboxConfig) CleanupInterval() time.Duration {
+	return c.cleanupInterval
+}
+
+func (c *OutboxConfig) CleanupBatchSize() int32 {
+	return c.cleanupBatchSize
+}
+
+// Archive moves old messages to the archive table instead of deleting them.
+func (c *OutboxConfig) Archive() bool {
+	return c.archive
+}
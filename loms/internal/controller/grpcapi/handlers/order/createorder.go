@@ -59,7 +59,8 @@ func repackOrderCreateRequest(in *grpcmodels.OrderCreateRequest) *requests.Order
 	}
 
 	return &requests.OrderCreate{
-		User:  in.User,
-		Items: items,
+		User:           in.User,
+		Items:          items,
+		IdempotencyKey: in.IdempotencyKey,
 	}
 }
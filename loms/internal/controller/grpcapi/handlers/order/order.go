@@ -13,6 +13,7 @@ type Service interface {
 	OrderInfo(ctx context.Context, orderID int64) (responses.OrderInfo, error)
 	OrderPay(ctx context.Context, orderID int64) error
 	OrderCancel(ctx context.Context, orderID int64) error
+	OrdersList(ctx context.Context, req *requests.OrdersList) (responses.OrdersList, error)
 }
 
 type GRPCApi struct {
@@ -2,14 +2,18 @@ package order
 
 import (
 	"context"
+	"errors"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
 	grpcmodels "github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -37,6 +41,12 @@ func (g *GRPCApi) OrderCancel(
 
 	err = g.orderService.OrderCancel(ctx, in.OrderId)
 	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrOrderNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrInvalidOrderStatusTransition):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
 
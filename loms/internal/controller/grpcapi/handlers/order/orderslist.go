@@ -0,0 +1,132 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/controller/grpcapi/utils"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/requests"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/responses"
+	grpcmodels "github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (g *GRPCApi) OrdersList(
+	ctx context.Context,
+	in *grpcmodels.OrdersListRequest,
+) (resp *grpcmodels.OrdersListResponse, err error) {
+	tracer := otel.Tracer("GRPCApi")
+	propagator := otel.GetTextMapPropagator()
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	ctx = propagator.Extract(ctx, propagation.HeaderCarrier(md))
+
+	var span trace.Span
+	ctx, span = tracer.Start(ctx, "OrdersList")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", in.User))
+
+	req, err := repackOrdersListRequest(in)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := g.orderService.OrdersList(ctx, req)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return repackOrdersListResponse(&list)
+}
+
+func repackOrdersListRequest(in *grpcmodels.OrdersListRequest) (*requests.OrdersList, error) {
+	statuses := make([]ordermodels.Status, 0, len(in.Statuses))
+	for _, s := range in.Statuses {
+		status, err := utils.GRPCOrderStatusToString(s)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	req := &requests.OrdersList{
+		User:     in.User,
+		Statuses: statuses,
+		Cursor:   in.Cursor,
+		Limit:    in.Limit,
+	}
+
+	if in.CreatedFrom != nil {
+		createdFrom := in.CreatedFrom.AsTime()
+		req.CreatedFrom = &createdFrom
+	}
+
+	if in.CreatedTo != nil {
+		createdTo := in.CreatedTo.AsTime()
+		req.CreatedTo = &createdTo
+	}
+
+	if req.CreatedFrom != nil && req.CreatedTo != nil && !req.CreatedFrom.Before(*req.CreatedTo) {
+		return nil, errors.New("created_from must be before created_to")
+	}
+
+	return req, nil
+}
+
+func repackOrdersListResponse(list *responses.OrdersList) (*grpcmodels.OrdersListResponse, error) {
+	orders := make([]*grpcmodels.Order, 0, len(list.Orders))
+
+	for _, o := range list.Orders {
+		items := make([]*grpcmodels.OrderItem, 0, len(o.Items))
+		for _, i := range o.Items {
+			items = append(items, &grpcmodels.OrderItem{
+				Sku:   i.SKU,
+				Count: uint32(i.Count),
+			})
+		}
+
+		status, err := utils.StringToGRPCOrderStatus(o.Status)
+		if err != nil {
+			return nil, err
+		}
+
+		order := &grpcmodels.Order{
+			OrderId:   o.ID,
+			Status:    status,
+			User:      o.User,
+			Items:     items,
+			CreatedAt: timestamppb.New(o.CreatedAt),
+		}
+
+		if o.UpdatedAt != nil {
+			order.UpdatedAt = timestamppb.New(*o.UpdatedAt)
+		}
+
+		orders = append(orders, order)
+	}
+
+	return &grpcmodels.OrdersListResponse{
+		Orders:     orders,
+		NextCursor: list.NextCursor,
+	}, nil
+}
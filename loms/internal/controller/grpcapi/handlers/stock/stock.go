@@ -8,6 +8,7 @@ import (
 
 type Service interface {
 	StocksInfo(ctx context.Context, sku uint32) (count uint64, err error)
+	StocksInfoBatch(ctx context.Context, skus []uint32) (counts map[uint32]uint64, err error)
 }
 
 type GRPCApi struct {
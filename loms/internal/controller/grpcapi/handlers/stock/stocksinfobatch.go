@@ -0,0 +1,46 @@
+package stock
+
+import (
+	"context"
+	"sort"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	grpcmodels "github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (g *GRPCApi) StocksInfoBatch(
+	ctx context.Context,
+	in *grpcmodels.StocksInfoBatchRequest,
+) (resp *grpcmodels.StocksInfoBatchResponse, err error) {
+	tracer := otel.Tracer("GRPCApi")
+	var span trace.Span
+	ctx, span = tracer.Start(ctx, "StocksInfoBatch")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sku_count", len(in.Skus)))
+
+	counts, err := g.stockService.StocksInfoBatch(ctx, in.Skus)
+	if err != nil {
+		return nil, err
+	}
+
+	stocks := make([]*grpcmodels.StockInfo, 0, len(counts))
+	for sku, count := range counts {
+		stocks = append(stocks, &grpcmodels.StockInfo{
+			Sku:   sku,
+			Count: count,
+		})
+	}
+
+	sort.Slice(stocks, func(i, j int) bool {
+		return stocks[i].Sku < stocks[j].Sku
+	})
+
+	return &grpcmodels.StocksInfoBatchResponse{Stocks: stocks}, nil
+}
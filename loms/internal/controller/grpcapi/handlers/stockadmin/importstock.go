@@ -0,0 +1,64 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	grpcmodels "github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (g *GRPCApi) ImportStock(
+	ctx context.Context,
+	in *grpcmodels.ImportStockRequest,
+) (resp *grpcmodels.ImportStockResponse, err error) {
+	tracer := otel.Tracer("GRPCApi")
+	var span trace.Span
+	ctx, span = tracer.Start(ctx, "ImportStock")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("format", in.Format.String()),
+		attribute.Int("size", len(in.Data)),
+	)
+
+	format, err := repackImportFormat(in.Format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	imported, err := g.stockAdminService.ImportStock(ctx, format, in.Data, in.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidStockImport), errors.Is(err, models.ErrInvalidStockTotal):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, models.ErrTotalBelowReserved):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &grpcmodels.ImportStockResponse{Imported: imported}, nil
+}
+
+func repackImportFormat(format grpcmodels.StockImportFormat) (stockmodels.ImportFormat, error) {
+	switch format {
+	case grpcmodels.StockImportFormat_CSV:
+		return stockmodels.ImportFormatCSV, nil
+	case grpcmodels.StockImportFormat_JSON:
+		return stockmodels.ImportFormatJSON, nil
+	default:
+		return "", fmt.Errorf("unknown import format %v", format)
+	}
+}
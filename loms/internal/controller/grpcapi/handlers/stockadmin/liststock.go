@@ -0,0 +1,45 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	grpcmodels "github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (g *GRPCApi) ListStock(
+	ctx context.Context,
+	in *grpcmodels.ListStockRequest,
+) (resp *grpcmodels.ListStockResponse, err error) {
+	tracer := otel.Tracer("GRPCApi")
+	var span trace.Span
+	ctx, span = tracer.Start(ctx, "ListStock")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	items, nextCursor, err := g.stockAdminService.ListStock(ctx, in.Cursor, in.Limit)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	stockItems := make([]*grpcmodels.StockItem, 0, len(items))
+	for _, item := range items {
+		stockItems = append(stockItems, repackStockItem(item))
+	}
+
+	return &grpcmodels.ListStockResponse{
+		Items:      stockItems,
+		NextCursor: nextCursor,
+	}, nil
+}
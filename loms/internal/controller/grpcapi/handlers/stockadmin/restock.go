@@ -0,0 +1,48 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	grpcmodels "github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (g *GRPCApi) Restock(
+	ctx context.Context,
+	in *grpcmodels.RestockRequest,
+) (resp *grpcmodels.StockItem, err error) {
+	tracer := otel.Tracer("GRPCApi")
+	var span trace.Span
+	ctx, span = tracer.Start(ctx, "Restock")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("sku", int64(in.Sku)),
+		attribute.Int64("delta", in.Delta),
+	)
+
+	item, err := g.stockAdminService.Restock(ctx, in.Sku, in.Delta, in.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrSKUNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrTotalBelowReserved):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, models.ErrInvalidStockTotal):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return repackStockItem(item), nil
+}
@@ -0,0 +1,36 @@
+package stockadmin
+
+import (
+	"context"
+
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/pkg/api/grpc/loms/v1"
+)
+
+type Service interface {
+	Restock(ctx context.Context, sku uint32, delta int64, reason string) (stockmodels.Item, error)
+	SetTotal(ctx context.Context, sku uint32, total uint64, reason string) (stockmodels.Item, error)
+	ListStock(ctx context.Context, cursor string, limit uint32) (items []stockmodels.Item, nextCursor string, err error)
+	ImportStock(ctx context.Context, format stockmodels.ImportFormat, data []byte, reason string) (imported uint32, err error)
+}
+
+type GRPCApi struct {
+	loms.UnimplementedStockAdminServer
+	stockAdminService Service
+}
+
+func NewStockAdminGRPCApi(
+	stockAdminService Service,
+) *GRPCApi {
+	return &GRPCApi{
+		stockAdminService: stockAdminService,
+	}
+}
+
+func repackStockItem(item stockmodels.Item) *loms.StockItem {
+	return &loms.StockItem{
+		Sku:        item.SKU,
+		TotalCount: item.TotalCount,
+		Reserved:   item.Reserved,
+	}
+}
@@ -23,3 +23,20 @@ func StringToGRPCOrderStatus(status order.Status) (grpcmodels.OrderStatus, error
 		return grpcmodels.OrderStatus(0), errors.New("unknown status")
 	}
 }
+
+func GRPCOrderStatusToString(status grpcmodels.OrderStatus) (order.Status, error) {
+	switch status {
+	case grpcmodels.OrderStatus_NEW:
+		return order.OrderStatusNew, nil
+	case grpcmodels.OrderStatus_AWAITING_PAYMENT:
+		return order.OrderStatusAwaitingPayment, nil
+	case grpcmodels.OrderStatus_FAILED:
+		return order.OrderStatusFailed, nil
+	case grpcmodels.OrderStatus_PAYED:
+		return order.OrderStatusPayed, nil
+	case grpcmodels.OrderStatus_CANCELLED:
+		return order.OrderStatusCancelled, nil
+	default:
+		return "", errors.New("unknown status")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"os"
+	"time"
 
+	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -23,6 +25,9 @@ type Metrics struct {
 	dbRequestCounter              *prometheus.CounterVec
 	dbHistogramResponseTime       *prometheus.HistogramVec
 	inMemoryObjectCount           prometheus.Gauge
+	outboxBacklogSize             *prometheus.GaugeVec
+	outboxBacklogAge              *prometheus.GaugeVec
+	producerMetrics               *producer.AsyncProducerMetrics
 }
 
 var metrics *Metrics
@@ -85,6 +90,25 @@ func Init(_ context.Context) error {
 			},
 			[]string{"operation", "status"},
 		),
+		outboxBacklogSize: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "outbox",
+				Name:      appName + "_backlog_size",
+				Help:      "Количество неотправленных событий в outbox",
+			},
+			[]string{"table"},
+		),
+		outboxBacklogAge: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "outbox",
+				Name:      appName + "_backlog_age_seconds",
+				Help:      "Сколько ждет отправки самое старое событие в outbox",
+			},
+			[]string{"table"},
+		),
+		producerMetrics: producer.NewAsyncProducerMetrics(namespace, appName),
 	}
 
 	return nil
@@ -118,3 +142,13 @@ func RecordDBMetric(queryType string, err error, duration float64) {
 	IncDBRequestCounter(queryType, status)
 	ObserveDBResponseTime(queryType, status, duration)
 }
+
+func SetOutboxBacklog(table string, size int64, age time.Duration) {
+	metrics.outboxBacklogSize.WithLabelValues(table).Set(float64(size))
+	metrics.outboxBacklogAge.WithLabelValues(table).Set(age.Seconds())
+}
+
+// ProducerMetrics are the metrics of the kafka async producer.
+func ProducerMetrics() *producer.AsyncProducerMetrics {
+	return metrics.producerMetrics
+}
@@ -17,4 +17,12 @@ func NewError(message string) Error {
 var (
 	ErrSKUNotFound   = NewError("sku not found")
 	ErrOrderNotFound = NewError("order not found")
+	ErrOrderFailed   = NewError("order failed: its items could not be reserved")
+
+	ErrInvalidOrderStatusTransition = NewError("invalid order status transition")
+	ErrInvalidCursor                = NewError("invalid cursor")
+
+	ErrTotalBelowReserved = NewError("total count is below reserved count")
+	ErrInvalidStockTotal  = NewError("total count is out of range")
+	ErrInvalidStockImport = NewError("invalid stock import")
 )
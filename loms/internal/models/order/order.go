@@ -12,9 +12,10 @@ type Order struct {
 }
 
 type NewOrder struct {
-	User   int64
-	Items  []Item
-	Status Status
+	User           int64
+	Items          []Item
+	Status         Status
+	IdempotencyKey string
 }
 
 type Item struct {
@@ -22,6 +23,21 @@ type Item struct {
 	Count uint16
 }
 
+type ListFilter struct {
+	UserID      int64
+	Statuses    []Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	After       *ListCursor
+	Limit       uint32
+}
+
+// ListCursor points at the last order of the previous page.
+type ListCursor struct {
+	CreatedAt time.Time
+	OrderID   int64
+}
+
 type Status string
 
 const (
@@ -32,13 +48,29 @@ const (
 	OrderStatusCancelled       Status = "cancelled"
 )
 
+var statusTransitions = map[Status][]Status{
+	OrderStatusNew:             {OrderStatusAwaitingPayment, OrderStatusFailed},
+	OrderStatusAwaitingPayment: {OrderStatusPayed, OrderStatusCancelled},
+}
+
 func (s Status) String() string {
 	return string(s)
 }
 
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
 type StatusChangedEvent struct {
 	ID      int64     `json:"id"`
 	OrderID int64     `json:"order_id"`
+	UserID  int64     `json:"user_id"`
 	Status  Status    `json:"status"`
 	At      time.Time `json:"at"`
 }
@@ -1,8 +1,9 @@
 package requests
 
 type OrderCreate struct {
-	User  int64
-	Items []Item
+	User           int64
+	Items          []Item
+	IdempotencyKey string
 }
 
 type Item struct {
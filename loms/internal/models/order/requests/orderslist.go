@@ -0,0 +1,16 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order"
+)
+
+type OrdersList struct {
+	User        int64
+	Statuses    []order.Status
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Cursor      string
+	Limit       uint32
+}
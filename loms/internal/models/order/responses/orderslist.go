@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order"
+)
+
+type OrdersList struct {
+	Orders     []Order
+	NextCursor string
+}
+
+type Order struct {
+	ID        int64
+	Status    order.Status
+	User      int64
+	Items     []Item
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
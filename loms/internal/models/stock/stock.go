@@ -1,5 +1,7 @@
 package stock
 
+import "time"
+
 type Item struct {
 	SKU        uint32
 	TotalCount uint64
@@ -10,3 +12,57 @@ type ReserveItem struct {
 	SKU   uint32
 	Count uint16
 }
+
+// ReservationDrift is a SKU whose reserved counter disagrees with its active reservations.
+type ReservationDrift struct {
+	SKU            uint32
+	Reserved       int64
+	LedgerReserved int64
+}
+
+// LevelChange is the availability of a SKU, total minus reserved, before and after it changed.
+type LevelChange struct {
+	SKU             uint32
+	AvailableBefore int64
+	AvailableAfter  int64
+}
+
+type LevelChangedEvent struct {
+	ID              int64     `json:"id"`
+	SKU             uint32    `json:"sku"`
+	AvailableBefore int64     `json:"available_before"`
+	AvailableAfter  int64     `json:"available_after"`
+	At              time.Time `json:"at"`
+}
+
+type ChangeAction string
+
+const (
+	ChangeActionRestock  ChangeAction = "restock"
+	ChangeActionSetTotal ChangeAction = "set_total"
+	ChangeActionImport   ChangeAction = "import"
+)
+
+// Change is a new total of a SKU together with the state it replaced, one row of the stock audit.
+type Change struct {
+	SKU      uint32
+	OldTotal uint64
+	NewTotal uint64
+	Reserved uint64
+}
+
+type ChangedEvent struct {
+	ID         int64        `json:"id"`
+	SKU        uint32       `json:"sku"`
+	Action     ChangeAction `json:"action"`
+	TotalCount uint64       `json:"total_count"`
+	Reserved   uint64       `json:"reserved"`
+	At         time.Time    `json:"at"`
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV  ImportFormat = "csv"
+	ImportFormatJSON ImportFormat = "json"
+)
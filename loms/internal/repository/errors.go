@@ -3,8 +3,11 @@ package repository
 import "errors"
 
 var (
-	ErrSKUNotFound       = errors.New("sku not found")
-	ErrInsufficientStock = errors.New("insufficient stock")
-	ErrOrderNotFound     = errors.New("order not found")
-	ErrNoElements        = errors.New("no elements")
+	ErrSKUNotFound         = errors.New("sku not found")
+	ErrInsufficientStock   = errors.New("insufficient stock")
+	ErrOrderNotFound       = errors.New("order not found")
+	ErrNoElements          = errors.New("no elements")
+	ErrOrderExists         = errors.New("order with this idempotency key already exists")
+	ErrReservationNotFound = errors.New("active reservation not found")
+	ErrReservationDrift    = errors.New("reserved stock drifted from the reservation ledger")
 )
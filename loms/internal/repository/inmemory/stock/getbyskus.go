@@ -0,0 +1,28 @@
+package stock
+
+import (
+	"context"
+
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+)
+
+func (r *Repository) GetBySKUs(ctx context.Context, skuIDs []uint32) ([]stockmodels.Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]stockmodels.Item, 0, len(skuIDs))
+	for _, skuID := range skuIDs {
+		item, ok := r.stock[skuID]
+		if !ok {
+			continue
+		}
+
+		items = append(items, stockmodels.Item{
+			SKU:        item.SKU,
+			TotalCount: item.TotalCount,
+			Reserved:   item.Reserved,
+		})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,39 @@
+package stock
+
+import (
+	"context"
+	"sort"
+
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+)
+
+func (r *Repository) GetReservationDrift(ctx context.Context) ([]stockmodels.ReservationDrift, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ledger := make(map[uint32]int64, len(r.stock))
+	for _, items := range r.reservations {
+		for _, i := range items {
+			ledger[i.SKU] += int64(i.Count)
+		}
+	}
+
+	drifts := make([]stockmodels.ReservationDrift, 0)
+	for sku, item := range r.stock {
+		if int64(item.Reserved) == ledger[sku] {
+			continue
+		}
+
+		drifts = append(drifts, stockmodels.ReservationDrift{
+			SKU:            sku,
+			Reserved:       int64(item.Reserved),
+			LedgerReserved: ledger[sku],
+		})
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].SKU < drifts[j].SKU
+	})
+
+	return drifts, nil
+}
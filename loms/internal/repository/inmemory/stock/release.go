@@ -0,0 +1,79 @@
+package stock
+
+import (
+	stockdomain "github.com/BruteMors/marketplace-service/loms/internal/domain/stock"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+)
+
+// release drops the order reservations, taking the items off the stock when they were sold.
+// The caller must hold the write lock.
+func (r *Repository) release(orderID int64, sold bool) ([]stockmodels.LevelChange, error) {
+	items, ok := r.reservations[orderID]
+	if !ok {
+		return nil, repository.ErrReservationNotFound
+	}
+
+	for _, i := range items {
+		stockItem := r.stock[i.SKU]
+		if stockItem.Reserved < uint64(i.Count) || stockItem.TotalCount < uint64(i.Count) {
+			return nil, repository.ErrReservationDrift
+		}
+	}
+
+	tracker := newLevelTracker(r.stock)
+	for _, i := range items {
+		tracker.track(i.SKU)
+
+		stockItem := r.stock[i.SKU]
+		stockItem.Reserved -= uint64(i.Count)
+		if sold {
+			stockItem.TotalCount -= uint64(i.Count)
+		}
+		r.stock[i.SKU] = stockItem
+	}
+
+	delete(r.reservations, orderID)
+
+	return tracker.changes(), nil
+}
+
+// levelTracker remembers availability of SKUs before they are modified.
+type levelTracker struct {
+	skus   []uint32
+	before map[uint32]int64
+	stock  map[uint32]stockdomain.Item
+}
+
+func newLevelTracker(stock map[uint32]stockdomain.Item) *levelTracker {
+	return &levelTracker{
+		before: make(map[uint32]int64),
+		stock:  stock,
+	}
+}
+
+func (t *levelTracker) track(sku uint32) {
+	if _, ok := t.before[sku]; ok {
+		return
+	}
+
+	t.skus = append(t.skus, sku)
+	t.before[sku] = available(t.stock[sku])
+}
+
+func (t *levelTracker) changes() []stockmodels.LevelChange {
+	changes := make([]stockmodels.LevelChange, 0, len(t.skus))
+	for _, sku := range t.skus {
+		changes = append(changes, stockmodels.LevelChange{
+			SKU:             sku,
+			AvailableBefore: t.before[sku],
+			AvailableAfter:  available(t.stock[sku]),
+		})
+	}
+
+	return changes
+}
+
+func available(item stockdomain.Item) int64 {
+	return int64(item.TotalCount) - int64(item.Reserved)
+}
@@ -7,23 +7,35 @@ import (
 	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 )
 
-func (r *Repository) Reserve(ctx context.Context, items []stockmodels.ReserveItem) error {
+func (r *Repository) Reserve(
+	ctx context.Context,
+	orderID int64,
+	items []stockmodels.ReserveItem,
+) ([]stockmodels.LevelChange, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	for _, item := range items {
 		stockItem, ok := r.stock[item.SKU]
 		if !ok {
-			return repository.ErrSKUNotFound
+			return nil, repository.ErrSKUNotFound
 		}
 
 		if stockItem.Reserved+uint64(item.Count) > stockItem.TotalCount {
-			return repository.ErrInsufficientStock
+			return nil, repository.ErrInsufficientStock
 		}
+	}
+
+	tracker := newLevelTracker(r.stock)
+	for _, item := range items {
+		tracker.track(item.SKU)
 
+		stockItem := r.stock[item.SKU]
 		stockItem.Reserved += uint64(item.Count)
 		r.stock[item.SKU] = stockItem
 	}
 
-	return nil
+	r.reservations[orderID] = append(r.reservations[orderID], items...)
+
+	return tracker.changes(), nil
 }
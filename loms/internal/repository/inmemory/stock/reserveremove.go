@@ -2,25 +2,12 @@ package stock
 
 import (
 	"context"
-
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
-	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 )
 
-func (r *Repository) ReserveRemove(ctx context.Context, item []stockmodels.ReserveItem) error {
+func (r *Repository) ReserveRemove(ctx context.Context, orderID int64) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	for _, i := range item {
-		stockItem, ok := r.stock[i.SKU]
-		if !ok {
-			return repository.ErrSKUNotFound
-		}
-
-		stockItem.Reserved -= uint64(i.Count)
-		stockItem.TotalCount -= uint64(i.Count)
-		r.stock[i.SKU] = stockItem
-	}
-
-	return nil
+	_, err := r.release(orderID, true)
+	return err
 }
@@ -6,18 +6,22 @@ import (
 	"sync"
 
 	stockdomain "github.com/BruteMors/marketplace-service/loms/internal/domain/stock"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 )
 
 //go:embed stock-data.json
 var stockData []byte
 
 type Repository struct {
-	mu    sync.RWMutex
-	stock map[uint32]stockdomain.Item
+	mu           sync.RWMutex
+	stock        map[uint32]stockdomain.Item
+	reservations map[int64][]stockmodels.ReserveItem
 }
 
 func NewRepository() (*Repository, error) {
-	repo := &Repository{}
+	repo := &Repository{
+		reservations: make(map[int64][]stockmodels.ReserveItem),
+	}
 
 	var tempItems []struct {
 		SKU        uint32 `json:"sku"`
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS orders_awaiting_payment_created_at_idx
+    ON "orders" (created_at)
+    WHERE status = 'awaiting payment';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_awaiting_payment_created_at_idx;
+-- +goose StatementEnd
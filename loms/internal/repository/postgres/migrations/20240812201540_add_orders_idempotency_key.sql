@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS idempotency_key TEXT;
+
+-- keys are generated per checkout attempt, so a key keeps returning its order in any status
+CREATE UNIQUE INDEX IF NOT EXISTS orders_user_id_idempotency_key_uniq
+    ON "orders" (user_id, idempotency_key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_user_id_idempotency_key_uniq;
+ALTER TABLE "orders" DROP COLUMN IF EXISTS idempotency_key;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE reservation_state AS ENUM ('active', 'removed', 'cancelled');
+
+CREATE TABLE IF NOT EXISTS "reservations" (
+                                            id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+                                            order_id BIGINT NOT NULL REFERENCES "orders" (order_id),
+                                            sku INTEGER NOT NULL REFERENCES "items" (sku),
+                                            count INTEGER NOT NULL CHECK (count > 0),
+                                            state reservation_state NOT NULL DEFAULT 'active',
+                                            created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                            updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS reservations_order_id_idx ON "reservations" (order_id);
+CREATE INDEX IF NOT EXISTS reservations_sku_active_idx ON "reservations" (sku) WHERE state = 'active';
+
+-- orders awaiting payment are the only ones holding stock
+INSERT INTO "reservations" (order_id, sku, count)
+SELECT oi.order_id, oi.item_sku, oi.count
+FROM "orders_to_items" oi
+JOIN "orders" o ON o.order_id = oi.order_id
+WHERE o.status = 'awaiting payment' AND oi.count > 0;
+
+-- NOT VALID keeps already drifted rows migratable, they are reported by the reconcile command
+ALTER TABLE "items"
+    ADD CONSTRAINT items_reserved_non_negative CHECK (reserved >= 0) NOT VALID,
+    ADD CONSTRAINT items_reserved_within_total CHECK (reserved <= total_count) NOT VALID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "items"
+    DROP CONSTRAINT IF EXISTS items_reserved_within_total,
+    DROP CONSTRAINT IF EXISTS items_reserved_non_negative;
+DROP TABLE IF EXISTS "reservations";
+DROP TYPE IF EXISTS reservation_state;
+-- +goose StatementEnd
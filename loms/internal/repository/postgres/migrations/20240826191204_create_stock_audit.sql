@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE stock_change_action AS ENUM ('restock', 'set_total', 'import');
+
+CREATE TABLE IF NOT EXISTS "stock_audit" (
+                                           id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+                                           sku INTEGER NOT NULL REFERENCES "items" (sku),
+                                           action stock_change_action NOT NULL,
+                                           old_total INTEGER NOT NULL,
+                                           new_total INTEGER NOT NULL,
+                                           reserved INTEGER NOT NULL,
+                                           reason TEXT NOT NULL DEFAULT '',
+                                           created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS stock_audit_sku_created_at_idx ON "stock_audit" (sku, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "stock_audit";
+DROP TYPE IF EXISTS stock_change_action;
+-- +goose StatementEnd
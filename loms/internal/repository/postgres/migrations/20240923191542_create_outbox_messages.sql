@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "outbox_messages" (
+                                               id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                               topic TEXT NOT NULL,
+                                               key BYTEA,
+                                               payload BYTEA NOT NULL,
+                                               headers JSONB NOT NULL DEFAULT '{}',
+                                               created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                               sent BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS outbox_messages_unsent_idx
+    ON "outbox_messages" (topic, key, id)
+    WHERE sent = FALSE;
+
+CREATE INDEX IF NOT EXISTS outbox_messages_sent_created_at_idx
+    ON "outbox_messages" (created_at)
+    WHERE sent = TRUE;
+
+CREATE TABLE IF NOT EXISTS "outbox_messages_archive" (
+                                                       id BIGINT PRIMARY KEY,
+                                                       topic TEXT NOT NULL,
+                                                       key BYTEA,
+                                                       payload BYTEA NOT NULL,
+                                                       headers JSONB NOT NULL,
+                                                       created_at TIMESTAMP NOT NULL,
+                                                       archived_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION notify_outbox_messages() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_messages', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_messages_notify
+    AFTER INSERT ON "outbox_messages"
+    FOR EACH STATEMENT
+EXECUTE FUNCTION notify_outbox_messages();
+
+-- new events get ids above the ones of order_status_changed_events, so notifier does not
+-- take them for events it has already processed. Events still unsent there are not moved:
+-- let the previous version drain the table before migrating.
+SELECT setval(
+    pg_get_serial_sequence('outbox_messages', 'id'),
+    (SELECT COALESCE(MAX(id), 0) FROM "order_status_changed_events") + 1,
+    false
+);
+
+DROP TABLE IF EXISTS "order_status_changed_events";
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "order_status_changed_events" (
+                                                           id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+                                                           order_id BIGINT NOT NULL REFERENCES "orders" (order_id),
+                                                           status order_status NOT NULL,
+                                                           at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                                           sent BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+DROP TRIGGER IF EXISTS outbox_messages_notify ON "outbox_messages";
+DROP FUNCTION IF EXISTS notify_outbox_messages();
+DROP TABLE IF EXISTS "outbox_messages_archive";
+DROP TABLE IF EXISTS "outbox_messages";
+-- +goose StatementEnd
@@ -2,14 +2,17 @@ package order
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"github.com/BruteMors/marketplace-service/loms/internal/metric"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	sqlc "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order/sqlc"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -45,6 +48,9 @@ func (r *Repository) Create(ctx context.Context, newOrder ordermodels.NewOrder)
 	metric.RecordDBMetric("insert", err, duration)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrOrderExists
+		}
 		return 0, err
 	}
 
@@ -69,6 +75,10 @@ func (r *Repository) prepareCreateOrderParams(newOrder ordermodels.NewOrder) sql
 	return sqlc.CreateOrderParams{
 		UserID: int32(newOrder.User),
 		Status: sqlc.OrderStatus(newOrder.Status),
+		IdempotencyKey: pgtype.Text{
+			String: newOrder.IdempotencyKey,
+			Valid:  newOrder.IdempotencyKey != "",
+		},
 	}
 }
 
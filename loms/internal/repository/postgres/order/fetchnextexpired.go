@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// FetchNextExpiredOrderID locks the oldest unpaid order, skipping rows locked by other replicas
+// and the orders in skip.
+func (r *Repository) FetchNextExpiredOrderID(ctx context.Context, paymentTimeout time.Duration, skip []int64) (orderID int64, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "FetchNextExpiredOrderID")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("paymentTimeout", paymentTimeout.String()),
+		attribute.Int("skip", len(skip)),
+	)
+
+	// A NULL array would filter out every order.
+	if skip == nil {
+		skip = []int64{}
+	}
+
+	queries := sqlc.New(r.db.MasterDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	start := time.Now()
+	orderID, err = queries.FetchNextExpiredOrderID(ctx, sqlc.FetchNextExpiredOrderIDParams{
+		PaymentTimeout: pgtype.Interval{
+			Microseconds: paymentTimeout.Microseconds(),
+			Valid:        true,
+		},
+		SkipOrderIds: skip,
+	})
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repository.ErrNoElements
+		}
+		return 0, err
+	}
+
+	return orderID, nil
+}
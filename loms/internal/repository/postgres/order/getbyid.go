@@ -2,14 +2,17 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"github.com/BruteMors/marketplace-service/loms/internal/metric"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order/sqlc"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -39,6 +42,9 @@ func (r *Repository) GetByID(ctx context.Context, orderID int64) (order ordermod
 	metric.RecordDBMetric("select", err, duration)
 
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ordermodels.Order{}, repository.ErrOrderNotFound
+		}
 		return ordermodels.Order{}, err
 	}
 
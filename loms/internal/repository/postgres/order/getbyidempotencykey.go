@@ -0,0 +1,61 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetByIdempotencyKey finds the order the user placed under the given key and its current status.
+func (r *Repository) GetByIdempotencyKey(
+	ctx context.Context,
+	userID int64,
+	key string,
+) (orderID int64, status ordermodels.Status, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "GetByIdempotencyKey")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("userID", userID),
+	)
+
+	// read from master: a replica may not have the order of a request that is being retried yet
+	queries := sqlc.New(r.db.MasterDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	start := time.Now()
+	row, err := queries.GetByIdempotencyKey(ctx, sqlc.GetByIdempotencyKeyParams{
+		UserID:         int32(userID),
+		IdempotencyKey: pgtype.Text{String: key, Valid: true},
+	})
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, "", repository.ErrOrderNotFound
+		}
+		return 0, "", err
+	}
+
+	return row.OrderID, ordermodels.Status(row.Status), nil
+}
@@ -1,4 +1,4 @@
-package outbox
+package order
 
 import (
 	"context"
@@ -9,24 +9,26 @@ import (
 	"github.com/BruteMors/marketplace-service/loms/internal/metric"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository"
-	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order/sqlc"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (r *Repository) FetchNextOrderStatusChangedEvent(ctx context.Context) (
-	event ordermodels.StatusChangedEvent,
-	err error,
-) {
+func (r *Repository) GetStatusForUpdate(ctx context.Context, orderID int64) (status ordermodels.Status, err error) {
 	tr := otel.Tracer("repository")
-	ctx, span := tr.Start(ctx, "FetchNextOrderStatusChangedEvent")
+	ctx, span := tr.Start(ctx, "GetStatusForUpdate")
 	defer func() {
 		tracing.RecordSpanError(span, err)
 		span.End()
 	}()
 
-	queries := sqlc.New(r.db.ReplicaDB())
+	span.SetAttributes(
+		attribute.Int64("orderID", orderID),
+	)
+
+	queries := sqlc.New(r.db.MasterDB())
 
 	tx, found := transaction.CheckTx(ctx)
 	if found {
@@ -34,23 +36,16 @@ func (r *Repository) FetchNextOrderStatusChangedEvent(ctx context.Context) (
 	}
 
 	start := time.Now()
-	dbEvent, err := queries.FetchNextOrderStatusChangedEvent(ctx)
+	dbStatus, err := queries.GetStatusForUpdate(ctx, orderID)
 	duration := time.Since(start).Seconds()
 	metric.RecordDBMetric("select", err, duration)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return ordermodels.StatusChangedEvent{}, repository.ErrNoElements
+			return "", repository.ErrOrderNotFound
 		}
-		return ordermodels.StatusChangedEvent{}, err
-	}
-
-	event = ordermodels.StatusChangedEvent{
-		ID:      dbEvent.ID,
-		OrderID: dbEvent.OrderID,
-		Status:  ordermodels.Status(dbEvent.Status),
-		At:      dbEvent.At.Time,
+		return "", err
 	}
 
-	return event, nil
+	return ordermodels.Status(dbStatus), nil
 }
@@ -0,0 +1,83 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ListByUser returns user orders newest first, starting right after filter.After when it is set.
+func (r *Repository) ListByUser(ctx context.Context, filter ordermodels.ListFilter) (orders []ordermodels.Order, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "ListByUser")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("userID", filter.UserID),
+		attribute.Int("limit", int(filter.Limit)),
+	)
+
+	queries := sqlc.New(r.db.ReplicaDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, status.String())
+	}
+
+	params := sqlc.ListByUserParams{
+		UserID:      int32(filter.UserID),
+		Statuses:    statuses,
+		CreatedFrom: toPgTimestamp(filter.CreatedFrom),
+		CreatedTo:   toPgTimestamp(filter.CreatedTo),
+		PageLimit:   int32(filter.Limit),
+	}
+
+	if filter.After != nil {
+		params.CursorCreatedAt = toPgTimestamp(&filter.After.CreatedAt)
+		params.CursorOrderID = pgtype.Int8{Int64: filter.After.OrderID, Valid: true}
+	}
+
+	start := time.Now()
+	rows, err := queries.ListByUser(ctx, params)
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	orders = make([]ordermodels.Order, 0, len(rows))
+	for _, row := range rows {
+		order, err := r.convertGetByIDRowToOrder(sqlc.GetByIDRow(row))
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+func toPgTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
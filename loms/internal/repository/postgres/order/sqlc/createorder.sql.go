@@ -7,21 +7,25 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createOrder = `-- name: CreateOrder :one
-INSERT INTO "orders" (user_id, status, created_at, updated_at)
-VALUES ($1, $2, NOW(), NOW())
+INSERT INTO "orders" (user_id, status, idempotency_key, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
+ON CONFLICT (user_id, idempotency_key) DO NOTHING
 RETURNING order_id
 `
 
 type CreateOrderParams struct {
-	UserID int32
-	Status OrderStatus
+	UserID         int32
+	Status         OrderStatus
+	IdempotencyKey pgtype.Text
 }
 
 func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (int64, error) {
-	row := q.db.QueryRow(ctx, createOrder, arg.UserID, arg.Status)
+	row := q.db.QueryRow(ctx, createOrder, arg.UserID, arg.Status, arg.IdempotencyKey)
 	var order_id int64
 	err := row.Scan(&order_id)
 	return order_id, err
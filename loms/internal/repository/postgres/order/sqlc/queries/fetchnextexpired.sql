@@ -0,0 +1,9 @@
+-- name: FetchNextExpiredOrderID :one
+SELECT order_id
+FROM orders
+WHERE status = 'awaiting payment'
+  AND created_at < NOW() - sqlc.arg(payment_timeout)::interval
+  AND order_id <> ALL(sqlc.arg(skip_order_ids)::bigint[])
+ORDER BY created_at ASC, order_id ASC
+LIMIT 1
+FOR UPDATE SKIP LOCKED;
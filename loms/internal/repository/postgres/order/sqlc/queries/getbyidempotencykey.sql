@@ -0,0 +1,5 @@
+-- name: GetByIdempotencyKey :one
+SELECT order_id, status
+FROM orders
+WHERE user_id = $1
+  AND idempotency_key = $2;
@@ -0,0 +1,5 @@
+-- name: GetStatusForUpdate :one
+SELECT status
+FROM orders
+WHERE order_id = $1
+FOR UPDATE;
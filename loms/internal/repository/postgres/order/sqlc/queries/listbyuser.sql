@@ -0,0 +1,25 @@
+-- name: ListByUser :many
+WITH page AS (
+  SELECT order_id, status, user_id, created_at, updated_at
+  FROM orders
+  WHERE user_id = @user_id
+    AND (cardinality(@statuses::text[]) = 0 OR status::text = ANY(@statuses::text[]))
+    AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+    AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp)
+    AND (sqlc.narg(cursor_created_at)::timestamp IS NULL
+      OR (created_at, order_id) < (sqlc.narg(cursor_created_at)::timestamp, sqlc.narg(cursor_order_id)::bigint))
+  ORDER BY created_at DESC, order_id DESC
+  LIMIT @page_limit
+)
+SELECT
+  p.order_id AS id,
+  p.status,
+  p.user_id,
+  p.created_at,
+  p.updated_at,
+  array_agg(i.item_sku ORDER BY i.id)::int[] AS skus,
+  array_agg(i.count ORDER BY i.id)::int[] AS counts
+FROM page p
+       JOIN orders_to_items i ON p.order_id = i.order_id
+GROUP BY p.order_id, p.status, p.user_id, p.created_at, p.updated_at
+ORDER BY p.created_at DESC, p.order_id DESC;
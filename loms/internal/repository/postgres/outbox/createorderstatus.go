@@ -2,9 +2,14 @@ package outbox
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"time"
 
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
 	"github.com/BruteMors/marketplace-service/loms/internal/metric"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox/sqlc"
@@ -13,6 +18,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
+// CreateOrderStatusChangedEvent adds the event to outbox_messages. The event id is the id
+// of the outbox message, so a replayed event keeps it. The message carries the trace context
+// of ctx, so the event is sent and handled in the trace of the request that changed the status.
 func (r *Repository) CreateOrderStatusChangedEvent(ctx context.Context, orderID int64, status ordermodels.Status) (err error) {
 	tr := otel.Tracer("repository")
 	ctx, span := tr.Start(ctx, "CreateOrderStatusChangedEvent")
@@ -34,19 +42,44 @@ func (r *Repository) CreateOrderStatusChangedEvent(ctx context.Context, orderID
 	}
 
 	start := time.Now()
-	err = queries.CreateOrderStatusChangedEvent(ctx, r.convertToCreateOrderStatusChangedEventParams(orderID, status))
+	userID, err := queries.GetOrderUserID(ctx, orderID)
 	duration := time.Since(start).Seconds()
-	metric.RecordDBMetric("insert", err, duration)
+	metric.RecordDBMetric("select", err, duration)
 
-	return err
-}
+	if err != nil {
+		return err
+	}
+
+	start = time.Now()
+	eventID, err := r.store.NextID(ctx)
+	duration = time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
 
-func (r *Repository) convertToCreateOrderStatusChangedEventParams(
-	orderID int64,
-	status ordermodels.Status,
-) sqlc.CreateOrderStatusChangedEventParams {
-	return sqlc.CreateOrderStatusChangedEventParams{
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(ordermodels.StatusChangedEvent{
+		ID:      eventID,
 		OrderID: orderID,
-		Status:  sqlc.OrderStatus(status),
+		UserID:  int64(userID),
+		Status:  status,
+		At:      time.Now().UTC(),
+	})
+	if err != nil {
+		return err
 	}
+
+	start = time.Now()
+	err = r.store.Add(ctx, liboutbox.Message{
+		ID:      eventID,
+		Topic:   config.GetSendStatusChangedEventTopic(),
+		Key:     []byte(strconv.FormatInt(orderID, 10)),
+		Payload: payload,
+		Headers: kafka.InjectTraceContext(ctx, nil),
+	})
+	duration = time.Since(start).Seconds()
+	metric.RecordDBMetric("insert", err, duration)
+
+	return err
 }
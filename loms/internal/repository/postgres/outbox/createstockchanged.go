@@ -0,0 +1,79 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreateStockChangedEvents adds an event per change to outbox_messages, keyed by sku.
+// The event id is the id of the outbox message. The messages carry the trace context of ctx.
+func (r *Repository) CreateStockChangedEvents(
+	ctx context.Context,
+	action stockmodels.ChangeAction,
+	changes []stockmodels.Change,
+) (err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "CreateStockChangedEvents")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("action", string(action)),
+		attribute.Int("sku_count", len(changes)),
+	)
+
+	msgs := make([]liboutbox.Message, 0, len(changes))
+	at := time.Now().UTC()
+	headers := kafka.InjectTraceContext(ctx, nil)
+
+	for _, change := range changes {
+		start := time.Now()
+		eventID, err := r.store.NextID(ctx)
+		duration := time.Since(start).Seconds()
+		metric.RecordDBMetric("select", err, duration)
+
+		if err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(stockmodels.ChangedEvent{
+			ID:         eventID,
+			SKU:        change.SKU,
+			Action:     action,
+			TotalCount: change.NewTotal,
+			Reserved:   change.Reserved,
+			At:         at,
+		})
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, liboutbox.Message{
+			ID:      eventID,
+			Topic:   config.GetSendStockChangedEventTopic(),
+			Key:     []byte(strconv.FormatUint(uint64(change.SKU), 10)),
+			Payload: payload,
+			Headers: headers,
+		})
+	}
+
+	start := time.Now()
+	err = r.store.Add(ctx, msgs...)
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("insert", err, duration)
+
+	return err
+}
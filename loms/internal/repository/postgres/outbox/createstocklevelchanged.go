@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// CreateStockLevelChangedEvents adds an event per change to outbox_messages, keyed by sku.
+// The event id is the id of the outbox message, notifier skips the ids it has already processed.
+// The messages carry the trace context of ctx.
+func (r *Repository) CreateStockLevelChangedEvents(ctx context.Context, changes []stockmodels.LevelChange) (err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "CreateStockLevelChangedEvents")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sku_count", len(changes)))
+
+	msgs := make([]liboutbox.Message, 0, len(changes))
+	at := time.Now().UTC()
+	headers := kafka.InjectTraceContext(ctx, nil)
+
+	for _, change := range changes {
+		start := time.Now()
+		eventID, err := r.store.NextID(ctx)
+		duration := time.Since(start).Seconds()
+		metric.RecordDBMetric("select", err, duration)
+
+		if err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(stockmodels.LevelChangedEvent{
+			ID:              eventID,
+			SKU:             change.SKU,
+			AvailableBefore: change.AvailableBefore,
+			AvailableAfter:  change.AvailableAfter,
+			At:              at,
+		})
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, liboutbox.Message{
+			ID:      eventID,
+			Topic:   config.GetSendStockLevelChangedEventTopic(),
+			Key:     []byte(strconv.FormatUint(uint64(change.SKU), 10)),
+			Payload: payload,
+			Headers: headers,
+		})
+	}
+
+	start := time.Now()
+	err = r.store.Add(ctx, msgs...)
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("insert", err, duration)
+
+	return err
+}
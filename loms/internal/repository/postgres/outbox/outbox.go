@@ -1,17 +1,26 @@
 package outbox
 
 import (
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 )
 
 type Repository struct {
-	db *pg.Client
+	db    *pg.Client
+	store *liboutbox.Store
 }
 
 func NewRepository(db *pg.Client) *Repository {
 	repo := &Repository{
-		db: db,
+		db:    db,
+		store: NewStore(db),
 	}
 
 	return repo
 }
+
+// NewStore is the outbox_messages store that writes in the transaction of the context.
+func NewStore(db *pg.Client) *liboutbox.Store {
+	return liboutbox.NewStore(db.MasterDB(), liboutbox.WithTxFromContext(transaction.CheckTx))
+}
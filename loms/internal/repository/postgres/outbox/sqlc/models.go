@@ -56,6 +56,92 @@ func (ns NullOrderStatus) Value() (driver.Value, error) {
 	return string(ns.OrderStatus), nil
 }
 
+type ReservationState string
+
+const (
+	ReservationStateActive    ReservationState = "active"
+	ReservationStateRemoved   ReservationState = "removed"
+	ReservationStateCancelled ReservationState = "cancelled"
+)
+
+func (e *ReservationState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReservationState(s)
+	case string:
+		*e = ReservationState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReservationState: %T", src)
+	}
+	return nil
+}
+
+type NullReservationState struct {
+	ReservationState ReservationState
+	Valid            bool // Valid is true if ReservationState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReservationState) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReservationState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReservationState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReservationState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReservationState), nil
+}
+
+type StockChangeAction string
+
+const (
+	StockChangeActionRestock  StockChangeAction = "restock"
+	StockChangeActionSetTotal StockChangeAction = "set_total"
+	StockChangeActionImport   StockChangeAction = "import"
+)
+
+func (e *StockChangeAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StockChangeAction(s)
+	case string:
+		*e = StockChangeAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StockChangeAction: %T", src)
+	}
+	return nil
+}
+
+type NullStockChangeAction struct {
+	StockChangeAction StockChangeAction
+	Valid             bool // Valid is true if StockChangeAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStockChangeAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.StockChangeAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StockChangeAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStockChangeAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StockChangeAction), nil
+}
+
 type Item struct {
 	Sku        int32
 	TotalCount int32
@@ -65,19 +151,12 @@ type Item struct {
 }
 
 type Order struct {
-	OrderID   int64
-	UserID    int32
-	Status    OrderStatus
-	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
-}
-
-type OrderStatusChangedEvent struct {
-	ID      int64
-	OrderID int64
-	Status  OrderStatus
-	At      pgtype.Timestamp
-	Sent    bool
+	OrderID        int64
+	UserID         int32
+	Status         OrderStatus
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	IdempotencyKey pgtype.Text
 }
 
 type OrdersToItem struct {
@@ -86,3 +165,44 @@ type OrdersToItem struct {
 	ItemSku int32
 	Count   int32
 }
+
+type OutboxMessage struct {
+	ID        int64
+	Topic     string
+	Key       []byte
+	Payload   []byte
+	Headers   []byte
+	CreatedAt pgtype.Timestamp
+	Sent      bool
+}
+
+type OutboxMessagesArchive struct {
+	ID         int64
+	Topic      string
+	Key        []byte
+	Payload    []byte
+	Headers    []byte
+	CreatedAt  pgtype.Timestamp
+	ArchivedAt pgtype.Timestamp
+}
+
+type Reservation struct {
+	ID        int64
+	OrderID   int64
+	Sku       int32
+	Count     int32
+	State     ReservationState
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type StockAudit struct {
+	ID        int64
+	Sku       int32
+	Action    StockChangeAction
+	OldTotal  int32
+	NewTotal  int32
+	Reserved  int32
+	Reason    string
+	CreatedAt pgtype.Timestamp
+}
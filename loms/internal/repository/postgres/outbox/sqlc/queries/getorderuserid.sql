@@ -0,0 +1,4 @@
+-- name: GetOrderUserID :one
+SELECT user_id
+FROM orders
+WHERE order_id = $1;
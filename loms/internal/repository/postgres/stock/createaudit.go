@@ -0,0 +1,71 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	sqlc "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *Repository) CreateAudit(
+	ctx context.Context,
+	action stockmodels.ChangeAction,
+	reason string,
+	changes []stockmodels.Change,
+) (err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "CreateAudit")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("action", string(action)),
+		attribute.Int("sku_count", len(changes)),
+	)
+
+	queries := sqlc.New(r.db.MasterDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	start := time.Now()
+	err = queries.CreateAudit(ctx, r.convertToCreateAuditParams(action, reason, changes))
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("insert", err, duration)
+
+	return err
+}
+
+func (r *Repository) convertToCreateAuditParams(
+	action stockmodels.ChangeAction,
+	reason string,
+	changes []stockmodels.Change,
+) sqlc.CreateAuditParams {
+	params := sqlc.CreateAuditParams{
+		Sku:      make([]int32, 0, len(changes)),
+		Action:   sqlc.StockChangeAction(action),
+		OldTotal: make([]int32, 0, len(changes)),
+		NewTotal: make([]int32, 0, len(changes)),
+		Reserved: make([]int32, 0, len(changes)),
+		Reason:   reason,
+	}
+
+	for _, change := range changes {
+		params.Sku = append(params.Sku, int32(change.SKU))
+		params.OldTotal = append(params.OldTotal, int32(change.OldTotal))
+		params.NewTotal = append(params.NewTotal, int32(change.NewTotal))
+		params.Reserved = append(params.Reserved, int32(change.Reserved))
+	}
+
+	return params
+}
@@ -0,0 +1,57 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	sqlc "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *Repository) GetBySKUs(ctx context.Context, skuIDs []uint32) (items []stockmodels.Item, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "GetBySKUs")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sku_count", len(skuIDs)))
+
+	queries := sqlc.New(r.db.ReplicaDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	skus := make([]int32, 0, len(skuIDs))
+	for _, skuID := range skuIDs {
+		skus = append(skus, int32(skuID))
+	}
+
+	start := time.Now()
+	rows, err := queries.GetBySKUs(ctx, skus)
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]stockmodels.Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, stockmodels.Item{
+			SKU:        uint32(row.Sku),
+			TotalCount: uint64(row.TotalCount),
+			Reserved:   uint64(row.Reserved),
+		})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,46 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (r *Repository) GetReservationDrift(ctx context.Context) (drifts []stockmodels.ReservationDrift, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "GetReservationDrift")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	queries := sqlc.New(r.db.MasterDB())
+
+	start := time.Now()
+	rows, err := queries.GetReservationDrift(ctx)
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("drift_count", len(rows)))
+
+	drifts = make([]stockmodels.ReservationDrift, 0, len(rows))
+	for _, row := range rows {
+		drifts = append(drifts, stockmodels.ReservationDrift{
+			SKU:            uint32(row.Sku),
+			Reserved:       int64(row.Reserved),
+			LedgerReserved: int64(row.LedgerReserved),
+		})
+	}
+
+	return drifts, nil
+}
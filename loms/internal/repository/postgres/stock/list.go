@@ -0,0 +1,59 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	sqlc "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// List returns up to limit items with SKUs greater than afterSKU, ordered by SKU.
+func (r *Repository) List(ctx context.Context, afterSKU uint32, limit uint32) (items []stockmodels.Item, err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "List")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("afterSKU", int64(afterSKU)),
+		attribute.Int64("limit", int64(limit)),
+	)
+
+	queries := sqlc.New(r.db.ReplicaDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	start := time.Now()
+	rows, err := queries.List(ctx, sqlc.ListParams{
+		AfterSku: int32(afterSKU),
+		RowLimit: int32(limit),
+	})
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("select", err, duration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items = make([]stockmodels.Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, stockmodels.Item{
+			SKU:        uint32(row.Sku),
+			TotalCount: uint64(row.TotalCount),
+			Reserved:   uint64(row.Reserved),
+		})
+	}
+
+	return items, nil
+}
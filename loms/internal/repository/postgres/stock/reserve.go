@@ -12,9 +12,15 @@ import (
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 	"github.com/jackc/pgx/v5"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (r *Repository) Reserve(ctx context.Context, items []stockmodels.ReserveItem) (err error) {
+// Reserve reserves the items for the order and returns how availability of each SKU changed.
+func (r *Repository) Reserve(
+	ctx context.Context,
+	orderID int64,
+	items []stockmodels.ReserveItem,
+) (changes []stockmodels.LevelChange, err error) {
 	tr := otel.Tracer("repository")
 	ctx, span := tr.Start(ctx, "Reserve")
 	defer func() {
@@ -22,11 +28,16 @@ func (r *Repository) Reserve(ctx context.Context, items []stockmodels.ReserveIte
 		span.End()
 	}()
 
+	span.SetAttributes(
+		attribute.Int64("orderID", orderID),
+		attribute.Int("item_count", len(items)),
+	)
+
 	queries := sqlc.New(r.db.MasterDB())
 
 	tx, commit, rollback, err := transaction.CreateTx(ctx, r.db.MasterDB(), pgx.TxOptions{})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer rollback(ctx)
 
@@ -48,13 +59,24 @@ func (r *Repository) Reserve(ctx context.Context, items []stockmodels.ReserveIte
 	metric.RecordDBMetric("select", err, duration)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if len(itemsAvailable) < len(skuToCount) {
+		return nil, repository.ErrSKUNotFound
 	}
 
+	changes = make([]stockmodels.LevelChange, 0, len(itemsAvailable))
 	for _, item := range itemsAvailable {
 		if item.Available < skuToCount[item.Sku] {
-			return repository.ErrInsufficientStock
+			return nil, repository.ErrInsufficientStock
 		}
+
+		changes = append(changes, stockmodels.LevelChange{
+			SKU:             uint32(item.Sku),
+			AvailableBefore: int64(item.Available),
+			AvailableAfter:  int64(item.Available - skuToCount[item.Sku]),
+		})
 	}
 
 	start = time.Now()
@@ -63,7 +85,19 @@ func (r *Repository) Reserve(ctx context.Context, items []stockmodels.ReserveIte
 	metric.RecordDBMetric("update", err, duration)
 
 	if err != nil {
-		return err
+		if isCheckViolation(err) {
+			return nil, repository.ErrReservationDrift
+		}
+		return nil, err
+	}
+
+	start = time.Now()
+	err = queries.CreateReservations(ctx, r.convertToCreateReservationsParams(orderID, items))
+	duration = time.Since(start).Seconds()
+	metric.RecordDBMetric("insert", err, duration)
+
+	if err != nil {
+		return nil, err
 	}
 
 	start = time.Now()
@@ -71,7 +105,11 @@ func (r *Repository) Reserve(ctx context.Context, items []stockmodels.ReserveIte
 	duration = time.Since(start).Seconds()
 	metric.RecordDBMetric("commit", err, duration)
 
-	return err
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
 }
 
 func (r *Repository) convertToReservedItemsParams(items []stockmodels.ReserveItem) sqlc.UpdateReservedItemsParams {
@@ -88,3 +126,16 @@ func (r *Repository) convertToReservedItemsParams(items []stockmodels.ReserveIte
 		Count: counts,
 	}
 }
+
+func (r *Repository) convertToCreateReservationsParams(
+	orderID int64,
+	items []stockmodels.ReserveItem,
+) sqlc.CreateReservationsParams {
+	params := r.convertToReservedItemsParams(items)
+
+	return sqlc.CreateReservationsParams{
+		OrderID: orderID,
+		Sku:     params.Sku,
+		Count:   params.Count,
+	}
+}
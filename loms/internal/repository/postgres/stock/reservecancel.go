@@ -7,13 +7,15 @@ import (
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"github.com/BruteMors/marketplace-service/loms/internal/metric"
 	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func (r *Repository) ReserveCancel(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+// ReserveCancel releases the active reservations of the order and returns how availability of each SKU changed.
+func (r *Repository) ReserveCancel(ctx context.Context, orderID int64) (changes []stockmodels.LevelChange, err error) {
 	tr := otel.Tracer("repository")
 	ctx, span := tr.Start(ctx, "ReserveCancel")
 	defer func() {
@@ -29,28 +31,35 @@ func (r *Repository) ReserveCancel(ctx context.Context, item []stockmodels.Reser
 	}
 
 	span.SetAttributes(
-		attribute.Int("item_count", len(item)),
+		attribute.Int64("orderID", orderID),
 	)
 
 	start := time.Now()
-	err = queries.ReserveCancel(ctx, r.convertToReserveCancelItemsParams(item))
+	rows, err := queries.ReserveCancel(ctx, orderID)
 	duration := time.Since(start).Seconds()
 	metric.RecordDBMetric("update", err, duration)
 
-	return err
-}
-
-func (r *Repository) convertToReserveCancelItemsParams(items []stockmodels.ReserveItem) sqlc.ReserveCancelParams {
-	skus := make([]int32, len(items))
-	counts := make([]int32, len(items))
+	if err != nil {
+		if isCheckViolation(err) {
+			return nil, repository.ErrReservationDrift
+		}
+		return nil, err
+	}
 
-	for i, item := range items {
-		skus[i] = int32(item.SKU)
-		counts[i] = int32(item.Count)
+	if len(rows) == 0 {
+		return nil, repository.ErrReservationNotFound
 	}
 
-	return sqlc.ReserveCancelParams{
-		Sku:   skus,
-		Count: counts,
+	span.SetAttributes(attribute.Int("sku_count", len(rows)))
+
+	changes = make([]stockmodels.LevelChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, stockmodels.LevelChange{
+			SKU:             uint32(row.Sku),
+			AvailableBefore: int64(row.Available - row.Count),
+			AvailableAfter:  int64(row.Available),
+		})
 	}
+
+	return changes, nil
 }
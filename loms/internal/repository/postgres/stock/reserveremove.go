@@ -6,14 +6,14 @@ import (
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"github.com/BruteMors/marketplace-service/loms/internal/metric"
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func (r *Repository) ReserveRemove(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+func (r *Repository) ReserveRemove(ctx context.Context, orderID int64) (err error) {
 	tr := otel.Tracer("repository")
 	ctx, span := tr.Start(ctx, "ReserveRemove")
 	defer func() {
@@ -29,28 +29,26 @@ func (r *Repository) ReserveRemove(ctx context.Context, item []stockmodels.Reser
 	}
 
 	span.SetAttributes(
-		attribute.Int("item_count", len(item)),
+		attribute.Int64("orderID", orderID),
 	)
 
 	start := time.Now()
-	err = queries.ReserveRemove(ctx, r.convertToReserveRemoveItemsParams(item))
+	skus, err := queries.ReserveRemove(ctx, orderID)
 	duration := time.Since(start).Seconds()
 	metric.RecordDBMetric("update", err, duration)
 
-	return err
-}
-
-func (r *Repository) convertToReserveRemoveItemsParams(items []stockmodels.ReserveItem) sqlc.ReserveRemoveParams {
-	skus := make([]int32, len(items))
-	counts := make([]int32, len(items))
-
-	for i, item := range items {
-		skus[i] = int32(item.SKU)
-		counts[i] = int32(item.Count)
+	if err != nil {
+		if isCheckViolation(err) {
+			return repository.ErrReservationDrift
+		}
+		return err
 	}
 
-	return sqlc.ReserveRemoveParams{
-		Sku:   skus,
-		Count: counts,
+	if len(skus) == 0 {
+		return repository.ErrReservationNotFound
 	}
+
+	span.SetAttributes(attribute.Int("sku_count", len(skus)))
+
+	return nil
 }
@@ -0,0 +1,8 @@
+-- name: CreateAudit :exec
+INSERT INTO stock_audit (sku, action, old_total, new_total, reserved, reason)
+SELECT unnest(@sku::int[]),
+       @action::stock_change_action,
+       unnest(@old_total::int[]),
+       unnest(@new_total::int[]),
+       unnest(@reserved::int[]),
+       @reason::text;
@@ -0,0 +1,4 @@
+-- name: GetBySKUs :many
+SELECT sku, total_count, reserved
+FROM items
+WHERE sku = ANY(@sku::int[]);
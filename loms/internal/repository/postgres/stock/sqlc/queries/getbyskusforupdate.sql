@@ -0,0 +1,6 @@
+-- name: GetBySKUsForUpdate :many
+SELECT sku, total_count, reserved
+FROM items
+WHERE sku = ANY(@sku::int[])
+ORDER BY sku
+  FOR UPDATE;
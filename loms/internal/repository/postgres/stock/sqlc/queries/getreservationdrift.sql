@@ -0,0 +1,10 @@
+-- name: GetReservationDrift :many
+SELECT
+  i.sku,
+  i.reserved,
+  COALESCE(SUM(r.count), 0)::int AS ledger_reserved
+FROM items i
+LEFT JOIN reservations r ON r.sku = i.sku AND r.state = 'active'
+GROUP BY i.sku, i.reserved
+HAVING i.reserved <> COALESCE(SUM(r.count), 0)
+ORDER BY i.sku;
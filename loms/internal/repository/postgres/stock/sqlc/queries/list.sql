@@ -0,0 +1,6 @@
+-- name: List :many
+SELECT sku, total_count, reserved
+FROM items
+WHERE sku > @after_sku::int
+ORDER BY sku
+LIMIT @row_limit::int;
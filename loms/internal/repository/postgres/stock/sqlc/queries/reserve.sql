@@ -13,5 +13,6 @@ SET reserved = items.reserved + ud.count
 FROM unnested_data ud
 WHERE items.sku = ud.sku AND (items.total_count - items.reserved) >= ud.count;
 
-
-
+-- name: CreateReservations :exec
+INSERT INTO reservations (order_id, sku, count)
+SELECT @order_id::bigint, unnest(@sku::int[]), unnest(@count::int[]);
@@ -1,8 +1,18 @@
--- name: ReserveCancel :exec
-WITH unnested_data AS (
-  SELECT unnest(@sku::int[]) AS sku, unnest(@count::int[]) AS count
+-- name: ReserveCancel :many
+WITH released AS (
+  UPDATE reservations
+  SET state = 'cancelled', updated_at = NOW()
+  WHERE order_id = @order_id AND state = 'active'
+  RETURNING sku, count
+), totals AS (
+  SELECT sku, SUM(count)::int AS count
+  FROM released
+  GROUP BY sku
 )
 UPDATE items
-SET reserved = GREATEST(reserved - unnested_data.count, 0)
-FROM unnested_data
-WHERE items.sku = unnested_data.sku;
+SET
+  reserved = items.reserved - totals.count,
+  updated_at = NOW()
+FROM totals
+WHERE items.sku = totals.sku
+RETURNING items.sku, (items.total_count - items.reserved)::int AS available, totals.count;
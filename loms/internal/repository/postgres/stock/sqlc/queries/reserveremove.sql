@@ -1,7 +1,19 @@
--- name: ReserveRemove :exec
+-- name: ReserveRemove :many
+WITH released AS (
+  UPDATE reservations
+  SET state = 'removed', updated_at = NOW()
+  WHERE order_id = @order_id AND state = 'active'
+  RETURNING sku, count
+), totals AS (
+  SELECT sku, SUM(count)::int AS count
+  FROM released
+  GROUP BY sku
+)
 UPDATE items
 SET
-  reserved = GREATEST(reserved - data.count, 0),
-  total_count = GREATEST(total_count - data.count, 0)
-FROM (SELECT unnest(@sku::int[]) AS sku, unnest(@count::int[]) AS count) AS data
-WHERE items.sku = data.sku;
+  reserved = items.reserved - totals.count,
+  total_count = items.total_count - totals.count,
+  updated_at = NOW()
+FROM totals
+WHERE items.sku = totals.sku
+RETURNING items.sku;
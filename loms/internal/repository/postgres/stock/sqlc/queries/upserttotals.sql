@@ -0,0 +1,6 @@
+-- name: UpsertTotals :exec
+INSERT INTO items (sku, total_count, reserved)
+SELECT unnest(@sku::int[]), unnest(@total_count::int[]), 0
+ON CONFLICT (sku) DO UPDATE
+SET total_count = EXCLUDED.total_count,
+    updated_at = NOW();
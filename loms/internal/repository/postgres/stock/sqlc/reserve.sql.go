@@ -60,3 +60,19 @@ func (q *Queries) UpdateReservedItems(ctx context.Context, arg UpdateReservedIte
 	_, err := q.db.Exec(ctx, updateReservedItems, arg.Sku, arg.Count)
 	return err
 }
+
+const createReservations = `-- name: CreateReservations :exec
+INSERT INTO reservations (order_id, sku, count)
+SELECT $1::bigint, unnest($2::int[]), unnest($3::int[])
+`
+
+type CreateReservationsParams struct {
+	OrderID int64
+	Sku     []int32
+	Count   []int32
+}
+
+func (q *Queries) CreateReservations(ctx context.Context, arg CreateReservationsParams) error {
+	_, err := q.db.Exec(ctx, createReservations, arg.OrderID, arg.Sku, arg.Count)
+	return err
+}
@@ -9,22 +9,48 @@ import (
 	"context"
 )
 
-const reserveCancel = `-- name: ReserveCancel :exec
-WITH unnested_data AS (
-  SELECT unnest($1::int[]) AS sku, unnest($2::int[]) AS count
+const reserveCancel = `-- name: ReserveCancel :many
+WITH released AS (
+  UPDATE reservations
+  SET state = 'cancelled', updated_at = NOW()
+  WHERE order_id = $1 AND state = 'active'
+  RETURNING sku, count
+), totals AS (
+  SELECT sku, SUM(count)::int AS count
+  FROM released
+  GROUP BY sku
 )
 UPDATE items
-SET reserved = GREATEST(reserved - unnested_data.count, 0)
-FROM unnested_data
-WHERE items.sku = unnested_data.sku
+SET
+  reserved = items.reserved - totals.count,
+  updated_at = NOW()
+FROM totals
+WHERE items.sku = totals.sku
+RETURNING items.sku, (items.total_count - items.reserved)::int AS available, totals.count
 `
 
-type ReserveCancelParams struct {
-	Sku   []int32
-	Count []int32
+type ReserveCancelRow struct {
+	Sku       int32
+	Available int32
+	Count     int32
 }
 
-func (q *Queries) ReserveCancel(ctx context.Context, arg ReserveCancelParams) error {
-	_, err := q.db.Exec(ctx, reserveCancel, arg.Sku, arg.Count)
-	return err
+func (q *Queries) ReserveCancel(ctx context.Context, orderID int64) ([]ReserveCancelRow, error) {
+	rows, err := q.db.Query(ctx, reserveCancel, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReserveCancelRow
+	for rows.Next() {
+		var i ReserveCancelRow
+		if err := rows.Scan(&i.Sku, &i.Available, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
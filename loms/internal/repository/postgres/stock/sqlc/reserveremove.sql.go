@@ -9,21 +9,43 @@ import (
 	"context"
 )
 
-const reserveRemove = `-- name: ReserveRemove :exec
+const reserveRemove = `-- name: ReserveRemove :many
+WITH released AS (
+  UPDATE reservations
+  SET state = 'removed', updated_at = NOW()
+  WHERE order_id = $1 AND state = 'active'
+  RETURNING sku, count
+), totals AS (
+  SELECT sku, SUM(count)::int AS count
+  FROM released
+  GROUP BY sku
+)
 UPDATE items
 SET
-  reserved = GREATEST(reserved - data.count, 0),
-  total_count = GREATEST(total_count - data.count, 0)
-FROM (SELECT unnest($1::int[]) AS sku, unnest($2::int[]) AS count) AS data
-WHERE items.sku = data.sku
+  reserved = items.reserved - totals.count,
+  total_count = items.total_count - totals.count,
+  updated_at = NOW()
+FROM totals
+WHERE items.sku = totals.sku
+RETURNING items.sku
 `
 
-type ReserveRemoveParams struct {
-	Sku   []int32
-	Count []int32
-}
-
-func (q *Queries) ReserveRemove(ctx context.Context, arg ReserveRemoveParams) error {
-	_, err := q.db.Exec(ctx, reserveRemove, arg.Sku, arg.Count)
-	return err
+func (q *Queries) ReserveRemove(ctx context.Context, orderID int64) ([]int32, error) {
+	rows, err := q.db.Query(ctx, reserveRemove, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var sku int32
+		if err := rows.Scan(&sku); err != nil {
+			return nil, err
+		}
+		items = append(items, sku)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
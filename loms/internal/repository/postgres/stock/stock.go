@@ -1,9 +1,14 @@
 package stock
 
 import (
+	"errors"
+
 	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+const checkViolationCode = "23514"
+
 type Repository struct {
 	db *pg.Client
 }
@@ -15,3 +20,10 @@ func NewRepository(db *pg.Client) *Repository {
 
 	return repo
 }
+
+// isCheckViolation reports whether err was raised by one of the items constraints,
+// which only happens when items.reserved no longer matches the reservations ledger.
+func isCheckViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == checkViolationCode
+}
@@ -0,0 +1,54 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/metric"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	sqlc "github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock/sqlc"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// UpsertTotals sets total counts of the changed items, creating SKUs that do not exist yet with nothing reserved.
+func (r *Repository) UpsertTotals(ctx context.Context, changes []stockmodels.Change) (err error) {
+	tr := otel.Tracer("repository")
+	ctx, span := tr.Start(ctx, "UpsertTotals")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sku_count", len(changes)))
+
+	queries := sqlc.New(r.db.MasterDB())
+
+	tx, found := transaction.CheckTx(ctx)
+	if found {
+		queries = queries.WithTx(tx)
+	}
+
+	params := sqlc.UpsertTotalsParams{
+		Sku:        make([]int32, 0, len(changes)),
+		TotalCount: make([]int32, 0, len(changes)),
+	}
+	for _, change := range changes {
+		params.Sku = append(params.Sku, int32(change.SKU))
+		params.TotalCount = append(params.TotalCount, int32(change.NewTotal))
+	}
+
+	start := time.Now()
+	err = queries.UpsertTotals(ctx, params)
+	duration := time.Since(start).Seconds()
+	metric.RecordDBMetric("insert", err, duration)
+
+	if isCheckViolation(err) {
+		return repository.ErrReservationDrift
+	}
+
+	return err
+}
@@ -0,0 +1,86 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *Service) StartExpiredOrdersCanceller(ctx context.Context) {
+	ticker := time.NewTicker(s.expiredCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.cancelExpiredOrders(ctx)
+		}
+	}
+}
+
+// cancelExpiredOrders cancels expired orders one per transaction until none is left.
+// An order that failed to be cancelled is skipped until the next tick, so it does not
+// hold back the orders after it.
+func (s *Service) cancelExpiredOrders(ctx context.Context) {
+	var failed []int64
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		default:
+		}
+
+		orderID, err := s.cancelNextExpiredOrder(ctx, failed)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoElements) {
+				return
+			}
+
+			slog.Error("Error cancelling expired order", "orderID", orderID, "error", err)
+
+			if orderID == 0 {
+				return
+			}
+			failed = append(failed, orderID)
+		}
+	}
+}
+
+// cancelNextExpiredOrder cancels the oldest expired order that is not in skip. It returns
+// the id of the order it tried to cancel, zero when no order was fetched.
+func (s *Service) cancelNextExpiredOrder(ctx context.Context, skip []int64) (orderID int64, err error) {
+	tr := otel.Tracer("orderService")
+	ctx, span := tr.Start(ctx, "CancelExpiredOrder")
+	defer func() {
+		if errors.Is(err, repository.ErrNoElements) {
+			span.End()
+			return
+		}
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var errTx error
+		orderID, errTx = s.orderRepository.FetchNextExpiredOrderID(ctx, s.paymentTimeout, skip)
+		if errTx != nil {
+			return errTx
+		}
+
+		span.SetAttributes(attribute.Int64("orderID", orderID))
+
+		return s.orderCancel(ctx, orderID)
+	})
+
+	return orderID, err
+}
@@ -0,0 +1,213 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/order/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceCancelNextExpiredOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	orderRepositoryMock := mock.NewRepositoryMock(mc)
+	stockServiceMock := mock.NewStockServiceMock(mc)
+	statusOutboxRepositoryMock := mock.NewStatusOutboxRepositoryMock(mc)
+	txManagerMock := mock.NewTxManagerMock(mc)
+
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+
+	paymentTimeout := 10 * time.Minute
+
+	s := &Service{
+		orderRepository:        orderRepositoryMock,
+		stockService:           stockServiceMock,
+		statusOutboxRepository: statusOutboxRepositoryMock,
+		txManager:              txManagerMock,
+		paymentTimeout:         paymentTimeout,
+	}
+
+	tests := []struct {
+		name              string
+		mockFetchFunc     func()
+		mockOrderFunc     func()
+		mockStockFunc     func()
+		mockSetStatusFunc func()
+		mockStatusOutbox  func()
+		expectedOrderID   int64
+		expectedError     error
+	}{
+		{
+			name: "no expired orders",
+			mockFetchFunc: func() {
+				orderRepositoryMock.FetchNextExpiredOrderIDMock.Return(0, repository.ErrNoElements)
+			},
+			expectedError: repository.ErrNoElements,
+		},
+		{
+			name: "fetch error",
+			mockFetchFunc: func() {
+				orderRepositoryMock.FetchNextExpiredOrderIDMock.Return(0, errors.New("db error"))
+			},
+			expectedError: errors.New("db error"),
+		},
+		{
+			name: "expired order cancelled",
+			mockFetchFunc: func() {
+				orderRepositoryMock.FetchNextExpiredOrderIDMock.Return(1, nil)
+			},
+			mockOrderFunc: func() {
+				orderRepositoryMock.GetStatusForUpdateMock.Return(ordermodels.OrderStatusAwaitingPayment, nil)
+			},
+			mockStockFunc: func() {
+				stockServiceMock.ReserveCancelMock.Expect(minimock.AnyContext, 1).Return(nil)
+			},
+			mockSetStatusFunc: func() {
+				orderRepositoryMock.SetStatusMock.Set(func(_ context.Context, orderID int64, status ordermodels.Status) error {
+					assert.Equal(t, int64(1), orderID)
+					assert.Equal(t, ordermodels.OrderStatusCancelled, status)
+					return nil
+				})
+			},
+			mockStatusOutbox: func() {
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.Set(
+					func(_ context.Context, orderID int64, status ordermodels.Status) error {
+						assert.Equal(t, int64(1), orderID)
+						assert.Equal(t, ordermodels.OrderStatusCancelled, status)
+						return nil
+					},
+				)
+			},
+			expectedOrderID: 1,
+			expectedError:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFetchFunc()
+			if tt.mockOrderFunc != nil {
+				tt.mockOrderFunc()
+			}
+			if tt.mockStockFunc != nil {
+				tt.mockStockFunc()
+			}
+			if tt.mockSetStatusFunc != nil {
+				tt.mockSetStatusFunc()
+			}
+			if tt.mockStatusOutbox != nil {
+				tt.mockStatusOutbox()
+			}
+
+			orderID, err := s.cancelNextExpiredOrder(context.Background(), nil)
+			assert.Equal(t, tt.expectedOrderID, orderID)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceCancelExpiredOrders(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	orderRepositoryMock := mock.NewRepositoryMock(mc)
+	stockServiceMock := mock.NewStockServiceMock(mc)
+	statusOutboxRepositoryMock := mock.NewStatusOutboxRepositoryMock(mc)
+	txManagerMock := mock.NewTxManagerMock(mc)
+
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+
+	s := &Service{
+		orderRepository:        orderRepositoryMock,
+		stockService:           stockServiceMock,
+		statusOutboxRepository: statusOutboxRepositoryMock,
+		txManager:              txManagerMock,
+		paymentTimeout:         time.Minute,
+		stopChan:               make(chan struct{}),
+	}
+
+	expired := []int64{1, 2, 3}
+	orderRepositoryMock.FetchNextExpiredOrderIDMock.Set(func(_ context.Context, _ time.Duration, _ []int64) (int64, error) {
+		if len(expired) == 0 {
+			return 0, repository.ErrNoElements
+		}
+		orderID := expired[0]
+		expired = expired[1:]
+		return orderID, nil
+	})
+	orderRepositoryMock.GetStatusForUpdateMock.Return(ordermodels.OrderStatusAwaitingPayment, nil)
+	stockServiceMock.ReserveCancelMock.Return(nil)
+	orderRepositoryMock.SetStatusMock.Return(nil)
+	statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.Return(nil)
+
+	s.cancelExpiredOrders(context.Background())
+
+	assert.Empty(t, expired)
+	assert.Equal(t, uint64(3), orderRepositoryMock.SetStatusAfterCounter())
+	assert.Equal(t, uint64(3), statusOutboxRepositoryMock.CreateOrderStatusChangedEventAfterCounter())
+}
+
+func TestServiceCancelExpiredOrdersSkipsFailedOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	orderRepositoryMock := mock.NewRepositoryMock(mc)
+	stockServiceMock := mock.NewStockServiceMock(mc)
+	statusOutboxRepositoryMock := mock.NewStatusOutboxRepositoryMock(mc)
+	txManagerMock := mock.NewTxManagerMock(mc)
+
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+
+	s := &Service{
+		orderRepository:        orderRepositoryMock,
+		stockService:           stockServiceMock,
+		statusOutboxRepository: statusOutboxRepositoryMock,
+		txManager:              txManagerMock,
+		paymentTimeout:         time.Minute,
+		stopChan:               make(chan struct{}),
+	}
+
+	// Order 1 is the oldest and can not be cancelled, orders 2 and 3 must be cancelled anyway.
+	expired := []int64{1, 2, 3}
+	cancelled := make(map[int64]bool)
+	orderRepositoryMock.FetchNextExpiredOrderIDMock.Set(func(_ context.Context, _ time.Duration, skip []int64) (int64, error) {
+		for _, orderID := range expired {
+			if !cancelled[orderID] && !slices.Contains(skip, orderID) {
+				return orderID, nil
+			}
+		}
+		return 0, repository.ErrNoElements
+	})
+	orderRepositoryMock.GetStatusForUpdateMock.Return(ordermodels.OrderStatusAwaitingPayment, nil)
+	stockServiceMock.ReserveCancelMock.Set(func(_ context.Context, orderID int64) error {
+		if orderID == 1 {
+			return errors.New("reserve cancel failed")
+		}
+		return nil
+	})
+	orderRepositoryMock.SetStatusMock.Set(func(_ context.Context, orderID int64, _ ordermodels.Status) error {
+		cancelled[orderID] = true
+		return nil
+	})
+	statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.Return(nil)
+
+	s.cancelExpiredOrders(context.Background())
+
+	assert.Equal(t, map[int64]bool{2: true, 3: true}, cancelled)
+	assert.Equal(t, uint64(4), orderRepositoryMock.FetchNextExpiredOrderIDAfterCounter())
+}
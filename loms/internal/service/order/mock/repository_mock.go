@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
@@ -25,12 +26,36 @@ type RepositoryMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mRepositoryMockCreate
 
+	funcFetchNextExpiredOrderID          func(ctx context.Context, paymentTimeout time.Duration, skip []int64) (orderID int64, err error)
+	inspectFuncFetchNextExpiredOrderID   func(ctx context.Context, paymentTimeout time.Duration, skip []int64)
+	afterFetchNextExpiredOrderIDCounter  uint64
+	beforeFetchNextExpiredOrderIDCounter uint64
+	FetchNextExpiredOrderIDMock          mRepositoryMockFetchNextExpiredOrderID
+
 	funcGetByID          func(ctx context.Context, orderID int64) (order ordermodels.Order, err error)
 	inspectFuncGetByID   func(ctx context.Context, orderID int64)
 	afterGetByIDCounter  uint64
 	beforeGetByIDCounter uint64
 	GetByIDMock          mRepositoryMockGetByID
 
+	funcGetByIdempotencyKey          func(ctx context.Context, userID int64, key string) (orderID int64, status ordermodels.Status, err error)
+	inspectFuncGetByIdempotencyKey   func(ctx context.Context, userID int64, key string)
+	afterGetByIdempotencyKeyCounter  uint64
+	beforeGetByIdempotencyKeyCounter uint64
+	GetByIdempotencyKeyMock          mRepositoryMockGetByIdempotencyKey
+
+	funcGetStatusForUpdate          func(ctx context.Context, orderID int64) (status ordermodels.Status, err error)
+	inspectFuncGetStatusForUpdate   func(ctx context.Context, orderID int64)
+	afterGetStatusForUpdateCounter  uint64
+	beforeGetStatusForUpdateCounter uint64
+	GetStatusForUpdateMock          mRepositoryMockGetStatusForUpdate
+
+	funcListByUser          func(ctx context.Context, filter ordermodels.ListFilter) (orders []ordermodels.Order, err error)
+	inspectFuncListByUser   func(ctx context.Context, filter ordermodels.ListFilter)
+	afterListByUserCounter  uint64
+	beforeListByUserCounter uint64
+	ListByUserMock          mRepositoryMockListByUser
+
 	funcSetStatus          func(ctx context.Context, orderID int64, status ordermodels.Status) (err error)
 	inspectFuncSetStatus   func(ctx context.Context, orderID int64, status ordermodels.Status)
 	afterSetStatusCounter  uint64
@@ -49,9 +74,21 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.CreateMock = mRepositoryMockCreate{mock: m}
 	m.CreateMock.callArgs = []*RepositoryMockCreateParams{}
 
+	m.FetchNextExpiredOrderIDMock = mRepositoryMockFetchNextExpiredOrderID{mock: m}
+	m.FetchNextExpiredOrderIDMock.callArgs = []*RepositoryMockFetchNextExpiredOrderIDParams{}
+
 	m.GetByIDMock = mRepositoryMockGetByID{mock: m}
 	m.GetByIDMock.callArgs = []*RepositoryMockGetByIDParams{}
 
+	m.GetByIdempotencyKeyMock = mRepositoryMockGetByIdempotencyKey{mock: m}
+	m.GetByIdempotencyKeyMock.callArgs = []*RepositoryMockGetByIdempotencyKeyParams{}
+
+	m.GetStatusForUpdateMock = mRepositoryMockGetStatusForUpdate{mock: m}
+	m.GetStatusForUpdateMock.callArgs = []*RepositoryMockGetStatusForUpdateParams{}
+
+	m.ListByUserMock = mRepositoryMockListByUser{mock: m}
+	m.ListByUserMock.callArgs = []*RepositoryMockListByUserParams{}
+
 	m.SetStatusMock = mRepositoryMockSetStatus{mock: m}
 	m.SetStatusMock.callArgs = []*RepositoryMockSetStatusParams{}
 
@@ -381,6 +418,355 @@ func (m *RepositoryMock) MinimockCreateInspect() {
 	}
 }
 
+type mRepositoryMockFetchNextExpiredOrderID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockFetchNextExpiredOrderIDExpectation
+	expectations       []*RepositoryMockFetchNextExpiredOrderIDExpectation
+
+	callArgs []*RepositoryMockFetchNextExpiredOrderIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockFetchNextExpiredOrderIDExpectation specifies expectation struct of the Repository.FetchNextExpiredOrderID
+type RepositoryMockFetchNextExpiredOrderIDExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockFetchNextExpiredOrderIDParams
+	paramPtrs *RepositoryMockFetchNextExpiredOrderIDParamPtrs
+	results   *RepositoryMockFetchNextExpiredOrderIDResults
+	Counter   uint64
+}
+
+// RepositoryMockFetchNextExpiredOrderIDParams contains parameters of the Repository.FetchNextExpiredOrderID
+type RepositoryMockFetchNextExpiredOrderIDParams struct {
+	ctx            context.Context
+	paymentTimeout time.Duration
+	skip           []int64
+}
+
+// RepositoryMockFetchNextExpiredOrderIDParamPtrs contains pointers to parameters of the Repository.FetchNextExpiredOrderID
+type RepositoryMockFetchNextExpiredOrderIDParamPtrs struct {
+	ctx            *context.Context
+	paymentTimeout *time.Duration
+	skip           *[]int64
+}
+
+// RepositoryMockFetchNextExpiredOrderIDResults contains results of the Repository.FetchNextExpiredOrderID
+type RepositoryMockFetchNextExpiredOrderIDResults struct {
+	orderID int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Optional() *mRepositoryMockFetchNextExpiredOrderID {
+	mmFetchNextExpiredOrderID.optional = true
+	return mmFetchNextExpiredOrderID
+}
+
+// Expect sets up expected params for Repository.FetchNextExpiredOrderID
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Expect(ctx context.Context, paymentTimeout time.Duration, skip []int64) *mRepositoryMockFetchNextExpiredOrderID {
+	if mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Set")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation = &RepositoryMockFetchNextExpiredOrderIDExpectation{}
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by ExpectParams functions")
+	}
+
+	mmFetchNextExpiredOrderID.defaultExpectation.params = &RepositoryMockFetchNextExpiredOrderIDParams{ctx, paymentTimeout, skip}
+	for _, e := range mmFetchNextExpiredOrderID.expectations {
+		if minimock.Equal(e.params, mmFetchNextExpiredOrderID.defaultExpectation.params) {
+			mmFetchNextExpiredOrderID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFetchNextExpiredOrderID.defaultExpectation.params)
+		}
+	}
+
+	return mmFetchNextExpiredOrderID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.FetchNextExpiredOrderID
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockFetchNextExpiredOrderID {
+	if mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Set")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation = &RepositoryMockFetchNextExpiredOrderIDExpectation{}
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.params != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Expect")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs = &RepositoryMockFetchNextExpiredOrderIDParamPtrs{}
+	}
+	mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFetchNextExpiredOrderID
+}
+
+// ExpectPaymentTimeoutParam2 sets up expected param paymentTimeout for Repository.FetchNextExpiredOrderID
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) ExpectPaymentTimeoutParam2(paymentTimeout time.Duration) *mRepositoryMockFetchNextExpiredOrderID {
+	if mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Set")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation = &RepositoryMockFetchNextExpiredOrderIDExpectation{}
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.params != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Expect")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs = &RepositoryMockFetchNextExpiredOrderIDParamPtrs{}
+	}
+	mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs.paymentTimeout = &paymentTimeout
+
+	return mmFetchNextExpiredOrderID
+}
+
+// ExpectSkipParam3 sets up expected param skip for Repository.FetchNextExpiredOrderID
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) ExpectSkipParam3(skip []int64) *mRepositoryMockFetchNextExpiredOrderID {
+	if mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Set")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation = &RepositoryMockFetchNextExpiredOrderIDExpectation{}
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.params != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Expect")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs = &RepositoryMockFetchNextExpiredOrderIDParamPtrs{}
+	}
+	mmFetchNextExpiredOrderID.defaultExpectation.paramPtrs.skip = &skip
+
+	return mmFetchNextExpiredOrderID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.FetchNextExpiredOrderID
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Inspect(f func(ctx context.Context, paymentTimeout time.Duration, skip []int64)) *mRepositoryMockFetchNextExpiredOrderID {
+	if mmFetchNextExpiredOrderID.mock.inspectFuncFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.FetchNextExpiredOrderID")
+	}
+
+	mmFetchNextExpiredOrderID.mock.inspectFuncFetchNextExpiredOrderID = f
+
+	return mmFetchNextExpiredOrderID
+}
+
+// Return sets up results that will be returned by Repository.FetchNextExpiredOrderID
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Return(orderID int64, err error) *RepositoryMock {
+	if mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Set")
+	}
+
+	if mmFetchNextExpiredOrderID.defaultExpectation == nil {
+		mmFetchNextExpiredOrderID.defaultExpectation = &RepositoryMockFetchNextExpiredOrderIDExpectation{mock: mmFetchNextExpiredOrderID.mock}
+	}
+	mmFetchNextExpiredOrderID.defaultExpectation.results = &RepositoryMockFetchNextExpiredOrderIDResults{orderID, err}
+	return mmFetchNextExpiredOrderID.mock
+}
+
+// Set uses given function f to mock the Repository.FetchNextExpiredOrderID method
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Set(f func(ctx context.Context, paymentTimeout time.Duration, skip []int64) (orderID int64, err error)) *RepositoryMock {
+	if mmFetchNextExpiredOrderID.defaultExpectation != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("Default expectation is already set for the Repository.FetchNextExpiredOrderID method")
+	}
+
+	if len(mmFetchNextExpiredOrderID.expectations) > 0 {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("Some expectations are already set for the Repository.FetchNextExpiredOrderID method")
+	}
+
+	mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID = f
+	return mmFetchNextExpiredOrderID.mock
+}
+
+// When sets expectation for the Repository.FetchNextExpiredOrderID which will trigger the result defined by the following
+// Then helper
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) When(ctx context.Context, paymentTimeout time.Duration, skip []int64) *RepositoryMockFetchNextExpiredOrderIDExpectation {
+	if mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("RepositoryMock.FetchNextExpiredOrderID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockFetchNextExpiredOrderIDExpectation{
+		mock:   mmFetchNextExpiredOrderID.mock,
+		params: &RepositoryMockFetchNextExpiredOrderIDParams{ctx, paymentTimeout, skip},
+	}
+	mmFetchNextExpiredOrderID.expectations = append(mmFetchNextExpiredOrderID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.FetchNextExpiredOrderID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockFetchNextExpiredOrderIDExpectation) Then(orderID int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockFetchNextExpiredOrderIDResults{orderID, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.FetchNextExpiredOrderID should be invoked
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Times(n uint64) *mRepositoryMockFetchNextExpiredOrderID {
+	if n == 0 {
+		mmFetchNextExpiredOrderID.mock.t.Fatalf("Times of RepositoryMock.FetchNextExpiredOrderID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFetchNextExpiredOrderID.expectedInvocations, n)
+	return mmFetchNextExpiredOrderID
+}
+
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) invocationsDone() bool {
+	if len(mmFetchNextExpiredOrderID.expectations) == 0 && mmFetchNextExpiredOrderID.defaultExpectation == nil && mmFetchNextExpiredOrderID.mock.funcFetchNextExpiredOrderID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFetchNextExpiredOrderID.mock.afterFetchNextExpiredOrderIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFetchNextExpiredOrderID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FetchNextExpiredOrderID implements order.Repository
+func (mmFetchNextExpiredOrderID *RepositoryMock) FetchNextExpiredOrderID(ctx context.Context, paymentTimeout time.Duration, skip []int64) (orderID int64, err error) {
+	mm_atomic.AddUint64(&mmFetchNextExpiredOrderID.beforeFetchNextExpiredOrderIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFetchNextExpiredOrderID.afterFetchNextExpiredOrderIDCounter, 1)
+
+	if mmFetchNextExpiredOrderID.inspectFuncFetchNextExpiredOrderID != nil {
+		mmFetchNextExpiredOrderID.inspectFuncFetchNextExpiredOrderID(ctx, paymentTimeout, skip)
+	}
+
+	mm_params := RepositoryMockFetchNextExpiredOrderIDParams{ctx, paymentTimeout, skip}
+
+	// Record call args
+	mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.mutex.Lock()
+	mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.callArgs = append(mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.callArgs, &mm_params)
+	mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.mutex.Unlock()
+
+	for _, e := range mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.orderID, e.results.err
+		}
+	}
+
+	if mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockFetchNextExpiredOrderIDParams{ctx, paymentTimeout, skip}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFetchNextExpiredOrderID.t.Errorf("RepositoryMock.FetchNextExpiredOrderID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.paymentTimeout != nil && !minimock.Equal(*mm_want_ptrs.paymentTimeout, mm_got.paymentTimeout) {
+				mmFetchNextExpiredOrderID.t.Errorf("RepositoryMock.FetchNextExpiredOrderID got unexpected parameter paymentTimeout, want: %#v, got: %#v%s\n", *mm_want_ptrs.paymentTimeout, mm_got.paymentTimeout, minimock.Diff(*mm_want_ptrs.paymentTimeout, mm_got.paymentTimeout))
+			}
+
+			if mm_want_ptrs.skip != nil && !minimock.Equal(*mm_want_ptrs.skip, mm_got.skip) {
+				mmFetchNextExpiredOrderID.t.Errorf("RepositoryMock.FetchNextExpiredOrderID got unexpected parameter skip, want: %#v, got: %#v%s\n", *mm_want_ptrs.skip, mm_got.skip, minimock.Diff(*mm_want_ptrs.skip, mm_got.skip))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFetchNextExpiredOrderID.t.Errorf("RepositoryMock.FetchNextExpiredOrderID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFetchNextExpiredOrderID.FetchNextExpiredOrderIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFetchNextExpiredOrderID.t.Fatal("No results are set for the RepositoryMock.FetchNextExpiredOrderID")
+		}
+		return (*mm_results).orderID, (*mm_results).err
+	}
+	if mmFetchNextExpiredOrderID.funcFetchNextExpiredOrderID != nil {
+		return mmFetchNextExpiredOrderID.funcFetchNextExpiredOrderID(ctx, paymentTimeout, skip)
+	}
+	mmFetchNextExpiredOrderID.t.Fatalf("Unexpected call to RepositoryMock.FetchNextExpiredOrderID. %v %v %v", ctx, paymentTimeout, skip)
+	return
+}
+
+// FetchNextExpiredOrderIDAfterCounter returns a count of finished RepositoryMock.FetchNextExpiredOrderID invocations
+func (mmFetchNextExpiredOrderID *RepositoryMock) FetchNextExpiredOrderIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFetchNextExpiredOrderID.afterFetchNextExpiredOrderIDCounter)
+}
+
+// FetchNextExpiredOrderIDBeforeCounter returns a count of RepositoryMock.FetchNextExpiredOrderID invocations
+func (mmFetchNextExpiredOrderID *RepositoryMock) FetchNextExpiredOrderIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFetchNextExpiredOrderID.beforeFetchNextExpiredOrderIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.FetchNextExpiredOrderID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFetchNextExpiredOrderID *mRepositoryMockFetchNextExpiredOrderID) Calls() []*RepositoryMockFetchNextExpiredOrderIDParams {
+	mmFetchNextExpiredOrderID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockFetchNextExpiredOrderIDParams, len(mmFetchNextExpiredOrderID.callArgs))
+	copy(argCopy, mmFetchNextExpiredOrderID.callArgs)
+
+	mmFetchNextExpiredOrderID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFetchNextExpiredOrderIDDone returns true if the count of the FetchNextExpiredOrderID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockFetchNextExpiredOrderIDDone() bool {
+	if m.FetchNextExpiredOrderIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FetchNextExpiredOrderIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FetchNextExpiredOrderIDMock.invocationsDone()
+}
+
+// MinimockFetchNextExpiredOrderIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockFetchNextExpiredOrderIDInspect() {
+	for _, e := range m.FetchNextExpiredOrderIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.FetchNextExpiredOrderID with params: %#v", *e.params)
+		}
+	}
+
+	afterFetchNextExpiredOrderIDCounter := mm_atomic.LoadUint64(&m.afterFetchNextExpiredOrderIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FetchNextExpiredOrderIDMock.defaultExpectation != nil && afterFetchNextExpiredOrderIDCounter < 1 {
+		if m.FetchNextExpiredOrderIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.FetchNextExpiredOrderID")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.FetchNextExpiredOrderID with params: %#v", *m.FetchNextExpiredOrderIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFetchNextExpiredOrderID != nil && afterFetchNextExpiredOrderIDCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.FetchNextExpiredOrderID")
+	}
+
+	if !m.FetchNextExpiredOrderIDMock.invocationsDone() && afterFetchNextExpiredOrderIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.FetchNextExpiredOrderID but found %d calls",
+			mm_atomic.LoadUint64(&m.FetchNextExpiredOrderIDMock.expectedInvocations), afterFetchNextExpiredOrderIDCounter)
+	}
+}
+
 type mRepositoryMockGetByID struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -702,44 +1088,46 @@ func (m *RepositoryMock) MinimockGetByIDInspect() {
 	}
 }
 
-type mRepositoryMockSetStatus struct {
+type mRepositoryMockGetByIdempotencyKey struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockSetStatusExpectation
-	expectations       []*RepositoryMockSetStatusExpectation
+	defaultExpectation *RepositoryMockGetByIdempotencyKeyExpectation
+	expectations       []*RepositoryMockGetByIdempotencyKeyExpectation
 
-	callArgs []*RepositoryMockSetStatusParams
+	callArgs []*RepositoryMockGetByIdempotencyKeyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations uint64
 }
 
-// RepositoryMockSetStatusExpectation specifies expectation struct of the Repository.SetStatus
-type RepositoryMockSetStatusExpectation struct {
+// RepositoryMockGetByIdempotencyKeyExpectation specifies expectation struct of the Repository.GetByIdempotencyKey
+type RepositoryMockGetByIdempotencyKeyExpectation struct {
 	mock      *RepositoryMock
-	params    *RepositoryMockSetStatusParams
-	paramPtrs *RepositoryMockSetStatusParamPtrs
-	results   *RepositoryMockSetStatusResults
+	params    *RepositoryMockGetByIdempotencyKeyParams
+	paramPtrs *RepositoryMockGetByIdempotencyKeyParamPtrs
+	results   *RepositoryMockGetByIdempotencyKeyResults
 	Counter   uint64
 }
 
-// RepositoryMockSetStatusParams contains parameters of the Repository.SetStatus
-type RepositoryMockSetStatusParams struct {
-	ctx     context.Context
-	orderID int64
-	status  ordermodels.Status
+// RepositoryMockGetByIdempotencyKeyParams contains parameters of the Repository.GetByIdempotencyKey
+type RepositoryMockGetByIdempotencyKeyParams struct {
+	ctx    context.Context
+	userID int64
+	key    string
 }
 
-// RepositoryMockSetStatusParamPtrs contains pointers to parameters of the Repository.SetStatus
-type RepositoryMockSetStatusParamPtrs struct {
-	ctx     *context.Context
-	orderID *int64
-	status  *ordermodels.Status
+// RepositoryMockGetByIdempotencyKeyParamPtrs contains pointers to parameters of the Repository.GetByIdempotencyKey
+type RepositoryMockGetByIdempotencyKeyParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	key    *string
 }
 
-// RepositoryMockSetStatusResults contains results of the Repository.SetStatus
-type RepositoryMockSetStatusResults struct {
-	err error
+// RepositoryMockGetByIdempotencyKeyResults contains results of the Repository.GetByIdempotencyKey
+type RepositoryMockGetByIdempotencyKeyResults struct {
+	orderID int64
+	status  ordermodels.Status
+	err     error
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -747,90 +1135,1080 @@ type RepositoryMockSetStatusResults struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSetStatus *mRepositoryMockSetStatus) Optional() *mRepositoryMockSetStatus {
-	mmSetStatus.optional = true
-	return mmSetStatus
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Optional() *mRepositoryMockGetByIdempotencyKey {
+	mmGetByIdempotencyKey.optional = true
+	return mmGetByIdempotencyKey
 }
 
-// Expect sets up expected params for Repository.SetStatus
-func (mmSetStatus *mRepositoryMockSetStatus) Expect(ctx context.Context, orderID int64, status ordermodels.Status) *mRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+// Expect sets up expected params for Repository.GetByIdempotencyKey
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Expect(ctx context.Context, userID int64, key string) *mRepositoryMockGetByIdempotencyKey {
+	if mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	if mmGetByIdempotencyKey.defaultExpectation == nil {
+		mmGetByIdempotencyKey.defaultExpectation = &RepositoryMockGetByIdempotencyKeyExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.paramPtrs != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by ExpectParams functions")
+	if mmGetByIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by ExpectParams functions")
 	}
 
-	mmSetStatus.defaultExpectation.params = &RepositoryMockSetStatusParams{ctx, orderID, status}
-	for _, e := range mmSetStatus.expectations {
-		if minimock.Equal(e.params, mmSetStatus.defaultExpectation.params) {
-			mmSetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStatus.defaultExpectation.params)
+	mmGetByIdempotencyKey.defaultExpectation.params = &RepositoryMockGetByIdempotencyKeyParams{ctx, userID, key}
+	for _, e := range mmGetByIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmGetByIdempotencyKey.defaultExpectation.params) {
+			mmGetByIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByIdempotencyKey.defaultExpectation.params)
 		}
 	}
 
-	return mmSetStatus
+	return mmGetByIdempotencyKey
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.SetStatus
-func (mmSetStatus *mRepositoryMockSetStatus) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetByIdempotencyKey
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetByIdempotencyKey {
+	if mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	if mmGetByIdempotencyKey.defaultExpectation == nil {
+		mmGetByIdempotencyKey.defaultExpectation = &RepositoryMockGetByIdempotencyKeyExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.params != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Expect")
+	if mmGetByIdempotencyKey.defaultExpectation.params != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Expect")
 	}
 
-	if mmSetStatus.defaultExpectation.paramPtrs == nil {
-		mmSetStatus.defaultExpectation.paramPtrs = &RepositoryMockSetStatusParamPtrs{}
+	if mmGetByIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmGetByIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockGetByIdempotencyKeyParamPtrs{}
 	}
-	mmSetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
 
-	return mmSetStatus
+	return mmGetByIdempotencyKey
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for Repository.SetStatus
-func (mmSetStatus *mRepositoryMockSetStatus) ExpectOrderIDParam2(orderID int64) *mRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetByIdempotencyKey
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) ExpectUserIDParam2(userID int64) *mRepositoryMockGetByIdempotencyKey {
+	if mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	if mmGetByIdempotencyKey.defaultExpectation == nil {
+		mmGetByIdempotencyKey.defaultExpectation = &RepositoryMockGetByIdempotencyKeyExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.params != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Expect")
+	if mmGetByIdempotencyKey.defaultExpectation.params != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Expect")
 	}
 
-	if mmSetStatus.defaultExpectation.paramPtrs == nil {
-		mmSetStatus.defaultExpectation.paramPtrs = &RepositoryMockSetStatusParamPtrs{}
+	if mmGetByIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmGetByIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockGetByIdempotencyKeyParamPtrs{}
 	}
-	mmSetStatus.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetByIdempotencyKey.defaultExpectation.paramPtrs.userID = &userID
 
-	return mmSetStatus
+	return mmGetByIdempotencyKey
 }
 
-// ExpectStatusParam3 sets up expected param status for Repository.SetStatus
-func (mmSetStatus *mRepositoryMockSetStatus) ExpectStatusParam3(status ordermodels.Status) *mRepositoryMockSetStatus {
-	if mmSetStatus.mock.funcSetStatus != nil {
-		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+// ExpectKeyParam3 sets up expected param key for Repository.GetByIdempotencyKey
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) ExpectKeyParam3(key string) *mRepositoryMockGetByIdempotencyKey {
+	if mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Set")
 	}
 
-	if mmSetStatus.defaultExpectation == nil {
-		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	if mmGetByIdempotencyKey.defaultExpectation == nil {
+		mmGetByIdempotencyKey.defaultExpectation = &RepositoryMockGetByIdempotencyKeyExpectation{}
 	}
 
-	if mmSetStatus.defaultExpectation.params != nil {
+	if mmGetByIdempotencyKey.defaultExpectation.params != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmGetByIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmGetByIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockGetByIdempotencyKeyParamPtrs{}
+	}
+	mmGetByIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+
+	return mmGetByIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetByIdempotencyKey
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Inspect(f func(ctx context.Context, userID int64, key string)) *mRepositoryMockGetByIdempotencyKey {
+	if mmGetByIdempotencyKey.mock.inspectFuncGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetByIdempotencyKey")
+	}
+
+	mmGetByIdempotencyKey.mock.inspectFuncGetByIdempotencyKey = f
+
+	return mmGetByIdempotencyKey
+}
+
+// Return sets up results that will be returned by Repository.GetByIdempotencyKey
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Return(orderID int64, status ordermodels.Status, err error) *RepositoryMock {
+	if mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Set")
+	}
+
+	if mmGetByIdempotencyKey.defaultExpectation == nil {
+		mmGetByIdempotencyKey.defaultExpectation = &RepositoryMockGetByIdempotencyKeyExpectation{mock: mmGetByIdempotencyKey.mock}
+	}
+	mmGetByIdempotencyKey.defaultExpectation.results = &RepositoryMockGetByIdempotencyKeyResults{orderID, status, err}
+	return mmGetByIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the Repository.GetByIdempotencyKey method
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Set(f func(ctx context.Context, userID int64, key string) (orderID int64, status ordermodels.Status, err error)) *RepositoryMock {
+	if mmGetByIdempotencyKey.defaultExpectation != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Repository.GetByIdempotencyKey method")
+	}
+
+	if len(mmGetByIdempotencyKey.expectations) > 0 {
+		mmGetByIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Repository.GetByIdempotencyKey method")
+	}
+
+	mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey = f
+	return mmGetByIdempotencyKey.mock
+}
+
+// When sets expectation for the Repository.GetByIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) When(ctx context.Context, userID int64, key string) *RepositoryMockGetByIdempotencyKeyExpectation {
+	if mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.mock.t.Fatalf("RepositoryMock.GetByIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetByIdempotencyKeyExpectation{
+		mock:   mmGetByIdempotencyKey.mock,
+		params: &RepositoryMockGetByIdempotencyKeyParams{ctx, userID, key},
+	}
+	mmGetByIdempotencyKey.expectations = append(mmGetByIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetByIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetByIdempotencyKeyExpectation) Then(orderID int64, status ordermodels.Status, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetByIdempotencyKeyResults{orderID, status, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetByIdempotencyKey should be invoked
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Times(n uint64) *mRepositoryMockGetByIdempotencyKey {
+	if n == 0 {
+		mmGetByIdempotencyKey.mock.t.Fatalf("Times of RepositoryMock.GetByIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetByIdempotencyKey.expectedInvocations, n)
+	return mmGetByIdempotencyKey
+}
+
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) invocationsDone() bool {
+	if len(mmGetByIdempotencyKey.expectations) == 0 && mmGetByIdempotencyKey.defaultExpectation == nil && mmGetByIdempotencyKey.mock.funcGetByIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByIdempotencyKey.mock.afterGetByIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetByIdempotencyKey implements order.Repository
+func (mmGetByIdempotencyKey *RepositoryMock) GetByIdempotencyKey(ctx context.Context, userID int64, key string) (orderID int64, status ordermodels.Status, err error) {
+	mm_atomic.AddUint64(&mmGetByIdempotencyKey.beforeGetByIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByIdempotencyKey.afterGetByIdempotencyKeyCounter, 1)
+
+	if mmGetByIdempotencyKey.inspectFuncGetByIdempotencyKey != nil {
+		mmGetByIdempotencyKey.inspectFuncGetByIdempotencyKey(ctx, userID, key)
+	}
+
+	mm_params := RepositoryMockGetByIdempotencyKeyParams{ctx, userID, key}
+
+	// Record call args
+	mmGetByIdempotencyKey.GetByIdempotencyKeyMock.mutex.Lock()
+	mmGetByIdempotencyKey.GetByIdempotencyKeyMock.callArgs = append(mmGetByIdempotencyKey.GetByIdempotencyKeyMock.callArgs, &mm_params)
+	mmGetByIdempotencyKey.GetByIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmGetByIdempotencyKey.GetByIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.orderID, e.results.status, e.results.err
+		}
+	}
+
+	if mmGetByIdempotencyKey.GetByIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByIdempotencyKey.GetByIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByIdempotencyKey.GetByIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByIdempotencyKey.GetByIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetByIdempotencyKeyParams{ctx, userID, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetByIdempotencyKey.t.Errorf("RepositoryMock.GetByIdempotencyKey got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetByIdempotencyKey.t.Errorf("RepositoryMock.GetByIdempotencyKey got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGetByIdempotencyKey.t.Errorf("RepositoryMock.GetByIdempotencyKey got unexpected parameter key, want: %#v, got: %#v%s\n", *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetByIdempotencyKey.t.Errorf("RepositoryMock.GetByIdempotencyKey got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetByIdempotencyKey.GetByIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetByIdempotencyKey.t.Fatal("No results are set for the RepositoryMock.GetByIdempotencyKey")
+		}
+		return (*mm_results).orderID, (*mm_results).status, (*mm_results).err
+	}
+	if mmGetByIdempotencyKey.funcGetByIdempotencyKey != nil {
+		return mmGetByIdempotencyKey.funcGetByIdempotencyKey(ctx, userID, key)
+	}
+	mmGetByIdempotencyKey.t.Fatalf("Unexpected call to RepositoryMock.GetByIdempotencyKey. %v %v %v", ctx, userID, key)
+	return
+}
+
+// GetByIdempotencyKeyAfterCounter returns a count of finished RepositoryMock.GetByIdempotencyKey invocations
+func (mmGetByIdempotencyKey *RepositoryMock) GetByIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByIdempotencyKey.afterGetByIdempotencyKeyCounter)
+}
+
+// GetByIdempotencyKeyBeforeCounter returns a count of RepositoryMock.GetByIdempotencyKey invocations
+func (mmGetByIdempotencyKey *RepositoryMock) GetByIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByIdempotencyKey.beforeGetByIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetByIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetByIdempotencyKey *mRepositoryMockGetByIdempotencyKey) Calls() []*RepositoryMockGetByIdempotencyKeyParams {
+	mmGetByIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetByIdempotencyKeyParams, len(mmGetByIdempotencyKey.callArgs))
+	copy(argCopy, mmGetByIdempotencyKey.callArgs)
+
+	mmGetByIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetByIdempotencyKeyDone returns true if the count of the GetByIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetByIdempotencyKeyDone() bool {
+	if m.GetByIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetByIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetByIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockGetByIdempotencyKeyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetByIdempotencyKeyInspect() {
+	for _, e := range m.GetByIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetByIdempotencyKey with params: %#v", *e.params)
+		}
+	}
+
+	afterGetByIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterGetByIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetByIdempotencyKeyMock.defaultExpectation != nil && afterGetByIdempotencyKeyCounter < 1 {
+		if m.GetByIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetByIdempotencyKey")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetByIdempotencyKey with params: %#v", *m.GetByIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetByIdempotencyKey != nil && afterGetByIdempotencyKeyCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetByIdempotencyKey")
+	}
+
+	if !m.GetByIdempotencyKeyMock.invocationsDone() && afterGetByIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetByIdempotencyKey but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByIdempotencyKeyMock.expectedInvocations), afterGetByIdempotencyKeyCounter)
+	}
+}
+
+type mRepositoryMockGetStatusForUpdate struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetStatusForUpdateExpectation
+	expectations       []*RepositoryMockGetStatusForUpdateExpectation
+
+	callArgs []*RepositoryMockGetStatusForUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockGetStatusForUpdateExpectation specifies expectation struct of the Repository.GetStatusForUpdate
+type RepositoryMockGetStatusForUpdateExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockGetStatusForUpdateParams
+	paramPtrs *RepositoryMockGetStatusForUpdateParamPtrs
+	results   *RepositoryMockGetStatusForUpdateResults
+	Counter   uint64
+}
+
+// RepositoryMockGetStatusForUpdateParams contains parameters of the Repository.GetStatusForUpdate
+type RepositoryMockGetStatusForUpdateParams struct {
+	ctx     context.Context
+	orderID int64
+}
+
+// RepositoryMockGetStatusForUpdateParamPtrs contains pointers to parameters of the Repository.GetStatusForUpdate
+type RepositoryMockGetStatusForUpdateParamPtrs struct {
+	ctx     *context.Context
+	orderID *int64
+}
+
+// RepositoryMockGetStatusForUpdateResults contains results of the Repository.GetStatusForUpdate
+type RepositoryMockGetStatusForUpdateResults struct {
+	status ordermodels.Status
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Optional() *mRepositoryMockGetStatusForUpdate {
+	mmGetStatusForUpdate.optional = true
+	return mmGetStatusForUpdate
+}
+
+// Expect sets up expected params for Repository.GetStatusForUpdate
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Expect(ctx context.Context, orderID int64) *mRepositoryMockGetStatusForUpdate {
+	if mmGetStatusForUpdate.mock.funcGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Set")
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation == nil {
+		mmGetStatusForUpdate.defaultExpectation = &RepositoryMockGetStatusForUpdateExpectation{}
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation.paramPtrs != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by ExpectParams functions")
+	}
+
+	mmGetStatusForUpdate.defaultExpectation.params = &RepositoryMockGetStatusForUpdateParams{ctx, orderID}
+	for _, e := range mmGetStatusForUpdate.expectations {
+		if minimock.Equal(e.params, mmGetStatusForUpdate.defaultExpectation.params) {
+			mmGetStatusForUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStatusForUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStatusForUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetStatusForUpdate
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetStatusForUpdate {
+	if mmGetStatusForUpdate.mock.funcGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Set")
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation == nil {
+		mmGetStatusForUpdate.defaultExpectation = &RepositoryMockGetStatusForUpdateExpectation{}
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation.params != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Expect")
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation.paramPtrs == nil {
+		mmGetStatusForUpdate.defaultExpectation.paramPtrs = &RepositoryMockGetStatusForUpdateParamPtrs{}
+	}
+	mmGetStatusForUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetStatusForUpdate
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for Repository.GetStatusForUpdate
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) ExpectOrderIDParam2(orderID int64) *mRepositoryMockGetStatusForUpdate {
+	if mmGetStatusForUpdate.mock.funcGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Set")
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation == nil {
+		mmGetStatusForUpdate.defaultExpectation = &RepositoryMockGetStatusForUpdateExpectation{}
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation.params != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Expect")
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation.paramPtrs == nil {
+		mmGetStatusForUpdate.defaultExpectation.paramPtrs = &RepositoryMockGetStatusForUpdateParamPtrs{}
+	}
+	mmGetStatusForUpdate.defaultExpectation.paramPtrs.orderID = &orderID
+
+	return mmGetStatusForUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetStatusForUpdate
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Inspect(f func(ctx context.Context, orderID int64)) *mRepositoryMockGetStatusForUpdate {
+	if mmGetStatusForUpdate.mock.inspectFuncGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetStatusForUpdate")
+	}
+
+	mmGetStatusForUpdate.mock.inspectFuncGetStatusForUpdate = f
+
+	return mmGetStatusForUpdate
+}
+
+// Return sets up results that will be returned by Repository.GetStatusForUpdate
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Return(status ordermodels.Status, err error) *RepositoryMock {
+	if mmGetStatusForUpdate.mock.funcGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Set")
+	}
+
+	if mmGetStatusForUpdate.defaultExpectation == nil {
+		mmGetStatusForUpdate.defaultExpectation = &RepositoryMockGetStatusForUpdateExpectation{mock: mmGetStatusForUpdate.mock}
+	}
+	mmGetStatusForUpdate.defaultExpectation.results = &RepositoryMockGetStatusForUpdateResults{status, err}
+	return mmGetStatusForUpdate.mock
+}
+
+// Set uses given function f to mock the Repository.GetStatusForUpdate method
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Set(f func(ctx context.Context, orderID int64) (status ordermodels.Status, err error)) *RepositoryMock {
+	if mmGetStatusForUpdate.defaultExpectation != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("Default expectation is already set for the Repository.GetStatusForUpdate method")
+	}
+
+	if len(mmGetStatusForUpdate.expectations) > 0 {
+		mmGetStatusForUpdate.mock.t.Fatalf("Some expectations are already set for the Repository.GetStatusForUpdate method")
+	}
+
+	mmGetStatusForUpdate.mock.funcGetStatusForUpdate = f
+	return mmGetStatusForUpdate.mock
+}
+
+// When sets expectation for the Repository.GetStatusForUpdate which will trigger the result defined by the following
+// Then helper
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) When(ctx context.Context, orderID int64) *RepositoryMockGetStatusForUpdateExpectation {
+	if mmGetStatusForUpdate.mock.funcGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.mock.t.Fatalf("RepositoryMock.GetStatusForUpdate mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetStatusForUpdateExpectation{
+		mock:   mmGetStatusForUpdate.mock,
+		params: &RepositoryMockGetStatusForUpdateParams{ctx, orderID},
+	}
+	mmGetStatusForUpdate.expectations = append(mmGetStatusForUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetStatusForUpdate return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetStatusForUpdateExpectation) Then(status ordermodels.Status, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetStatusForUpdateResults{status, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetStatusForUpdate should be invoked
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Times(n uint64) *mRepositoryMockGetStatusForUpdate {
+	if n == 0 {
+		mmGetStatusForUpdate.mock.t.Fatalf("Times of RepositoryMock.GetStatusForUpdate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStatusForUpdate.expectedInvocations, n)
+	return mmGetStatusForUpdate
+}
+
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) invocationsDone() bool {
+	if len(mmGetStatusForUpdate.expectations) == 0 && mmGetStatusForUpdate.defaultExpectation == nil && mmGetStatusForUpdate.mock.funcGetStatusForUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStatusForUpdate.mock.afterGetStatusForUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStatusForUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStatusForUpdate implements order.Repository
+func (mmGetStatusForUpdate *RepositoryMock) GetStatusForUpdate(ctx context.Context, orderID int64) (status ordermodels.Status, err error) {
+	mm_atomic.AddUint64(&mmGetStatusForUpdate.beforeGetStatusForUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStatusForUpdate.afterGetStatusForUpdateCounter, 1)
+
+	if mmGetStatusForUpdate.inspectFuncGetStatusForUpdate != nil {
+		mmGetStatusForUpdate.inspectFuncGetStatusForUpdate(ctx, orderID)
+	}
+
+	mm_params := RepositoryMockGetStatusForUpdateParams{ctx, orderID}
+
+	// Record call args
+	mmGetStatusForUpdate.GetStatusForUpdateMock.mutex.Lock()
+	mmGetStatusForUpdate.GetStatusForUpdateMock.callArgs = append(mmGetStatusForUpdate.GetStatusForUpdateMock.callArgs, &mm_params)
+	mmGetStatusForUpdate.GetStatusForUpdateMock.mutex.Unlock()
+
+	for _, e := range mmGetStatusForUpdate.GetStatusForUpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.status, e.results.err
+		}
+	}
+
+	if mmGetStatusForUpdate.GetStatusForUpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStatusForUpdate.GetStatusForUpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStatusForUpdate.GetStatusForUpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStatusForUpdate.GetStatusForUpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetStatusForUpdateParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStatusForUpdate.t.Errorf("RepositoryMock.GetStatusForUpdate got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetStatusForUpdate.t.Errorf("RepositoryMock.GetStatusForUpdate got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStatusForUpdate.t.Errorf("RepositoryMock.GetStatusForUpdate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStatusForUpdate.GetStatusForUpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStatusForUpdate.t.Fatal("No results are set for the RepositoryMock.GetStatusForUpdate")
+		}
+		return (*mm_results).status, (*mm_results).err
+	}
+	if mmGetStatusForUpdate.funcGetStatusForUpdate != nil {
+		return mmGetStatusForUpdate.funcGetStatusForUpdate(ctx, orderID)
+	}
+	mmGetStatusForUpdate.t.Fatalf("Unexpected call to RepositoryMock.GetStatusForUpdate. %v %v", ctx, orderID)
+	return
+}
+
+// GetStatusForUpdateAfterCounter returns a count of finished RepositoryMock.GetStatusForUpdate invocations
+func (mmGetStatusForUpdate *RepositoryMock) GetStatusForUpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStatusForUpdate.afterGetStatusForUpdateCounter)
+}
+
+// GetStatusForUpdateBeforeCounter returns a count of RepositoryMock.GetStatusForUpdate invocations
+func (mmGetStatusForUpdate *RepositoryMock) GetStatusForUpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStatusForUpdate.beforeGetStatusForUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetStatusForUpdate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStatusForUpdate *mRepositoryMockGetStatusForUpdate) Calls() []*RepositoryMockGetStatusForUpdateParams {
+	mmGetStatusForUpdate.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetStatusForUpdateParams, len(mmGetStatusForUpdate.callArgs))
+	copy(argCopy, mmGetStatusForUpdate.callArgs)
+
+	mmGetStatusForUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStatusForUpdateDone returns true if the count of the GetStatusForUpdate invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetStatusForUpdateDone() bool {
+	if m.GetStatusForUpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStatusForUpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStatusForUpdateMock.invocationsDone()
+}
+
+// MinimockGetStatusForUpdateInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetStatusForUpdateInspect() {
+	for _, e := range m.GetStatusForUpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetStatusForUpdate with params: %#v", *e.params)
+		}
+	}
+
+	afterGetStatusForUpdateCounter := mm_atomic.LoadUint64(&m.afterGetStatusForUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStatusForUpdateMock.defaultExpectation != nil && afterGetStatusForUpdateCounter < 1 {
+		if m.GetStatusForUpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetStatusForUpdate")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetStatusForUpdate with params: %#v", *m.GetStatusForUpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStatusForUpdate != nil && afterGetStatusForUpdateCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetStatusForUpdate")
+	}
+
+	if !m.GetStatusForUpdateMock.invocationsDone() && afterGetStatusForUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetStatusForUpdate but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStatusForUpdateMock.expectedInvocations), afterGetStatusForUpdateCounter)
+	}
+}
+
+type mRepositoryMockListByUser struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListByUserExpectation
+	expectations       []*RepositoryMockListByUserExpectation
+
+	callArgs []*RepositoryMockListByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockListByUserExpectation specifies expectation struct of the Repository.ListByUser
+type RepositoryMockListByUserExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockListByUserParams
+	paramPtrs *RepositoryMockListByUserParamPtrs
+	results   *RepositoryMockListByUserResults
+	Counter   uint64
+}
+
+// RepositoryMockListByUserParams contains parameters of the Repository.ListByUser
+type RepositoryMockListByUserParams struct {
+	ctx    context.Context
+	filter ordermodels.ListFilter
+}
+
+// RepositoryMockListByUserParamPtrs contains pointers to parameters of the Repository.ListByUser
+type RepositoryMockListByUserParamPtrs struct {
+	ctx    *context.Context
+	filter *ordermodels.ListFilter
+}
+
+// RepositoryMockListByUserResults contains results of the Repository.ListByUser
+type RepositoryMockListByUserResults struct {
+	orders []ordermodels.Order
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByUser *mRepositoryMockListByUser) Optional() *mRepositoryMockListByUser {
+	mmListByUser.optional = true
+	return mmListByUser
+}
+
+// Expect sets up expected params for Repository.ListByUser
+func (mmListByUser *mRepositoryMockListByUser) Expect(ctx context.Context, filter ordermodels.ListFilter) *mRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &RepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by ExpectParams functions")
+	}
+
+	mmListByUser.defaultExpectation.params = &RepositoryMockListByUserParams{ctx, filter}
+	for _, e := range mmListByUser.expectations {
+		if minimock.Equal(e.params, mmListByUser.defaultExpectation.params) {
+			mmListByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmListByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ListByUser
+func (mmListByUser *mRepositoryMockListByUser) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &RepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &RepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListByUser
+}
+
+// ExpectFilterParam2 sets up expected param filter for Repository.ListByUser
+func (mmListByUser *mRepositoryMockListByUser) ExpectFilterParam2(filter ordermodels.ListFilter) *mRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &RepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &RepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.filter = &filter
+
+	return mmListByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ListByUser
+func (mmListByUser *mRepositoryMockListByUser) Inspect(f func(ctx context.Context, filter ordermodels.ListFilter)) *mRepositoryMockListByUser {
+	if mmListByUser.mock.inspectFuncListByUser != nil {
+		mmListByUser.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListByUser")
+	}
+
+	mmListByUser.mock.inspectFuncListByUser = f
+
+	return mmListByUser
+}
+
+// Return sets up results that will be returned by Repository.ListByUser
+func (mmListByUser *mRepositoryMockListByUser) Return(orders []ordermodels.Order, err error) *RepositoryMock {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &RepositoryMockListByUserExpectation{mock: mmListByUser.mock}
+	}
+	mmListByUser.defaultExpectation.results = &RepositoryMockListByUserResults{orders, err}
+	return mmListByUser.mock
+}
+
+// Set uses given function f to mock the Repository.ListByUser method
+func (mmListByUser *mRepositoryMockListByUser) Set(f func(ctx context.Context, filter ordermodels.ListFilter) (orders []ordermodels.Order, err error)) *RepositoryMock {
+	if mmListByUser.defaultExpectation != nil {
+		mmListByUser.mock.t.Fatalf("Default expectation is already set for the Repository.ListByUser method")
+	}
+
+	if len(mmListByUser.expectations) > 0 {
+		mmListByUser.mock.t.Fatalf("Some expectations are already set for the Repository.ListByUser method")
+	}
+
+	mmListByUser.mock.funcListByUser = f
+	return mmListByUser.mock
+}
+
+// When sets expectation for the Repository.ListByUser which will trigger the result defined by the following
+// Then helper
+func (mmListByUser *mRepositoryMockListByUser) When(ctx context.Context, filter ordermodels.ListFilter) *RepositoryMockListByUserExpectation {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("RepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListByUserExpectation{
+		mock:   mmListByUser.mock,
+		params: &RepositoryMockListByUserParams{ctx, filter},
+	}
+	mmListByUser.expectations = append(mmListByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ListByUser return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListByUserExpectation) Then(orders []ordermodels.Order, err error) *RepositoryMock {
+	e.results = &RepositoryMockListByUserResults{orders, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ListByUser should be invoked
+func (mmListByUser *mRepositoryMockListByUser) Times(n uint64) *mRepositoryMockListByUser {
+	if n == 0 {
+		mmListByUser.mock.t.Fatalf("Times of RepositoryMock.ListByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByUser.expectedInvocations, n)
+	return mmListByUser
+}
+
+func (mmListByUser *mRepositoryMockListByUser) invocationsDone() bool {
+	if len(mmListByUser.expectations) == 0 && mmListByUser.defaultExpectation == nil && mmListByUser.mock.funcListByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByUser.mock.afterListByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByUser implements order.Repository
+func (mmListByUser *RepositoryMock) ListByUser(ctx context.Context, filter ordermodels.ListFilter) (orders []ordermodels.Order, err error) {
+	mm_atomic.AddUint64(&mmListByUser.beforeListByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByUser.afterListByUserCounter, 1)
+
+	if mmListByUser.inspectFuncListByUser != nil {
+		mmListByUser.inspectFuncListByUser(ctx, filter)
+	}
+
+	mm_params := RepositoryMockListByUserParams{ctx, filter}
+
+	// Record call args
+	mmListByUser.ListByUserMock.mutex.Lock()
+	mmListByUser.ListByUserMock.callArgs = append(mmListByUser.ListByUserMock.callArgs, &mm_params)
+	mmListByUser.ListByUserMock.mutex.Unlock()
+
+	for _, e := range mmListByUser.ListByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.orders, e.results.err
+		}
+	}
+
+	if mmListByUser.ListByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByUser.ListByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByUser.ListByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmListByUser.ListByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListByUserParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByUser.t.Errorf("RepositoryMock.ListByUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListByUser.t.Errorf("RepositoryMock.ListByUser got unexpected parameter filter, want: %#v, got: %#v%s\n", *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByUser.t.Errorf("RepositoryMock.ListByUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByUser.ListByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByUser.t.Fatal("No results are set for the RepositoryMock.ListByUser")
+		}
+		return (*mm_results).orders, (*mm_results).err
+	}
+	if mmListByUser.funcListByUser != nil {
+		return mmListByUser.funcListByUser(ctx, filter)
+	}
+	mmListByUser.t.Fatalf("Unexpected call to RepositoryMock.ListByUser. %v %v", ctx, filter)
+	return
+}
+
+// ListByUserAfterCounter returns a count of finished RepositoryMock.ListByUser invocations
+func (mmListByUser *RepositoryMock) ListByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.afterListByUserCounter)
+}
+
+// ListByUserBeforeCounter returns a count of RepositoryMock.ListByUser invocations
+func (mmListByUser *RepositoryMock) ListByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.beforeListByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByUser *mRepositoryMockListByUser) Calls() []*RepositoryMockListByUserParams {
+	mmListByUser.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListByUserParams, len(mmListByUser.callArgs))
+	copy(argCopy, mmListByUser.callArgs)
+
+	mmListByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByUserDone returns true if the count of the ListByUser invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListByUserDone() bool {
+	if m.ListByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByUserMock.invocationsDone()
+}
+
+// MinimockListByUserInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListByUserInspect() {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListByUser with params: %#v", *e.params)
+		}
+	}
+
+	afterListByUserCounter := mm_atomic.LoadUint64(&m.afterListByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && afterListByUserCounter < 1 {
+		if m.ListByUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.ListByUser")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListByUser with params: %#v", *m.ListByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && afterListByUserCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.ListByUser")
+	}
+
+	if !m.ListByUserMock.invocationsDone() && afterListByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListByUser but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByUserMock.expectedInvocations), afterListByUserCounter)
+	}
+}
+
+type mRepositoryMockSetStatus struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSetStatusExpectation
+	expectations       []*RepositoryMockSetStatusExpectation
+
+	callArgs []*RepositoryMockSetStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockSetStatusExpectation specifies expectation struct of the Repository.SetStatus
+type RepositoryMockSetStatusExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockSetStatusParams
+	paramPtrs *RepositoryMockSetStatusParamPtrs
+	results   *RepositoryMockSetStatusResults
+	Counter   uint64
+}
+
+// RepositoryMockSetStatusParams contains parameters of the Repository.SetStatus
+type RepositoryMockSetStatusParams struct {
+	ctx     context.Context
+	orderID int64
+	status  ordermodels.Status
+}
+
+// RepositoryMockSetStatusParamPtrs contains pointers to parameters of the Repository.SetStatus
+type RepositoryMockSetStatusParamPtrs struct {
+	ctx     *context.Context
+	orderID *int64
+	status  *ordermodels.Status
+}
+
+// RepositoryMockSetStatusResults contains results of the Repository.SetStatus
+type RepositoryMockSetStatusResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetStatus *mRepositoryMockSetStatus) Optional() *mRepositoryMockSetStatus {
+	mmSetStatus.optional = true
+	return mmSetStatus
+}
+
+// Expect sets up expected params for Repository.SetStatus
+func (mmSetStatus *mRepositoryMockSetStatus) Expect(ctx context.Context, orderID int64, status ordermodels.Status) *mRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by ExpectParams functions")
+	}
+
+	mmSetStatus.defaultExpectation.params = &RepositoryMockSetStatusParams{ctx, orderID, status}
+	for _, e := range mmSetStatus.expectations {
+		if minimock.Equal(e.params, mmSetStatus.defaultExpectation.params) {
+			mmSetStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmSetStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SetStatus
+func (mmSetStatus *mRepositoryMockSetStatus) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &RepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSetStatus
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for Repository.SetStatus
+func (mmSetStatus *mRepositoryMockSetStatus) ExpectOrderIDParam2(orderID int64) *mRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Expect")
+	}
+
+	if mmSetStatus.defaultExpectation.paramPtrs == nil {
+		mmSetStatus.defaultExpectation.paramPtrs = &RepositoryMockSetStatusParamPtrs{}
+	}
+	mmSetStatus.defaultExpectation.paramPtrs.orderID = &orderID
+
+	return mmSetStatus
+}
+
+// ExpectStatusParam3 sets up expected param status for Repository.SetStatus
+func (mmSetStatus *mRepositoryMockSetStatus) ExpectStatusParam3(status ordermodels.Status) *mRepositoryMockSetStatus {
+	if mmSetStatus.mock.funcSetStatus != nil {
+		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Set")
+	}
+
+	if mmSetStatus.defaultExpectation == nil {
+		mmSetStatus.defaultExpectation = &RepositoryMockSetStatusExpectation{}
+	}
+
+	if mmSetStatus.defaultExpectation.params != nil {
 		mmSetStatus.mock.t.Fatalf("RepositoryMock.SetStatus mock is already set by Expect")
 	}
 
@@ -1056,8 +2434,16 @@ func (m *RepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCreateInspect()
 
+			m.MinimockFetchNextExpiredOrderIDInspect()
+
 			m.MinimockGetByIDInspect()
 
+			m.MinimockGetByIdempotencyKeyInspect()
+
+			m.MinimockGetStatusForUpdateInspect()
+
+			m.MinimockListByUserInspect()
+
 			m.MinimockSetStatusInspect()
 		}
 	})
@@ -1083,6 +2469,10 @@ func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateDone() &&
+		m.MinimockFetchNextExpiredOrderIDDone() &&
 		m.MinimockGetByIDDone() &&
+		m.MinimockGetByIdempotencyKeyDone() &&
+		m.MinimockGetStatusForUpdateDone() &&
+		m.MinimockListByUserDone() &&
 		m.MinimockSetStatusDone()
 }
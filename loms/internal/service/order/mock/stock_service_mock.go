@@ -11,7 +11,6 @@ import (
 	mm_time "time"
 
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 	"github.com/gojuno/minimock/v3"
 )
 
@@ -20,20 +19,20 @@ type StockServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcReserve          func(ctx context.Context, item []ordermodels.Item) (err error)
-	inspectFuncReserve   func(ctx context.Context, item []ordermodels.Item)
+	funcReserve          func(ctx context.Context, orderID int64, item []ordermodels.Item) (err error)
+	inspectFuncReserve   func(ctx context.Context, orderID int64, item []ordermodels.Item)
 	afterReserveCounter  uint64
 	beforeReserveCounter uint64
 	ReserveMock          mStockServiceMockReserve
 
-	funcReserveCancel          func(ctx context.Context, item []stockmodels.ReserveItem) (err error)
-	inspectFuncReserveCancel   func(ctx context.Context, item []stockmodels.ReserveItem)
+	funcReserveCancel          func(ctx context.Context, orderID int64) (err error)
+	inspectFuncReserveCancel   func(ctx context.Context, orderID int64)
 	afterReserveCancelCounter  uint64
 	beforeReserveCancelCounter uint64
 	ReserveCancelMock          mStockServiceMockReserveCancel
 
-	funcReserveRemove          func(ctx context.Context, item []stockmodels.ReserveItem) (err error)
-	inspectFuncReserveRemove   func(ctx context.Context, item []stockmodels.ReserveItem)
+	funcReserveRemove          func(ctx context.Context, orderID int64) (err error)
+	inspectFuncReserveRemove   func(ctx context.Context, orderID int64)
 	afterReserveRemoveCounter  uint64
 	beforeReserveRemoveCounter uint64
 	ReserveRemoveMock          mStockServiceMockReserveRemove
@@ -84,14 +83,16 @@ type StockServiceMockReserveExpectation struct {
 
 // StockServiceMockReserveParams contains parameters of the StockService.Reserve
 type StockServiceMockReserveParams struct {
-	ctx  context.Context
-	item []ordermodels.Item
+	ctx     context.Context
+	orderID int64
+	item    []ordermodels.Item
 }
 
 // StockServiceMockReserveParamPtrs contains pointers to parameters of the StockService.Reserve
 type StockServiceMockReserveParamPtrs struct {
-	ctx  *context.Context
-	item *[]ordermodels.Item
+	ctx     *context.Context
+	orderID *int64
+	item    *[]ordermodels.Item
 }
 
 // StockServiceMockReserveResults contains results of the StockService.Reserve
@@ -110,7 +111,7 @@ func (mmReserve *mStockServiceMockReserve) Optional() *mStockServiceMockReserve
 }
 
 // Expect sets up expected params for StockService.Reserve
-func (mmReserve *mStockServiceMockReserve) Expect(ctx context.Context, item []ordermodels.Item) *mStockServiceMockReserve {
+func (mmReserve *mStockServiceMockReserve) Expect(ctx context.Context, orderID int64, item []ordermodels.Item) *mStockServiceMockReserve {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("StockServiceMock.Reserve mock is already set by Set")
 	}
@@ -123,7 +124,7 @@ func (mmReserve *mStockServiceMockReserve) Expect(ctx context.Context, item []or
 		mmReserve.mock.t.Fatalf("StockServiceMock.Reserve mock is already set by ExpectParams functions")
 	}
 
-	mmReserve.defaultExpectation.params = &StockServiceMockReserveParams{ctx, item}
+	mmReserve.defaultExpectation.params = &StockServiceMockReserveParams{ctx, orderID, item}
 	for _, e := range mmReserve.expectations {
 		if minimock.Equal(e.params, mmReserve.defaultExpectation.params) {
 			mmReserve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserve.defaultExpectation.params)
@@ -155,8 +156,30 @@ func (mmReserve *mStockServiceMockReserve) ExpectCtxParam1(ctx context.Context)
 	return mmReserve
 }
 
-// ExpectItemParam2 sets up expected param item for StockService.Reserve
-func (mmReserve *mStockServiceMockReserve) ExpectItemParam2(item []ordermodels.Item) *mStockServiceMockReserve {
+// ExpectOrderIDParam2 sets up expected param orderID for StockService.Reserve
+func (mmReserve *mStockServiceMockReserve) ExpectOrderIDParam2(orderID int64) *mStockServiceMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("StockServiceMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &StockServiceMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("StockServiceMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &StockServiceMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.orderID = &orderID
+
+	return mmReserve
+}
+
+// ExpectItemParam3 sets up expected param item for StockService.Reserve
+func (mmReserve *mStockServiceMockReserve) ExpectItemParam3(item []ordermodels.Item) *mStockServiceMockReserve {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("StockServiceMock.Reserve mock is already set by Set")
 	}
@@ -178,7 +201,7 @@ func (mmReserve *mStockServiceMockReserve) ExpectItemParam2(item []ordermodels.I
 }
 
 // Inspect accepts an inspector function that has same arguments as the StockService.Reserve
-func (mmReserve *mStockServiceMockReserve) Inspect(f func(ctx context.Context, item []ordermodels.Item)) *mStockServiceMockReserve {
+func (mmReserve *mStockServiceMockReserve) Inspect(f func(ctx context.Context, orderID int64, item []ordermodels.Item)) *mStockServiceMockReserve {
 	if mmReserve.mock.inspectFuncReserve != nil {
 		mmReserve.mock.t.Fatalf("Inspect function is already set for StockServiceMock.Reserve")
 	}
@@ -202,7 +225,7 @@ func (mmReserve *mStockServiceMockReserve) Return(err error) *StockServiceMock {
 }
 
 // Set uses given function f to mock the StockService.Reserve method
-func (mmReserve *mStockServiceMockReserve) Set(f func(ctx context.Context, item []ordermodels.Item) (err error)) *StockServiceMock {
+func (mmReserve *mStockServiceMockReserve) Set(f func(ctx context.Context, orderID int64, item []ordermodels.Item) (err error)) *StockServiceMock {
 	if mmReserve.defaultExpectation != nil {
 		mmReserve.mock.t.Fatalf("Default expectation is already set for the StockService.Reserve method")
 	}
@@ -217,14 +240,14 @@ func (mmReserve *mStockServiceMockReserve) Set(f func(ctx context.Context, item
 
 // When sets expectation for the StockService.Reserve which will trigger the result defined by the following
 // Then helper
-func (mmReserve *mStockServiceMockReserve) When(ctx context.Context, item []ordermodels.Item) *StockServiceMockReserveExpectation {
+func (mmReserve *mStockServiceMockReserve) When(ctx context.Context, orderID int64, item []ordermodels.Item) *StockServiceMockReserveExpectation {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("StockServiceMock.Reserve mock is already set by Set")
 	}
 
 	expectation := &StockServiceMockReserveExpectation{
 		mock:   mmReserve.mock,
-		params: &StockServiceMockReserveParams{ctx, item},
+		params: &StockServiceMockReserveParams{ctx, orderID, item},
 	}
 	mmReserve.expectations = append(mmReserve.expectations, expectation)
 	return expectation
@@ -257,15 +280,15 @@ func (mmReserve *mStockServiceMockReserve) invocationsDone() bool {
 }
 
 // Reserve implements order.StockService
-func (mmReserve *StockServiceMock) Reserve(ctx context.Context, item []ordermodels.Item) (err error) {
+func (mmReserve *StockServiceMock) Reserve(ctx context.Context, orderID int64, item []ordermodels.Item) (err error) {
 	mm_atomic.AddUint64(&mmReserve.beforeReserveCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserve.afterReserveCounter, 1)
 
 	if mmReserve.inspectFuncReserve != nil {
-		mmReserve.inspectFuncReserve(ctx, item)
+		mmReserve.inspectFuncReserve(ctx, orderID, item)
 	}
 
-	mm_params := StockServiceMockReserveParams{ctx, item}
+	mm_params := StockServiceMockReserveParams{ctx, orderID, item}
 
 	// Record call args
 	mmReserve.ReserveMock.mutex.Lock()
@@ -284,7 +307,7 @@ func (mmReserve *StockServiceMock) Reserve(ctx context.Context, item []ordermode
 		mm_want := mmReserve.ReserveMock.defaultExpectation.params
 		mm_want_ptrs := mmReserve.ReserveMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceMockReserveParams{ctx, item}
+		mm_got := StockServiceMockReserveParams{ctx, orderID, item}
 
 		if mm_want_ptrs != nil {
 
@@ -292,6 +315,10 @@ func (mmReserve *StockServiceMock) Reserve(ctx context.Context, item []ordermode
 				mmReserve.t.Errorf("StockServiceMock.Reserve got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmReserve.t.Errorf("StockServiceMock.Reserve got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
 			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
 				mmReserve.t.Errorf("StockServiceMock.Reserve got unexpected parameter item, want: %#v, got: %#v%s\n", *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
 			}
@@ -307,9 +334,9 @@ func (mmReserve *StockServiceMock) Reserve(ctx context.Context, item []ordermode
 		return (*mm_results).err
 	}
 	if mmReserve.funcReserve != nil {
-		return mmReserve.funcReserve(ctx, item)
+		return mmReserve.funcReserve(ctx, orderID, item)
 	}
-	mmReserve.t.Fatalf("Unexpected call to StockServiceMock.Reserve. %v %v", ctx, item)
+	mmReserve.t.Fatalf("Unexpected call to StockServiceMock.Reserve. %v %v %v", ctx, orderID, item)
 	return
 }
 
@@ -404,14 +431,14 @@ type StockServiceMockReserveCancelExpectation struct {
 
 // StockServiceMockReserveCancelParams contains parameters of the StockService.ReserveCancel
 type StockServiceMockReserveCancelParams struct {
-	ctx  context.Context
-	item []stockmodels.ReserveItem
+	ctx     context.Context
+	orderID int64
 }
 
 // StockServiceMockReserveCancelParamPtrs contains pointers to parameters of the StockService.ReserveCancel
 type StockServiceMockReserveCancelParamPtrs struct {
-	ctx  *context.Context
-	item *[]stockmodels.ReserveItem
+	ctx     *context.Context
+	orderID *int64
 }
 
 // StockServiceMockReserveCancelResults contains results of the StockService.ReserveCancel
@@ -430,7 +457,7 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) Optional() *mStockService
 }
 
 // Expect sets up expected params for StockService.ReserveCancel
-func (mmReserveCancel *mStockServiceMockReserveCancel) Expect(ctx context.Context, item []stockmodels.ReserveItem) *mStockServiceMockReserveCancel {
+func (mmReserveCancel *mStockServiceMockReserveCancel) Expect(ctx context.Context, orderID int64) *mStockServiceMockReserveCancel {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("StockServiceMock.ReserveCancel mock is already set by Set")
 	}
@@ -443,7 +470,7 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) Expect(ctx context.Contex
 		mmReserveCancel.mock.t.Fatalf("StockServiceMock.ReserveCancel mock is already set by ExpectParams functions")
 	}
 
-	mmReserveCancel.defaultExpectation.params = &StockServiceMockReserveCancelParams{ctx, item}
+	mmReserveCancel.defaultExpectation.params = &StockServiceMockReserveCancelParams{ctx, orderID}
 	for _, e := range mmReserveCancel.expectations {
 		if minimock.Equal(e.params, mmReserveCancel.defaultExpectation.params) {
 			mmReserveCancel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveCancel.defaultExpectation.params)
@@ -475,8 +502,8 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) ExpectCtxParam1(ctx conte
 	return mmReserveCancel
 }
 
-// ExpectItemParam2 sets up expected param item for StockService.ReserveCancel
-func (mmReserveCancel *mStockServiceMockReserveCancel) ExpectItemParam2(item []stockmodels.ReserveItem) *mStockServiceMockReserveCancel {
+// ExpectOrderIDParam2 sets up expected param orderID for StockService.ReserveCancel
+func (mmReserveCancel *mStockServiceMockReserveCancel) ExpectOrderIDParam2(orderID int64) *mStockServiceMockReserveCancel {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("StockServiceMock.ReserveCancel mock is already set by Set")
 	}
@@ -492,13 +519,13 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) ExpectItemParam2(item []s
 	if mmReserveCancel.defaultExpectation.paramPtrs == nil {
 		mmReserveCancel.defaultExpectation.paramPtrs = &StockServiceMockReserveCancelParamPtrs{}
 	}
-	mmReserveCancel.defaultExpectation.paramPtrs.item = &item
+	mmReserveCancel.defaultExpectation.paramPtrs.orderID = &orderID
 
 	return mmReserveCancel
 }
 
 // Inspect accepts an inspector function that has same arguments as the StockService.ReserveCancel
-func (mmReserveCancel *mStockServiceMockReserveCancel) Inspect(f func(ctx context.Context, item []stockmodels.ReserveItem)) *mStockServiceMockReserveCancel {
+func (mmReserveCancel *mStockServiceMockReserveCancel) Inspect(f func(ctx context.Context, orderID int64)) *mStockServiceMockReserveCancel {
 	if mmReserveCancel.mock.inspectFuncReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("Inspect function is already set for StockServiceMock.ReserveCancel")
 	}
@@ -522,7 +549,7 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) Return(err error) *StockS
 }
 
 // Set uses given function f to mock the StockService.ReserveCancel method
-func (mmReserveCancel *mStockServiceMockReserveCancel) Set(f func(ctx context.Context, item []stockmodels.ReserveItem) (err error)) *StockServiceMock {
+func (mmReserveCancel *mStockServiceMockReserveCancel) Set(f func(ctx context.Context, orderID int64) (err error)) *StockServiceMock {
 	if mmReserveCancel.defaultExpectation != nil {
 		mmReserveCancel.mock.t.Fatalf("Default expectation is already set for the StockService.ReserveCancel method")
 	}
@@ -537,14 +564,14 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) Set(f func(ctx context.Co
 
 // When sets expectation for the StockService.ReserveCancel which will trigger the result defined by the following
 // Then helper
-func (mmReserveCancel *mStockServiceMockReserveCancel) When(ctx context.Context, item []stockmodels.ReserveItem) *StockServiceMockReserveCancelExpectation {
+func (mmReserveCancel *mStockServiceMockReserveCancel) When(ctx context.Context, orderID int64) *StockServiceMockReserveCancelExpectation {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("StockServiceMock.ReserveCancel mock is already set by Set")
 	}
 
 	expectation := &StockServiceMockReserveCancelExpectation{
 		mock:   mmReserveCancel.mock,
-		params: &StockServiceMockReserveCancelParams{ctx, item},
+		params: &StockServiceMockReserveCancelParams{ctx, orderID},
 	}
 	mmReserveCancel.expectations = append(mmReserveCancel.expectations, expectation)
 	return expectation
@@ -577,15 +604,15 @@ func (mmReserveCancel *mStockServiceMockReserveCancel) invocationsDone() bool {
 }
 
 // ReserveCancel implements order.StockService
-func (mmReserveCancel *StockServiceMock) ReserveCancel(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+func (mmReserveCancel *StockServiceMock) ReserveCancel(ctx context.Context, orderID int64) (err error) {
 	mm_atomic.AddUint64(&mmReserveCancel.beforeReserveCancelCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserveCancel.afterReserveCancelCounter, 1)
 
 	if mmReserveCancel.inspectFuncReserveCancel != nil {
-		mmReserveCancel.inspectFuncReserveCancel(ctx, item)
+		mmReserveCancel.inspectFuncReserveCancel(ctx, orderID)
 	}
 
-	mm_params := StockServiceMockReserveCancelParams{ctx, item}
+	mm_params := StockServiceMockReserveCancelParams{ctx, orderID}
 
 	// Record call args
 	mmReserveCancel.ReserveCancelMock.mutex.Lock()
@@ -604,7 +631,7 @@ func (mmReserveCancel *StockServiceMock) ReserveCancel(ctx context.Context, item
 		mm_want := mmReserveCancel.ReserveCancelMock.defaultExpectation.params
 		mm_want_ptrs := mmReserveCancel.ReserveCancelMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceMockReserveCancelParams{ctx, item}
+		mm_got := StockServiceMockReserveCancelParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
@@ -612,8 +639,8 @@ func (mmReserveCancel *StockServiceMock) ReserveCancel(ctx context.Context, item
 				mmReserveCancel.t.Errorf("StockServiceMock.ReserveCancel got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
-				mmReserveCancel.t.Errorf("StockServiceMock.ReserveCancel got unexpected parameter item, want: %#v, got: %#v%s\n", *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmReserveCancel.t.Errorf("StockServiceMock.ReserveCancel got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -627,9 +654,9 @@ func (mmReserveCancel *StockServiceMock) ReserveCancel(ctx context.Context, item
 		return (*mm_results).err
 	}
 	if mmReserveCancel.funcReserveCancel != nil {
-		return mmReserveCancel.funcReserveCancel(ctx, item)
+		return mmReserveCancel.funcReserveCancel(ctx, orderID)
 	}
-	mmReserveCancel.t.Fatalf("Unexpected call to StockServiceMock.ReserveCancel. %v %v", ctx, item)
+	mmReserveCancel.t.Fatalf("Unexpected call to StockServiceMock.ReserveCancel. %v %v", ctx, orderID)
 	return
 }
 
@@ -724,14 +751,14 @@ type StockServiceMockReserveRemoveExpectation struct {
 
 // StockServiceMockReserveRemoveParams contains parameters of the StockService.ReserveRemove
 type StockServiceMockReserveRemoveParams struct {
-	ctx  context.Context
-	item []stockmodels.ReserveItem
+	ctx     context.Context
+	orderID int64
 }
 
 // StockServiceMockReserveRemoveParamPtrs contains pointers to parameters of the StockService.ReserveRemove
 type StockServiceMockReserveRemoveParamPtrs struct {
-	ctx  *context.Context
-	item *[]stockmodels.ReserveItem
+	ctx     *context.Context
+	orderID *int64
 }
 
 // StockServiceMockReserveRemoveResults contains results of the StockService.ReserveRemove
@@ -750,7 +777,7 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) Optional() *mStockService
 }
 
 // Expect sets up expected params for StockService.ReserveRemove
-func (mmReserveRemove *mStockServiceMockReserveRemove) Expect(ctx context.Context, item []stockmodels.ReserveItem) *mStockServiceMockReserveRemove {
+func (mmReserveRemove *mStockServiceMockReserveRemove) Expect(ctx context.Context, orderID int64) *mStockServiceMockReserveRemove {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("StockServiceMock.ReserveRemove mock is already set by Set")
 	}
@@ -763,7 +790,7 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) Expect(ctx context.Contex
 		mmReserveRemove.mock.t.Fatalf("StockServiceMock.ReserveRemove mock is already set by ExpectParams functions")
 	}
 
-	mmReserveRemove.defaultExpectation.params = &StockServiceMockReserveRemoveParams{ctx, item}
+	mmReserveRemove.defaultExpectation.params = &StockServiceMockReserveRemoveParams{ctx, orderID}
 	for _, e := range mmReserveRemove.expectations {
 		if minimock.Equal(e.params, mmReserveRemove.defaultExpectation.params) {
 			mmReserveRemove.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveRemove.defaultExpectation.params)
@@ -795,8 +822,8 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) ExpectCtxParam1(ctx conte
 	return mmReserveRemove
 }
 
-// ExpectItemParam2 sets up expected param item for StockService.ReserveRemove
-func (mmReserveRemove *mStockServiceMockReserveRemove) ExpectItemParam2(item []stockmodels.ReserveItem) *mStockServiceMockReserveRemove {
+// ExpectOrderIDParam2 sets up expected param orderID for StockService.ReserveRemove
+func (mmReserveRemove *mStockServiceMockReserveRemove) ExpectOrderIDParam2(orderID int64) *mStockServiceMockReserveRemove {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("StockServiceMock.ReserveRemove mock is already set by Set")
 	}
@@ -812,13 +839,13 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) ExpectItemParam2(item []s
 	if mmReserveRemove.defaultExpectation.paramPtrs == nil {
 		mmReserveRemove.defaultExpectation.paramPtrs = &StockServiceMockReserveRemoveParamPtrs{}
 	}
-	mmReserveRemove.defaultExpectation.paramPtrs.item = &item
+	mmReserveRemove.defaultExpectation.paramPtrs.orderID = &orderID
 
 	return mmReserveRemove
 }
 
 // Inspect accepts an inspector function that has same arguments as the StockService.ReserveRemove
-func (mmReserveRemove *mStockServiceMockReserveRemove) Inspect(f func(ctx context.Context, item []stockmodels.ReserveItem)) *mStockServiceMockReserveRemove {
+func (mmReserveRemove *mStockServiceMockReserveRemove) Inspect(f func(ctx context.Context, orderID int64)) *mStockServiceMockReserveRemove {
 	if mmReserveRemove.mock.inspectFuncReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("Inspect function is already set for StockServiceMock.ReserveRemove")
 	}
@@ -842,7 +869,7 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) Return(err error) *StockS
 }
 
 // Set uses given function f to mock the StockService.ReserveRemove method
-func (mmReserveRemove *mStockServiceMockReserveRemove) Set(f func(ctx context.Context, item []stockmodels.ReserveItem) (err error)) *StockServiceMock {
+func (mmReserveRemove *mStockServiceMockReserveRemove) Set(f func(ctx context.Context, orderID int64) (err error)) *StockServiceMock {
 	if mmReserveRemove.defaultExpectation != nil {
 		mmReserveRemove.mock.t.Fatalf("Default expectation is already set for the StockService.ReserveRemove method")
 	}
@@ -857,14 +884,14 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) Set(f func(ctx context.Co
 
 // When sets expectation for the StockService.ReserveRemove which will trigger the result defined by the following
 // Then helper
-func (mmReserveRemove *mStockServiceMockReserveRemove) When(ctx context.Context, item []stockmodels.ReserveItem) *StockServiceMockReserveRemoveExpectation {
+func (mmReserveRemove *mStockServiceMockReserveRemove) When(ctx context.Context, orderID int64) *StockServiceMockReserveRemoveExpectation {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("StockServiceMock.ReserveRemove mock is already set by Set")
 	}
 
 	expectation := &StockServiceMockReserveRemoveExpectation{
 		mock:   mmReserveRemove.mock,
-		params: &StockServiceMockReserveRemoveParams{ctx, item},
+		params: &StockServiceMockReserveRemoveParams{ctx, orderID},
 	}
 	mmReserveRemove.expectations = append(mmReserveRemove.expectations, expectation)
 	return expectation
@@ -897,15 +924,15 @@ func (mmReserveRemove *mStockServiceMockReserveRemove) invocationsDone() bool {
 }
 
 // ReserveRemove implements order.StockService
-func (mmReserveRemove *StockServiceMock) ReserveRemove(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+func (mmReserveRemove *StockServiceMock) ReserveRemove(ctx context.Context, orderID int64) (err error) {
 	mm_atomic.AddUint64(&mmReserveRemove.beforeReserveRemoveCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserveRemove.afterReserveRemoveCounter, 1)
 
 	if mmReserveRemove.inspectFuncReserveRemove != nil {
-		mmReserveRemove.inspectFuncReserveRemove(ctx, item)
+		mmReserveRemove.inspectFuncReserveRemove(ctx, orderID)
 	}
 
-	mm_params := StockServiceMockReserveRemoveParams{ctx, item}
+	mm_params := StockServiceMockReserveRemoveParams{ctx, orderID}
 
 	// Record call args
 	mmReserveRemove.ReserveRemoveMock.mutex.Lock()
@@ -924,7 +951,7 @@ func (mmReserveRemove *StockServiceMock) ReserveRemove(ctx context.Context, item
 		mm_want := mmReserveRemove.ReserveRemoveMock.defaultExpectation.params
 		mm_want_ptrs := mmReserveRemove.ReserveRemoveMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceMockReserveRemoveParams{ctx, item}
+		mm_got := StockServiceMockReserveRemoveParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
@@ -932,8 +959,8 @@ func (mmReserveRemove *StockServiceMock) ReserveRemove(ctx context.Context, item
 				mmReserveRemove.t.Errorf("StockServiceMock.ReserveRemove got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
-				mmReserveRemove.t.Errorf("StockServiceMock.ReserveRemove got unexpected parameter item, want: %#v, got: %#v%s\n", *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmReserveRemove.t.Errorf("StockServiceMock.ReserveRemove got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -947,9 +974,9 @@ func (mmReserveRemove *StockServiceMock) ReserveRemove(ctx context.Context, item
 		return (*mm_results).err
 	}
 	if mmReserveRemove.funcReserveRemove != nil {
-		return mmReserveRemove.funcReserveRemove(ctx, item)
+		return mmReserveRemove.funcReserveRemove(ctx, orderID)
 	}
-	mmReserveRemove.t.Fatalf("Unexpected call to StockServiceMock.ReserveRemove. %v %v", ctx, item)
+	mmReserveRemove.t.Fatalf("Unexpected call to StockServiceMock.ReserveRemove. %v %v", ctx, orderID)
 	return
 }
 
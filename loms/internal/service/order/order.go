@@ -2,48 +2,44 @@ package order
 
 import (
 	"context"
+	"time"
 
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 )
 
 type Repository interface {
 	Create(ctx context.Context, order ordermodels.NewOrder) (orderID int64, err error)
 	SetStatus(ctx context.Context, orderID int64, status ordermodels.Status) error
 	GetByID(ctx context.Context, orderID int64) (order ordermodels.Order, err error)
+	GetByIdempotencyKey(ctx context.Context, userID int64, key string) (orderID int64, status ordermodels.Status, err error)
+	GetStatusForUpdate(ctx context.Context, orderID int64) (status ordermodels.Status, err error)
+	FetchNextExpiredOrderID(ctx context.Context, paymentTimeout time.Duration, skip []int64) (orderID int64, err error)
+	ListByUser(ctx context.Context, filter ordermodels.ListFilter) (orders []ordermodels.Order, err error)
 }
 
 type StockService interface {
-	Reserve(ctx context.Context, item []ordermodels.Item) error
-	ReserveRemove(ctx context.Context, item []stockmodels.ReserveItem) error
-	ReserveCancel(ctx context.Context, item []stockmodels.ReserveItem) error
+	Reserve(ctx context.Context, orderID int64, item []ordermodels.Item) error
+	ReserveRemove(ctx context.Context, orderID int64) error
+	ReserveCancel(ctx context.Context, orderID int64) error
 }
 
 type TxManager interface {
 	ReadCommitted(ctx context.Context, f func(context.Context) error) error
 }
 
-type MQSender interface {
-	SendMessage(
-		topicName string,
-		key []byte,
-		message []byte,
-		headers map[string]string,
-	) (partition int32, offset int64, err error)
-}
-
+// StatusOutboxRepository writes order status events to the outbox, the outbox dispatcher
+// publishes them.
 type StatusOutboxRepository interface {
 	CreateOrderStatusChangedEvent(ctx context.Context, orderID int64, status ordermodels.Status) error
-	FetchNextOrderStatusChangedEvent(ctx context.Context) (ordermodels.StatusChangedEvent, error)
-	MarkOrderStatusChangedEventAsSend(ctx context.Context, eventID int64) error
 }
 
 type Service struct {
 	orderRepository        Repository
 	stockService           StockService
 	txManager              TxManager
-	mqSender               MQSender
 	statusOutboxRepository StatusOutboxRepository
+	paymentTimeout         time.Duration
+	expiredCheckInterval   time.Duration
 	stopChan               chan struct{}
 }
 
@@ -52,20 +48,21 @@ func NewService(
 	repo Repository,
 	stockService StockService,
 	txManager TxManager,
-	mqSender MQSender,
 	statusOutboxRepository StatusOutboxRepository,
-
+	paymentTimeout time.Duration,
+	expiredCheckInterval time.Duration,
 ) *Service {
 	s := &Service{
 		orderRepository:        repo,
 		stockService:           stockService,
 		txManager:              txManager,
-		mqSender:               mqSender,
 		statusOutboxRepository: statusOutboxRepository,
+		paymentTimeout:         paymentTimeout,
+		expiredCheckInterval:   expiredCheckInterval,
 		stopChan:               make(chan struct{}),
 	}
 
-	go s.StartStatusChangedEventDispatcher(ctx)
+	go s.StartExpiredOrdersCanceller(ctx)
 
 	return s
 }
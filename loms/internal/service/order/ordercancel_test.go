@@ -7,7 +7,6 @@ import (
 
 	"github.com/BruteMors/marketplace-service/loms/internal/models"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
-	"github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/service/order/mock"
 	"github.com/gojuno/minimock/v3"
@@ -41,7 +40,7 @@ func TestServiceOrderCancel(t *testing.T) {
 			name:    "order not found",
 			orderID: 1,
 			mockOrderFunc: func() {
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 1).Return(ordermodels.Order{}, repository.ErrOrderNotFound)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 1).Return("", repository.ErrOrderNotFound)
 			},
 			expectedError: models.ErrOrderNotFound,
 		},
@@ -49,7 +48,7 @@ func TestServiceOrderCancel(t *testing.T) {
 			name:    "order repository error",
 			orderID: 2,
 			mockOrderFunc: func() {
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 2).Return(ordermodels.Order{}, errors.New("db error"))
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 2).Return("", errors.New("db error"))
 			},
 			expectedError: errors.New("db error"),
 		},
@@ -57,20 +56,10 @@ func TestServiceOrderCancel(t *testing.T) {
 			name:    "successful order cancel",
 			orderID: 3,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 100, Count: 2},
-						{SKU: 101, Count: 3},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 3).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 3).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockStockFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 100, Count: 2},
-					{SKU: 101, Count: 3},
-				}
-				stockServiceMock.ReserveCancelMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveCancelMock.Expect(ctx, 3).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 3, ordermodels.OrderStatusCancelled).Return(nil)
@@ -84,18 +73,10 @@ func TestServiceOrderCancel(t *testing.T) {
 			name:    "stock service error",
 			orderID: 4,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 200, Count: 1},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 4).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 4).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockStockFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 200, Count: 1},
-				}
-				stockServiceMock.ReserveCancelMock.Expect(ctx, items).Return(errors.New("stock service error"))
+				stockServiceMock.ReserveCancelMock.Expect(ctx, 4).Return(errors.New("stock service error"))
 			},
 			expectedError: errors.New("stock service error"),
 		},
@@ -103,18 +84,10 @@ func TestServiceOrderCancel(t *testing.T) {
 			name:    "order status update error",
 			orderID: 5,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 300, Count: 4},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 5).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 5).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockStockFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 300, Count: 4},
-				}
-				stockServiceMock.ReserveCancelMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveCancelMock.Expect(ctx, 5).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 5, ordermodels.OrderStatusCancelled).Return(errors.New("status update error"))
@@ -125,18 +98,10 @@ func TestServiceOrderCancel(t *testing.T) {
 			name:    "status outbox error",
 			orderID: 6,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 400, Count: 1},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 6).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 6).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockStockFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 400, Count: 1},
-				}
-				stockServiceMock.ReserveCancelMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveCancelMock.Expect(ctx, 6).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 6, ordermodels.OrderStatusCancelled).Return(nil)
@@ -146,6 +111,22 @@ func TestServiceOrderCancel(t *testing.T) {
 			},
 			expectedError: errors.New("status outbox error"),
 		},
+		{
+			name:    "order already in target status",
+			orderID: 7,
+			mockOrderFunc: func() {
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 7).Return(ordermodels.OrderStatusCancelled, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "invalid status transition",
+			orderID: 8,
+			mockOrderFunc: func() {
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 8).Return(ordermodels.OrderStatusPayed, nil)
+			},
+			expectedError: models.ErrInvalidOrderStatusTransition,
+		},
 	}
 
 	for _, tt := range tests {
@@ -163,7 +144,7 @@ func TestServiceOrderCancel(t *testing.T) {
 
 			err := s.orderCancel(ctx, tt.orderID)
 			if tt.expectedError != nil {
-				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.ErrorContains(t, err, tt.expectedError.Error())
 			} else {
 				assert.NoError(t, err)
 			}
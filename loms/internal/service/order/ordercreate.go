@@ -3,7 +3,6 @@ package order
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"github.com/BruteMors/marketplace-service/loms/internal/models"
@@ -24,13 +23,49 @@ func (s *Service) OrderCreate(ctx context.Context, create *requests.OrderCreate)
 
 	span.SetAttributes(
 		attribute.Int64("userID", create.User),
+		attribute.Bool("idempotent", create.IdempotencyKey != ""),
 	)
 
+	if create.IdempotencyKey != "" {
+		orderID, err = s.replayOrderCreate(ctx, create)
+		if !errors.Is(err, repository.ErrOrderNotFound) {
+			span.SetAttributes(
+				attribute.Int64("orderID", orderID),
+				attribute.Bool("replayed", true),
+			)
+			return orderID, err
+		}
+	}
+
+	orderID, err = s.orderCreate(ctx, create)
+	if err != nil {
+		return 0, err
+	}
+
 	span.SetAttributes(attribute.Int64("orderID", orderID))
 
 	return orderID, nil
 }
 
+// replayOrderCreate returns the outcome of the order already placed under the idempotency key of create:
+// its ID, or ErrOrderFailed when its items could not be reserved. It returns repository.ErrOrderNotFound
+// when there is no such order.
+func (s *Service) replayOrderCreate(ctx context.Context, create *requests.OrderCreate) (orderID int64, err error) {
+	orderID, status, err := s.orderRepository.GetByIdempotencyKey(ctx, create.User, create.IdempotencyKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if status == ordermodels.OrderStatusFailed {
+		return 0, models.ErrOrderFailed
+	}
+
+	return orderID, nil
+}
+
+// orderCreate places the order, reserves its items and records the resulting status in a single transaction,
+// so a crash in between leaves neither a stuck "new" order nor an orphaned reservation.
+// A rejected reservation still commits the order as failed.
 func (s *Service) orderCreate(ctx context.Context, create *requests.OrderCreate) (orderID int64, err error) {
 	items := make([]ordermodels.Item, 0, len(create.Items))
 
@@ -42,80 +77,57 @@ func (s *Service) orderCreate(ctx context.Context, create *requests.OrderCreate)
 	}
 
 	newOrder := ordermodels.NewOrder{
-		User:   create.User,
-		Items:  items,
-		Status: ordermodels.OrderStatusNew,
+		User:           create.User,
+		Items:          items,
+		Status:         ordermodels.OrderStatusNew,
+		IdempotencyKey: create.IdempotencyKey,
 	}
 
+	var reserveErr error
 	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		orderID, err = s.orderRepository.Create(ctx, newOrder)
-		if err != nil {
+		if errors.Is(err, repository.ErrOrderExists) {
+			// a concurrent request with the same key has just placed the order
+			orderID, err = s.replayOrderCreate(ctx, create)
 			return err
 		}
-		err = s.statusOutboxRepository.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusNew)
 		if err != nil {
 			return err
 		}
 
-		return nil
-	})
-
-	if err != nil {
-		return 0, err
-	}
-
-	err = s.stockService.Reserve(ctx, items)
-	if err != nil {
-		if errors.Is(err, repository.ErrSKUNotFound) {
-			err = models.ErrSKUNotFound
+		err = s.statusOutboxRepository.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusNew)
+		if err != nil {
+			return err
 		}
 
-		errTx := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-			errUpdateOrderStatus := s.orderRepository.SetStatus(ctx, orderID, ordermodels.OrderStatusFailed)
-			if errUpdateOrderStatus != nil {
-				return fmt.Errorf(
-					"failed to reserve items (%w) and failed to update order status (%w)",
-					err,
-					errUpdateOrderStatus,
-				)
-			}
+		status := ordermodels.OrderStatusAwaitingPayment
 
-			errCreateEvent := s.statusOutboxRepository.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusFailed)
-			if errCreateEvent != nil {
-				return fmt.Errorf(
-					"failed to reserve items (%w) and failed to create status changed event (%w)",
-					err,
-					errCreateEvent,
-				)
+		reserveErr = s.stockService.Reserve(ctx, orderID, items)
+		if reserveErr != nil {
+			if !errors.Is(reserveErr, repository.ErrSKUNotFound) && !errors.Is(reserveErr, repository.ErrInsufficientStock) {
+				return reserveErr
 			}
-
-			return nil
-		})
-
-		if errTx != nil {
-			return 0, errTx
+			status = ordermodels.OrderStatusFailed
 		}
 
-		return 0, err
-	}
-
-	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		errUpdateOrderStatus := s.orderRepository.SetStatus(ctx, orderID, ordermodels.OrderStatusAwaitingPayment)
-		if errUpdateOrderStatus != nil {
-			return errUpdateOrderStatus
-		}
-
-		errCreateEvent := s.statusOutboxRepository.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusAwaitingPayment)
-		if errCreateEvent != nil {
-			return errCreateEvent
+		err = s.orderRepository.SetStatus(ctx, orderID, status)
+		if err != nil {
+			return err
 		}
 
-		return nil
+		return s.statusOutboxRepository.CreateOrderStatusChangedEvent(ctx, orderID, status)
 	})
 
 	if err != nil {
 		return 0, err
 	}
 
+	if reserveErr != nil {
+		if errors.Is(reserveErr, repository.ErrSKUNotFound) {
+			return 0, models.ErrSKUNotFound
+		}
+		return 0, reserveErr
+	}
+
 	return orderID, nil
 }
@@ -19,9 +19,18 @@ func TestServiceOrderCreate(t *testing.T) {
 
 	orderRepositoryMock := mock.NewRepositoryMock(mc)
 	stockServiceMock := mock.NewStockServiceMock(mc)
+	statusOutboxRepositoryMock := mock.NewStatusOutboxRepositoryMock(mc)
+	txManagerMock := mock.NewTxManagerMock(mc)
+
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+
 	s := &Service{
-		orderRepository: orderRepositoryMock,
-		stockService:    stockServiceMock,
+		orderRepository:        orderRepositoryMock,
+		stockService:           stockServiceMock,
+		statusOutboxRepository: statusOutboxRepositoryMock,
+		txManager:              txManagerMock,
 	}
 
 	ctx := context.Background()
@@ -32,6 +41,7 @@ func TestServiceOrderCreate(t *testing.T) {
 		mockOrderCreateFunc func()
 		mockReserveFunc     func()
 		mockSetStatusFunc   func()
+		mockStatusOutbox    func()
 		expectedOrderID     int64
 		expectedError       error
 	}{
@@ -61,11 +71,15 @@ func TestServiceOrderCreate(t *testing.T) {
 					{SKU: 100, Count: 2},
 					{SKU: 101, Count: 3},
 				}
-				stockServiceMock.ReserveMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveMock.Expect(ctx, 12345, items).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 12345, ordermodels.OrderStatusAwaitingPayment).Return(nil)
 			},
+			mockStatusOutbox: func() {
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(ctx, 12345, ordermodels.OrderStatusNew).Then(nil)
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(ctx, 12345, ordermodels.OrderStatusAwaitingPayment).Then(nil)
+			},
 			expectedOrderID: 12345,
 			expectedError:   nil,
 		},
@@ -114,14 +128,49 @@ func TestServiceOrderCreate(t *testing.T) {
 				items := []ordermodels.Item{
 					{SKU: 300, Count: 4},
 				}
-				stockServiceMock.ReserveMock.Expect(ctx, items).Return(repository.ErrSKUNotFound)
+				stockServiceMock.ReserveMock.Expect(ctx, 67890, items).Return(repository.ErrSKUNotFound)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 67890, ordermodels.OrderStatusFailed).Return(nil)
 			},
+			mockStatusOutbox: func() {
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(ctx, 67890, ordermodels.OrderStatusNew).Then(nil)
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(ctx, 67890, ordermodels.OrderStatusFailed).Then(nil)
+			},
 			expectedOrderID: 0,
 			expectedError:   models.ErrSKUNotFound,
 		},
+		{
+			name: "reserve fails with database error",
+			request: &requests.OrderCreate{
+				User: 4,
+				Items: []requests.Item{
+					{SKU: 400, Count: 1},
+				},
+			},
+			mockOrderCreateFunc: func() {
+				items := []ordermodels.Item{
+					{SKU: 400, Count: 1},
+				}
+				newOrder := ordermodels.NewOrder{
+					User:   4,
+					Items:  items,
+					Status: ordermodels.OrderStatusNew,
+				}
+				orderRepositoryMock.CreateMock.Expect(ctx, newOrder).Return(int64(13579), nil)
+			},
+			mockReserveFunc: func() {
+				items := []ordermodels.Item{
+					{SKU: 400, Count: 1},
+				}
+				stockServiceMock.ReserveMock.Expect(ctx, 13579, items).Return(errors.New("db error"))
+			},
+			mockStatusOutbox: func() {
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(ctx, 13579, ordermodels.OrderStatusNew).Then(nil)
+			},
+			expectedOrderID: 0,
+			expectedError:   errors.New("db error"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -133,6 +182,9 @@ func TestServiceOrderCreate(t *testing.T) {
 			if tt.mockSetStatusFunc != nil {
 				tt.mockSetStatusFunc()
 			}
+			if tt.mockStatusOutbox != nil {
+				tt.mockStatusOutbox()
+			}
 
 			orderID, err := s.orderCreate(ctx, tt.request)
 			assert.Equal(t, tt.expectedOrderID, orderID)
@@ -144,3 +196,152 @@ func TestServiceOrderCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceOrderCreateIdempotency(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	orderRepositoryMock := mock.NewRepositoryMock(mc)
+	stockServiceMock := mock.NewStockServiceMock(mc)
+	statusOutboxRepositoryMock := mock.NewStatusOutboxRepositoryMock(mc)
+	txManagerMock := mock.NewTxManagerMock(mc)
+
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+
+	s := &Service{
+		orderRepository:        orderRepositoryMock,
+		stockService:           stockServiceMock,
+		statusOutboxRepository: statusOutboxRepositoryMock,
+		txManager:              txManagerMock,
+	}
+
+	ctx := context.Background()
+
+	items := []ordermodels.Item{
+		{SKU: 100, Count: 2},
+	}
+
+	tests := []struct {
+		name            string
+		request         *requests.OrderCreate
+		mockFunc        func()
+		expectedOrderID int64
+		expectedError   error
+	}{
+		{
+			name: "retried request returns original order",
+			request: &requests.OrderCreate{
+				User:           1,
+				Items:          []requests.Item{{SKU: 100, Count: 2}},
+				IdempotencyKey: "key-1",
+			},
+			mockFunc: func() {
+				orderRepositoryMock.GetByIdempotencyKeyMock.Expect(minimock.AnyContext, 1, "key-1").Return(int64(111), ordermodels.OrderStatusAwaitingPayment, nil)
+			},
+			expectedOrderID: 111,
+			expectedError:   nil,
+		},
+		{
+			name: "retried request of a failed order fails again",
+			request: &requests.OrderCreate{
+				User:           5,
+				Items:          []requests.Item{{SKU: 100, Count: 2}},
+				IdempotencyKey: "key-5",
+			},
+			mockFunc: func() {
+				orderRepositoryMock.GetByIdempotencyKeyMock.Expect(minimock.AnyContext, 5, "key-5").Return(int64(555), ordermodels.OrderStatusFailed, nil)
+			},
+			expectedOrderID: 0,
+			expectedError:   models.ErrOrderFailed,
+		},
+		{
+			name: "key lookup fails",
+			request: &requests.OrderCreate{
+				User:           2,
+				Items:          []requests.Item{{SKU: 100, Count: 2}},
+				IdempotencyKey: "key-2",
+			},
+			mockFunc: func() {
+				orderRepositoryMock.GetByIdempotencyKeyMock.Expect(minimock.AnyContext, 2, "key-2").Return(int64(0), ordermodels.Status(""), errors.New("db error"))
+			},
+			expectedOrderID: 0,
+			expectedError:   errors.New("db error"),
+		},
+		{
+			name: "new key creates order",
+			request: &requests.OrderCreate{
+				User:           3,
+				Items:          []requests.Item{{SKU: 100, Count: 2}},
+				IdempotencyKey: "key-3",
+			},
+			mockFunc: func() {
+				orderRepositoryMock.GetByIdempotencyKeyMock.Expect(minimock.AnyContext, 3, "key-3").Return(int64(0), ordermodels.Status(""), repository.ErrOrderNotFound)
+				orderRepositoryMock.CreateMock.Expect(minimock.AnyContext, ordermodels.NewOrder{
+					User:           3,
+					Items:          items,
+					Status:         ordermodels.OrderStatusNew,
+					IdempotencyKey: "key-3",
+				}).Return(int64(333), nil)
+				stockServiceMock.ReserveMock.Expect(minimock.AnyContext, 333, items).Return(nil)
+				orderRepositoryMock.SetStatusMock.Expect(minimock.AnyContext, 333, ordermodels.OrderStatusAwaitingPayment).Return(nil)
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(minimock.AnyContext, 333, ordermodels.OrderStatusNew).Then(nil)
+				statusOutboxRepositoryMock.CreateOrderStatusChangedEventMock.When(minimock.AnyContext, 333, ordermodels.OrderStatusAwaitingPayment).Then(nil)
+			},
+			expectedOrderID: 333,
+			expectedError:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			orderID, err := s.OrderCreate(ctx, tt.request)
+			assert.Equal(t, tt.expectedOrderID, orderID)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceOrderCreateConcurrentIdempotencyKey(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	orderRepositoryMock := mock.NewRepositoryMock(mc)
+	txManagerMock := mock.NewTxManagerMock(mc)
+
+	txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f func(context.Context) error) error {
+		return f(ctx)
+	})
+
+	s := &Service{
+		orderRepository: orderRepositoryMock,
+		txManager:       txManagerMock,
+	}
+
+	ctx := context.Background()
+
+	lookups := 0
+	orderRepositoryMock.GetByIdempotencyKeyMock.Set(func(_ context.Context, userID int64, key string) (int64, ordermodels.Status, error) {
+		lookups++
+		if lookups == 1 {
+			return 0, "", repository.ErrOrderNotFound
+		}
+		return 444, ordermodels.OrderStatusAwaitingPayment, nil
+	})
+	orderRepositoryMock.CreateMock.Return(0, repository.ErrOrderExists)
+
+	orderID, err := s.OrderCreate(ctx, &requests.OrderCreate{
+		User:           4,
+		Items:          []requests.Item{{SKU: 100, Count: 2}},
+		IdempotencyKey: "key-4",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(444), orderID)
+	assert.Equal(t, 2, lookups)
+}
@@ -2,13 +2,9 @@ package order
 
 import (
 	"context"
-	"errors"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
-	"github.com/BruteMors/marketplace-service/loms/internal/models"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
-	"github.com/BruteMors/marketplace-service/loms/internal/models/stock"
-	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -31,24 +27,16 @@ func (s *Service) OrderPay(ctx context.Context, orderID int64) (err error) {
 }
 
 func (s *Service) orderPay(ctx context.Context, orderID int64) error {
-	order, err := s.orderRepository.GetByID(ctx, orderID)
+	alreadyDone, err := s.checkStatusTransition(ctx, orderID, ordermodels.OrderStatusPayed)
 	if err != nil {
-		if errors.Is(err, repository.ErrOrderNotFound) {
-			return models.ErrOrderNotFound
-		}
 		return err
 	}
 
-	items := make([]stock.ReserveItem, 0, len(order.Items))
-
-	for _, i := range order.Items {
-		items = append(items, stock.ReserveItem{
-			SKU:   i.SKU,
-			Count: i.Count,
-		})
+	if alreadyDone {
+		return nil
 	}
 
-	err = s.stockService.ReserveRemove(ctx, items)
+	err = s.stockService.ReserveRemove(ctx, orderID)
 	if err != nil {
 		return err
 	}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/BruteMors/marketplace-service/loms/internal/models"
 	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
-	"github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/service/order/mock"
 	"github.com/gojuno/minimock/v3"
@@ -44,7 +43,7 @@ func TestServiceOrderPay(t *testing.T) {
 			name:    "order not found",
 			orderID: 1,
 			mockOrderFunc: func() {
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 1).Return(ordermodels.Order{}, repository.ErrOrderNotFound)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 1).Return("", repository.ErrOrderNotFound)
 			},
 			expectedError: models.ErrOrderNotFound,
 		},
@@ -52,7 +51,7 @@ func TestServiceOrderPay(t *testing.T) {
 			name:    "order repository error",
 			orderID: 2,
 			mockOrderFunc: func() {
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 2).Return(ordermodels.Order{}, errors.New("database error"))
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 2).Return("", errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 		},
@@ -60,20 +59,10 @@ func TestServiceOrderPay(t *testing.T) {
 			name:    "successful payment",
 			orderID: 3,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 100, Count: 2},
-						{SKU: 101, Count: 1},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 3).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 3).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockReserveFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 100, Count: 2},
-					{SKU: 101, Count: 1},
-				}
-				stockServiceMock.ReserveRemoveMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveRemoveMock.Expect(ctx, 3).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 3, ordermodels.OrderStatusPayed).Return(nil)
@@ -87,18 +76,10 @@ func TestServiceOrderPay(t *testing.T) {
 			name:    "reserve removal error",
 			orderID: 4,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 200, Count: 1},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 4).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 4).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockReserveFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 200, Count: 1},
-				}
-				stockServiceMock.ReserveRemoveMock.Expect(ctx, items).Return(errors.New("reserve removal error"))
+				stockServiceMock.ReserveRemoveMock.Expect(ctx, 4).Return(errors.New("reserve removal error"))
 			},
 			expectedError: errors.New("reserve removal error"),
 		},
@@ -106,18 +87,10 @@ func TestServiceOrderPay(t *testing.T) {
 			name:    "order status update error",
 			orderID: 5,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 300, Count: 4},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 5).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 5).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockReserveFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 300, Count: 4},
-				}
-				stockServiceMock.ReserveRemoveMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveRemoveMock.Expect(ctx, 5).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 5, ordermodels.OrderStatusPayed).Return(errors.New("status update error"))
@@ -128,18 +101,10 @@ func TestServiceOrderPay(t *testing.T) {
 			name:    "status outbox error",
 			orderID: 6,
 			mockOrderFunc: func() {
-				order := ordermodels.Order{
-					Items: []ordermodels.Item{
-						{SKU: 400, Count: 1},
-					},
-				}
-				orderRepositoryMock.GetByIDMock.Expect(ctx, 6).Return(order, nil)
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 6).Return(ordermodels.OrderStatusAwaitingPayment, nil)
 			},
 			mockReserveFunc: func() {
-				items := []stock.ReserveItem{
-					{SKU: 400, Count: 1},
-				}
-				stockServiceMock.ReserveRemoveMock.Expect(ctx, items).Return(nil)
+				stockServiceMock.ReserveRemoveMock.Expect(ctx, 6).Return(nil)
 			},
 			mockSetStatusFunc: func() {
 				orderRepositoryMock.SetStatusMock.Expect(ctx, 6, ordermodels.OrderStatusPayed).Return(nil)
@@ -149,6 +114,22 @@ func TestServiceOrderPay(t *testing.T) {
 			},
 			expectedError: errors.New("status outbox error"),
 		},
+		{
+			name:    "order already in target status",
+			orderID: 7,
+			mockOrderFunc: func() {
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 7).Return(ordermodels.OrderStatusPayed, nil)
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "invalid status transition",
+			orderID: 8,
+			mockOrderFunc: func() {
+				orderRepositoryMock.GetStatusForUpdateMock.Expect(ctx, 8).Return(ordermodels.OrderStatusCancelled, nil)
+			},
+			expectedError: models.ErrInvalidOrderStatusTransition,
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,7 +147,7 @@ func TestServiceOrderPay(t *testing.T) {
 
 			err := s.orderPay(ctx, tt.orderID)
 			if tt.expectedError != nil {
-				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.ErrorContains(t, err, tt.expectedError.Error())
 			} else {
 				assert.NoError(t, err)
 			}
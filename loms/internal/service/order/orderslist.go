@@ -0,0 +1,123 @@
+package order
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/requests"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/responses"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const defaultOrdersListLimit = 20
+
+func (s *Service) OrdersList(ctx context.Context, req *requests.OrdersList) (list responses.OrdersList, err error) {
+	tr := otel.Tracer("orderService")
+	ctx, span := tr.Start(ctx, "OrdersList")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", req.User))
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultOrdersListLimit
+	}
+
+	filter := ordermodels.ListFilter{
+		UserID:      req.User,
+		Statuses:    req.Statuses,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		// one extra order tells whether there is a next page
+		Limit: limit + 1,
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeListCursor(req.Cursor)
+		if err != nil {
+			return responses.OrdersList{}, models.ErrInvalidCursor
+		}
+		filter.After = &cursor
+	}
+
+	orders, err := s.orderRepository.ListByUser(ctx, filter)
+	if err != nil {
+		return responses.OrdersList{}, err
+	}
+
+	if len(orders) > int(limit) {
+		orders = orders[:limit]
+		last := orders[len(orders)-1]
+		list.NextCursor = encodeListCursor(ordermodels.ListCursor{
+			CreatedAt: last.CreatedAt,
+			OrderID:   last.ID,
+		})
+	}
+
+	list.Orders = make([]responses.Order, 0, len(orders))
+	for _, order := range orders {
+		items := make([]responses.Item, 0, len(order.Items))
+		for _, i := range order.Items {
+			items = append(items, responses.Item{
+				SKU:   i.SKU,
+				Count: i.Count,
+			})
+		}
+
+		list.Orders = append(list.Orders, responses.Order{
+			ID:        order.ID,
+			Status:    order.Status,
+			User:      order.UserID,
+			Items:     items,
+			CreatedAt: order.CreatedAt,
+			UpdatedAt: order.UpdatedAt,
+		})
+	}
+
+	span.SetAttributes(attribute.Int("orders", len(list.Orders)))
+
+	return list, nil
+}
+
+// encodeListCursor packs the position of the last returned order into an opaque token.
+func encodeListCursor(cursor ordermodels.ListCursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.UnixMicro(), 10) + ":" + strconv.FormatInt(cursor.OrderID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeListCursor(token string) (ordermodels.ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ordermodels.ListCursor{}, err
+	}
+
+	createdAtPart, orderIDPart, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return ordermodels.ListCursor{}, models.ErrInvalidCursor
+	}
+
+	createdAt, err := strconv.ParseInt(createdAtPart, 10, 64)
+	if err != nil {
+		return ordermodels.ListCursor{}, err
+	}
+
+	orderID, err := strconv.ParseInt(orderIDPart, 10, 64)
+	if err != nil {
+		return ordermodels.ListCursor{}, err
+	}
+
+	return ordermodels.ListCursor{
+		CreatedAt: time.UnixMicro(createdAt).UTC(),
+		OrderID:   orderID,
+	}, nil
+}
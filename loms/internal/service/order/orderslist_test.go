@@ -0,0 +1,157 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/requests"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/responses"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/order/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceOrdersList(t *testing.T) {
+	mc := minimock.NewController(t)
+	orderRepositoryMock := mock.NewRepositoryMock(mc)
+	s := &Service{
+		orderRepository: orderRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	firstCreatedAt := time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC)
+	secondCreatedAt := firstCreatedAt.Add(-time.Hour)
+	cursor := order.ListCursor{CreatedAt: secondCreatedAt, OrderID: 7}
+
+	firstOrder := order.Order{
+		ID:        8,
+		Status:    order.OrderStatusPayed,
+		UserID:    123,
+		Items:     []order.Item{{SKU: 100, Count: 2}},
+		CreatedAt: firstCreatedAt,
+		UpdatedAt: &firstCreatedAt,
+	}
+	secondOrder := order.Order{
+		ID:        7,
+		Status:    order.OrderStatusAwaitingPayment,
+		UserID:    123,
+		Items:     []order.Item{{SKU: 101, Count: 1}},
+		CreatedAt: secondCreatedAt,
+		UpdatedAt: &secondCreatedAt,
+	}
+
+	tests := []struct {
+		name             string
+		req              *requests.OrdersList
+		mockOrderFunc    func()
+		expectedResponse responses.OrdersList
+		expectedError    error
+	}{
+		{
+			name:             "invalid cursor",
+			req:              &requests.OrdersList{User: 123, Cursor: "not a cursor"},
+			mockOrderFunc:    func() {},
+			expectedResponse: responses.OrdersList{},
+			expectedError:    models.ErrInvalidCursor,
+		},
+		{
+			name: "database error",
+			req:  &requests.OrdersList{User: 123},
+			mockOrderFunc: func() {
+				orderRepositoryMock.ListByUserMock.Expect(minimock.AnyContext, order.ListFilter{
+					UserID: 123,
+					Limit:  defaultOrdersListLimit + 1,
+				}).Return(nil, errors.New("database error"))
+			},
+			expectedResponse: responses.OrdersList{},
+			expectedError:    errors.New("database error"),
+		},
+		{
+			name: "page with next cursor",
+			req: &requests.OrdersList{
+				User:     123,
+				Statuses: []order.Status{order.OrderStatusPayed, order.OrderStatusAwaitingPayment},
+				Limit:    1,
+			},
+			mockOrderFunc: func() {
+				orderRepositoryMock.ListByUserMock.Expect(minimock.AnyContext, order.ListFilter{
+					UserID:   123,
+					Statuses: []order.Status{order.OrderStatusPayed, order.OrderStatusAwaitingPayment},
+					Limit:    2,
+				}).Return([]order.Order{firstOrder, secondOrder}, nil)
+			},
+			expectedResponse: responses.OrdersList{
+				Orders: []responses.Order{
+					{
+						ID:        8,
+						Status:    order.OrderStatusPayed,
+						User:      123,
+						Items:     []responses.Item{{SKU: 100, Count: 2}},
+						CreatedAt: firstCreatedAt,
+						UpdatedAt: &firstCreatedAt,
+					},
+				},
+				NextCursor: encodeListCursor(order.ListCursor{CreatedAt: firstCreatedAt, OrderID: 8}),
+			},
+			expectedError: nil,
+		},
+		{
+			name: "last page",
+			req: &requests.OrdersList{
+				User:   123,
+				Cursor: encodeListCursor(cursor),
+				Limit:  5,
+			},
+			mockOrderFunc: func() {
+				orderRepositoryMock.ListByUserMock.Expect(minimock.AnyContext, order.ListFilter{
+					UserID: 123,
+					After:  &cursor,
+					Limit:  6,
+				}).Return([]order.Order{secondOrder}, nil)
+			},
+			expectedResponse: responses.OrdersList{
+				Orders: []responses.Order{
+					{
+						ID:        7,
+						Status:    order.OrderStatusAwaitingPayment,
+						User:      123,
+						Items:     []responses.Item{{SKU: 101, Count: 1}},
+						CreatedAt: secondCreatedAt,
+						UpdatedAt: &secondCreatedAt,
+					},
+				},
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockOrderFunc()
+			response, err := s.OrdersList(ctx, tt.req)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.Equal(t, tt.expectedResponse, response)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, response)
+			}
+		})
+	}
+}
+
+func TestListCursorRoundTrip(t *testing.T) {
+	cursor := order.ListCursor{
+		CreatedAt: time.Date(2024, 8, 5, 12, 30, 15, 123456000, time.UTC),
+		OrderID:   42,
+	}
+
+	decoded, err := decodeListCursor(encodeListCursor(cursor))
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+)
+
+// checkStatusTransition locks the order row until the end of the transaction and
+// reports whether the order is already in the requested status.
+func (s *Service) checkStatusTransition(
+	ctx context.Context,
+	orderID int64,
+	next ordermodels.Status,
+) (alreadyDone bool, err error) {
+	current, err := s.orderRepository.GetStatusForUpdate(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return false, models.ErrOrderNotFound
+		}
+		return false, err
+	}
+
+	if current == next {
+		return true, nil
+	}
+
+	if !current.CanTransitionTo(next) {
+		return false, fmt.Errorf("%w: %s -> %s", models.ErrInvalidOrderStatusTransition, current, next)
+	}
+
+	return false, nil
+}
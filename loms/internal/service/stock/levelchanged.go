@@ -0,0 +1,17 @@
+package stock
+
+import (
+	"context"
+
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+)
+
+// createLevelChangedEvents records availability changes in the outbox.
+// It must run inside the transaction that changed the stock.
+func (s *Service) createLevelChangedEvents(ctx context.Context, changes []stockmodels.LevelChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return s.stockOutboxRepository.CreateStockLevelChangedEvents(ctx, changes)
+}
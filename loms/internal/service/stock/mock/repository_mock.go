@@ -25,20 +25,32 @@ type RepositoryMock struct {
 	beforeGetBySKUCounter uint64
 	GetBySKUMock          mRepositoryMockGetBySKU
 
-	funcReserve          func(ctx context.Context, item []stockmodels.ReserveItem) (err error)
-	inspectFuncReserve   func(ctx context.Context, item []stockmodels.ReserveItem)
+	funcGetBySKUs          func(ctx context.Context, skuIDs []uint32) (ia1 []stockmodels.Item, err error)
+	inspectFuncGetBySKUs   func(ctx context.Context, skuIDs []uint32)
+	afterGetBySKUsCounter  uint64
+	beforeGetBySKUsCounter uint64
+	GetBySKUsMock          mRepositoryMockGetBySKUs
+
+	funcGetReservationDrift          func(ctx context.Context) (ra1 []stockmodels.ReservationDrift, err error)
+	inspectFuncGetReservationDrift   func(ctx context.Context)
+	afterGetReservationDriftCounter  uint64
+	beforeGetReservationDriftCounter uint64
+	GetReservationDriftMock          mRepositoryMockGetReservationDrift
+
+	funcReserve          func(ctx context.Context, orderID int64, item []stockmodels.ReserveItem) (la1 []stockmodels.LevelChange, err error)
+	inspectFuncReserve   func(ctx context.Context, orderID int64, item []stockmodels.ReserveItem)
 	afterReserveCounter  uint64
 	beforeReserveCounter uint64
 	ReserveMock          mRepositoryMockReserve
 
-	funcReserveCancel          func(ctx context.Context, item []stockmodels.ReserveItem) (err error)
-	inspectFuncReserveCancel   func(ctx context.Context, item []stockmodels.ReserveItem)
+	funcReserveCancel          func(ctx context.Context, orderID int64) (la1 []stockmodels.LevelChange, err error)
+	inspectFuncReserveCancel   func(ctx context.Context, orderID int64)
 	afterReserveCancelCounter  uint64
 	beforeReserveCancelCounter uint64
 	ReserveCancelMock          mRepositoryMockReserveCancel
 
-	funcReserveRemove          func(ctx context.Context, item []stockmodels.ReserveItem) (err error)
-	inspectFuncReserveRemove   func(ctx context.Context, item []stockmodels.ReserveItem)
+	funcReserveRemove          func(ctx context.Context, orderID int64) (err error)
+	inspectFuncReserveRemove   func(ctx context.Context, orderID int64)
 	afterReserveRemoveCounter  uint64
 	beforeReserveRemoveCounter uint64
 	ReserveRemoveMock          mRepositoryMockReserveRemove
@@ -55,6 +67,12 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.GetBySKUMock = mRepositoryMockGetBySKU{mock: m}
 	m.GetBySKUMock.callArgs = []*RepositoryMockGetBySKUParams{}
 
+	m.GetBySKUsMock = mRepositoryMockGetBySKUs{mock: m}
+	m.GetBySKUsMock.callArgs = []*RepositoryMockGetBySKUsParams{}
+
+	m.GetReservationDriftMock = mRepositoryMockGetReservationDrift{mock: m}
+	m.GetReservationDriftMock.callArgs = []*RepositoryMockGetReservationDriftParams{}
+
 	m.ReserveMock = mRepositoryMockReserve{mock: m}
 	m.ReserveMock.callArgs = []*RepositoryMockReserveParams{}
 
@@ -390,6 +408,620 @@ func (m *RepositoryMock) MinimockGetBySKUInspect() {
 	}
 }
 
+type mRepositoryMockGetBySKUs struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetBySKUsExpectation
+	expectations       []*RepositoryMockGetBySKUsExpectation
+
+	callArgs []*RepositoryMockGetBySKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockGetBySKUsExpectation specifies expectation struct of the Repository.GetBySKUs
+type RepositoryMockGetBySKUsExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockGetBySKUsParams
+	paramPtrs *RepositoryMockGetBySKUsParamPtrs
+	results   *RepositoryMockGetBySKUsResults
+	Counter   uint64
+}
+
+// RepositoryMockGetBySKUsParams contains parameters of the Repository.GetBySKUs
+type RepositoryMockGetBySKUsParams struct {
+	ctx    context.Context
+	skuIDs []uint32
+}
+
+// RepositoryMockGetBySKUsParamPtrs contains pointers to parameters of the Repository.GetBySKUs
+type RepositoryMockGetBySKUsParamPtrs struct {
+	ctx    *context.Context
+	skuIDs *[]uint32
+}
+
+// RepositoryMockGetBySKUsResults contains results of the Repository.GetBySKUs
+type RepositoryMockGetBySKUsResults struct {
+	ia1 []stockmodels.Item
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Optional() *mRepositoryMockGetBySKUs {
+	mmGetBySKUs.optional = true
+	return mmGetBySKUs
+}
+
+// Expect sets up expected params for Repository.GetBySKUs
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Expect(ctx context.Context, skuIDs []uint32) *mRepositoryMockGetBySKUs {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &RepositoryMockGetBySKUsExpectation{}
+	}
+
+	if mmGetBySKUs.defaultExpectation.paramPtrs != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by ExpectParams functions")
+	}
+
+	mmGetBySKUs.defaultExpectation.params = &RepositoryMockGetBySKUsParams{ctx, skuIDs}
+	for _, e := range mmGetBySKUs.expectations {
+		if minimock.Equal(e.params, mmGetBySKUs.defaultExpectation.params) {
+			mmGetBySKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBySKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBySKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetBySKUs
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetBySKUs {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &RepositoryMockGetBySKUsExpectation{}
+	}
+
+	if mmGetBySKUs.defaultExpectation.params != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Expect")
+	}
+
+	if mmGetBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetBySKUs.defaultExpectation.paramPtrs = &RepositoryMockGetBySKUsParamPtrs{}
+	}
+	mmGetBySKUs.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetBySKUs
+}
+
+// ExpectSkuIDsParam2 sets up expected param skuIDs for Repository.GetBySKUs
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) ExpectSkuIDsParam2(skuIDs []uint32) *mRepositoryMockGetBySKUs {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &RepositoryMockGetBySKUsExpectation{}
+	}
+
+	if mmGetBySKUs.defaultExpectation.params != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Expect")
+	}
+
+	if mmGetBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetBySKUs.defaultExpectation.paramPtrs = &RepositoryMockGetBySKUsParamPtrs{}
+	}
+	mmGetBySKUs.defaultExpectation.paramPtrs.skuIDs = &skuIDs
+
+	return mmGetBySKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetBySKUs
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Inspect(f func(ctx context.Context, skuIDs []uint32)) *mRepositoryMockGetBySKUs {
+	if mmGetBySKUs.mock.inspectFuncGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetBySKUs")
+	}
+
+	mmGetBySKUs.mock.inspectFuncGetBySKUs = f
+
+	return mmGetBySKUs
+}
+
+// Return sets up results that will be returned by Repository.GetBySKUs
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Return(ia1 []stockmodels.Item, err error) *RepositoryMock {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Set")
+	}
+
+	if mmGetBySKUs.defaultExpectation == nil {
+		mmGetBySKUs.defaultExpectation = &RepositoryMockGetBySKUsExpectation{mock: mmGetBySKUs.mock}
+	}
+	mmGetBySKUs.defaultExpectation.results = &RepositoryMockGetBySKUsResults{ia1, err}
+	return mmGetBySKUs.mock
+}
+
+// Set uses given function f to mock the Repository.GetBySKUs method
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Set(f func(ctx context.Context, skuIDs []uint32) (ia1 []stockmodels.Item, err error)) *RepositoryMock {
+	if mmGetBySKUs.defaultExpectation != nil {
+		mmGetBySKUs.mock.t.Fatalf("Default expectation is already set for the Repository.GetBySKUs method")
+	}
+
+	if len(mmGetBySKUs.expectations) > 0 {
+		mmGetBySKUs.mock.t.Fatalf("Some expectations are already set for the Repository.GetBySKUs method")
+	}
+
+	mmGetBySKUs.mock.funcGetBySKUs = f
+	return mmGetBySKUs.mock
+}
+
+// When sets expectation for the Repository.GetBySKUs which will trigger the result defined by the following
+// Then helper
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) When(ctx context.Context, skuIDs []uint32) *RepositoryMockGetBySKUsExpectation {
+	if mmGetBySKUs.mock.funcGetBySKUs != nil {
+		mmGetBySKUs.mock.t.Fatalf("RepositoryMock.GetBySKUs mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetBySKUsExpectation{
+		mock:   mmGetBySKUs.mock,
+		params: &RepositoryMockGetBySKUsParams{ctx, skuIDs},
+	}
+	mmGetBySKUs.expectations = append(mmGetBySKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetBySKUs return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetBySKUsExpectation) Then(ia1 []stockmodels.Item, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetBySKUsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetBySKUs should be invoked
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Times(n uint64) *mRepositoryMockGetBySKUs {
+	if n == 0 {
+		mmGetBySKUs.mock.t.Fatalf("Times of RepositoryMock.GetBySKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBySKUs.expectedInvocations, n)
+	return mmGetBySKUs
+}
+
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) invocationsDone() bool {
+	if len(mmGetBySKUs.expectations) == 0 && mmGetBySKUs.defaultExpectation == nil && mmGetBySKUs.mock.funcGetBySKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBySKUs.mock.afterGetBySKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBySKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBySKUs implements stock.Repository
+func (mmGetBySKUs *RepositoryMock) GetBySKUs(ctx context.Context, skuIDs []uint32) (ia1 []stockmodels.Item, err error) {
+	mm_atomic.AddUint64(&mmGetBySKUs.beforeGetBySKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBySKUs.afterGetBySKUsCounter, 1)
+
+	if mmGetBySKUs.inspectFuncGetBySKUs != nil {
+		mmGetBySKUs.inspectFuncGetBySKUs(ctx, skuIDs)
+	}
+
+	mm_params := RepositoryMockGetBySKUsParams{ctx, skuIDs}
+
+	// Record call args
+	mmGetBySKUs.GetBySKUsMock.mutex.Lock()
+	mmGetBySKUs.GetBySKUsMock.callArgs = append(mmGetBySKUs.GetBySKUsMock.callArgs, &mm_params)
+	mmGetBySKUs.GetBySKUsMock.mutex.Unlock()
+
+	for _, e := range mmGetBySKUs.GetBySKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmGetBySKUs.GetBySKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBySKUs.GetBySKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBySKUs.GetBySKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBySKUs.GetBySKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetBySKUsParams{ctx, skuIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBySKUs.t.Errorf("RepositoryMock.GetBySKUs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuIDs != nil && !minimock.Equal(*mm_want_ptrs.skuIDs, mm_got.skuIDs) {
+				mmGetBySKUs.t.Errorf("RepositoryMock.GetBySKUs got unexpected parameter skuIDs, want: %#v, got: %#v%s\n", *mm_want_ptrs.skuIDs, mm_got.skuIDs, minimock.Diff(*mm_want_ptrs.skuIDs, mm_got.skuIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBySKUs.t.Errorf("RepositoryMock.GetBySKUs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBySKUs.GetBySKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBySKUs.t.Fatal("No results are set for the RepositoryMock.GetBySKUs")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmGetBySKUs.funcGetBySKUs != nil {
+		return mmGetBySKUs.funcGetBySKUs(ctx, skuIDs)
+	}
+	mmGetBySKUs.t.Fatalf("Unexpected call to RepositoryMock.GetBySKUs. %v %v", ctx, skuIDs)
+	return
+}
+
+// GetBySKUsAfterCounter returns a count of finished RepositoryMock.GetBySKUs invocations
+func (mmGetBySKUs *RepositoryMock) GetBySKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySKUs.afterGetBySKUsCounter)
+}
+
+// GetBySKUsBeforeCounter returns a count of RepositoryMock.GetBySKUs invocations
+func (mmGetBySKUs *RepositoryMock) GetBySKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBySKUs.beforeGetBySKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetBySKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBySKUs *mRepositoryMockGetBySKUs) Calls() []*RepositoryMockGetBySKUsParams {
+	mmGetBySKUs.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetBySKUsParams, len(mmGetBySKUs.callArgs))
+	copy(argCopy, mmGetBySKUs.callArgs)
+
+	mmGetBySKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBySKUsDone returns true if the count of the GetBySKUs invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetBySKUsDone() bool {
+	if m.GetBySKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBySKUsMock.invocationsDone()
+}
+
+// MinimockGetBySKUsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetBySKUsInspect() {
+	for _, e := range m.GetBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetBySKUs with params: %#v", *e.params)
+		}
+	}
+
+	afterGetBySKUsCounter := mm_atomic.LoadUint64(&m.afterGetBySKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBySKUsMock.defaultExpectation != nil && afterGetBySKUsCounter < 1 {
+		if m.GetBySKUsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetBySKUs")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetBySKUs with params: %#v", *m.GetBySKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBySKUs != nil && afterGetBySKUsCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetBySKUs")
+	}
+
+	if !m.GetBySKUsMock.invocationsDone() && afterGetBySKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetBySKUs but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBySKUsMock.expectedInvocations), afterGetBySKUsCounter)
+	}
+}
+
+type mRepositoryMockGetReservationDrift struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetReservationDriftExpectation
+	expectations       []*RepositoryMockGetReservationDriftExpectation
+
+	callArgs []*RepositoryMockGetReservationDriftParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RepositoryMockGetReservationDriftExpectation specifies expectation struct of the Repository.GetReservationDrift
+type RepositoryMockGetReservationDriftExpectation struct {
+	mock      *RepositoryMock
+	params    *RepositoryMockGetReservationDriftParams
+	paramPtrs *RepositoryMockGetReservationDriftParamPtrs
+	results   *RepositoryMockGetReservationDriftResults
+	Counter   uint64
+}
+
+// RepositoryMockGetReservationDriftParams contains parameters of the Repository.GetReservationDrift
+type RepositoryMockGetReservationDriftParams struct {
+	ctx context.Context
+}
+
+// RepositoryMockGetReservationDriftParamPtrs contains pointers to parameters of the Repository.GetReservationDrift
+type RepositoryMockGetReservationDriftParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryMockGetReservationDriftResults contains results of the Repository.GetReservationDrift
+type RepositoryMockGetReservationDriftResults struct {
+	ra1 []stockmodels.ReservationDrift
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Optional() *mRepositoryMockGetReservationDrift {
+	mmGetReservationDrift.optional = true
+	return mmGetReservationDrift
+}
+
+// Expect sets up expected params for Repository.GetReservationDrift
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Expect(ctx context.Context) *mRepositoryMockGetReservationDrift {
+	if mmGetReservationDrift.mock.funcGetReservationDrift != nil {
+		mmGetReservationDrift.mock.t.Fatalf("RepositoryMock.GetReservationDrift mock is already set by Set")
+	}
+
+	if mmGetReservationDrift.defaultExpectation == nil {
+		mmGetReservationDrift.defaultExpectation = &RepositoryMockGetReservationDriftExpectation{}
+	}
+
+	if mmGetReservationDrift.defaultExpectation.paramPtrs != nil {
+		mmGetReservationDrift.mock.t.Fatalf("RepositoryMock.GetReservationDrift mock is already set by ExpectParams functions")
+	}
+
+	mmGetReservationDrift.defaultExpectation.params = &RepositoryMockGetReservationDriftParams{ctx}
+	for _, e := range mmGetReservationDrift.expectations {
+		if minimock.Equal(e.params, mmGetReservationDrift.defaultExpectation.params) {
+			mmGetReservationDrift.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReservationDrift.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReservationDrift
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetReservationDrift
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetReservationDrift {
+	if mmGetReservationDrift.mock.funcGetReservationDrift != nil {
+		mmGetReservationDrift.mock.t.Fatalf("RepositoryMock.GetReservationDrift mock is already set by Set")
+	}
+
+	if mmGetReservationDrift.defaultExpectation == nil {
+		mmGetReservationDrift.defaultExpectation = &RepositoryMockGetReservationDriftExpectation{}
+	}
+
+	if mmGetReservationDrift.defaultExpectation.params != nil {
+		mmGetReservationDrift.mock.t.Fatalf("RepositoryMock.GetReservationDrift mock is already set by Expect")
+	}
+
+	if mmGetReservationDrift.defaultExpectation.paramPtrs == nil {
+		mmGetReservationDrift.defaultExpectation.paramPtrs = &RepositoryMockGetReservationDriftParamPtrs{}
+	}
+	mmGetReservationDrift.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetReservationDrift
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetReservationDrift
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Inspect(f func(ctx context.Context)) *mRepositoryMockGetReservationDrift {
+	if mmGetReservationDrift.mock.inspectFuncGetReservationDrift != nil {
+		mmGetReservationDrift.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetReservationDrift")
+	}
+
+	mmGetReservationDrift.mock.inspectFuncGetReservationDrift = f
+
+	return mmGetReservationDrift
+}
+
+// Return sets up results that will be returned by Repository.GetReservationDrift
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Return(ra1 []stockmodels.ReservationDrift, err error) *RepositoryMock {
+	if mmGetReservationDrift.mock.funcGetReservationDrift != nil {
+		mmGetReservationDrift.mock.t.Fatalf("RepositoryMock.GetReservationDrift mock is already set by Set")
+	}
+
+	if mmGetReservationDrift.defaultExpectation == nil {
+		mmGetReservationDrift.defaultExpectation = &RepositoryMockGetReservationDriftExpectation{mock: mmGetReservationDrift.mock}
+	}
+	mmGetReservationDrift.defaultExpectation.results = &RepositoryMockGetReservationDriftResults{ra1, err}
+	return mmGetReservationDrift.mock
+}
+
+// Set uses given function f to mock the Repository.GetReservationDrift method
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Set(f func(ctx context.Context) (ra1 []stockmodels.ReservationDrift, err error)) *RepositoryMock {
+	if mmGetReservationDrift.defaultExpectation != nil {
+		mmGetReservationDrift.mock.t.Fatalf("Default expectation is already set for the Repository.GetReservationDrift method")
+	}
+
+	if len(mmGetReservationDrift.expectations) > 0 {
+		mmGetReservationDrift.mock.t.Fatalf("Some expectations are already set for the Repository.GetReservationDrift method")
+	}
+
+	mmGetReservationDrift.mock.funcGetReservationDrift = f
+	return mmGetReservationDrift.mock
+}
+
+// When sets expectation for the Repository.GetReservationDrift which will trigger the result defined by the following
+// Then helper
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) When(ctx context.Context) *RepositoryMockGetReservationDriftExpectation {
+	if mmGetReservationDrift.mock.funcGetReservationDrift != nil {
+		mmGetReservationDrift.mock.t.Fatalf("RepositoryMock.GetReservationDrift mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetReservationDriftExpectation{
+		mock:   mmGetReservationDrift.mock,
+		params: &RepositoryMockGetReservationDriftParams{ctx},
+	}
+	mmGetReservationDrift.expectations = append(mmGetReservationDrift.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetReservationDrift return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetReservationDriftExpectation) Then(ra1 []stockmodels.ReservationDrift, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetReservationDriftResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetReservationDrift should be invoked
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Times(n uint64) *mRepositoryMockGetReservationDrift {
+	if n == 0 {
+		mmGetReservationDrift.mock.t.Fatalf("Times of RepositoryMock.GetReservationDrift mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReservationDrift.expectedInvocations, n)
+	return mmGetReservationDrift
+}
+
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) invocationsDone() bool {
+	if len(mmGetReservationDrift.expectations) == 0 && mmGetReservationDrift.defaultExpectation == nil && mmGetReservationDrift.mock.funcGetReservationDrift == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReservationDrift.mock.afterGetReservationDriftCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReservationDrift.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReservationDrift implements stock.Repository
+func (mmGetReservationDrift *RepositoryMock) GetReservationDrift(ctx context.Context) (ra1 []stockmodels.ReservationDrift, err error) {
+	mm_atomic.AddUint64(&mmGetReservationDrift.beforeGetReservationDriftCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReservationDrift.afterGetReservationDriftCounter, 1)
+
+	if mmGetReservationDrift.inspectFuncGetReservationDrift != nil {
+		mmGetReservationDrift.inspectFuncGetReservationDrift(ctx)
+	}
+
+	mm_params := RepositoryMockGetReservationDriftParams{ctx}
+
+	// Record call args
+	mmGetReservationDrift.GetReservationDriftMock.mutex.Lock()
+	mmGetReservationDrift.GetReservationDriftMock.callArgs = append(mmGetReservationDrift.GetReservationDriftMock.callArgs, &mm_params)
+	mmGetReservationDrift.GetReservationDriftMock.mutex.Unlock()
+
+	for _, e := range mmGetReservationDrift.GetReservationDriftMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetReservationDrift.GetReservationDriftMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReservationDrift.GetReservationDriftMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReservationDrift.GetReservationDriftMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReservationDrift.GetReservationDriftMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetReservationDriftParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReservationDrift.t.Errorf("RepositoryMock.GetReservationDrift got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReservationDrift.t.Errorf("RepositoryMock.GetReservationDrift got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReservationDrift.GetReservationDriftMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReservationDrift.t.Fatal("No results are set for the RepositoryMock.GetReservationDrift")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetReservationDrift.funcGetReservationDrift != nil {
+		return mmGetReservationDrift.funcGetReservationDrift(ctx)
+	}
+	mmGetReservationDrift.t.Fatalf("Unexpected call to RepositoryMock.GetReservationDrift. %v", ctx)
+	return
+}
+
+// GetReservationDriftAfterCounter returns a count of finished RepositoryMock.GetReservationDrift invocations
+func (mmGetReservationDrift *RepositoryMock) GetReservationDriftAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReservationDrift.afterGetReservationDriftCounter)
+}
+
+// GetReservationDriftBeforeCounter returns a count of RepositoryMock.GetReservationDrift invocations
+func (mmGetReservationDrift *RepositoryMock) GetReservationDriftBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReservationDrift.beforeGetReservationDriftCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetReservationDrift.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReservationDrift *mRepositoryMockGetReservationDrift) Calls() []*RepositoryMockGetReservationDriftParams {
+	mmGetReservationDrift.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetReservationDriftParams, len(mmGetReservationDrift.callArgs))
+	copy(argCopy, mmGetReservationDrift.callArgs)
+
+	mmGetReservationDrift.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReservationDriftDone returns true if the count of the GetReservationDrift invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetReservationDriftDone() bool {
+	if m.GetReservationDriftMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReservationDriftMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReservationDriftMock.invocationsDone()
+}
+
+// MinimockGetReservationDriftInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetReservationDriftInspect() {
+	for _, e := range m.GetReservationDriftMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetReservationDrift with params: %#v", *e.params)
+		}
+	}
+
+	afterGetReservationDriftCounter := mm_atomic.LoadUint64(&m.afterGetReservationDriftCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReservationDriftMock.defaultExpectation != nil && afterGetReservationDriftCounter < 1 {
+		if m.GetReservationDriftMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RepositoryMock.GetReservationDrift")
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetReservationDrift with params: %#v", *m.GetReservationDriftMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReservationDrift != nil && afterGetReservationDriftCounter < 1 {
+		m.t.Error("Expected call to RepositoryMock.GetReservationDrift")
+	}
+
+	if !m.GetReservationDriftMock.invocationsDone() && afterGetReservationDriftCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetReservationDrift but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReservationDriftMock.expectedInvocations), afterGetReservationDriftCounter)
+	}
+}
+
 type mRepositoryMockReserve struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -413,18 +1045,21 @@ type RepositoryMockReserveExpectation struct {
 
 // RepositoryMockReserveParams contains parameters of the Repository.Reserve
 type RepositoryMockReserveParams struct {
-	ctx  context.Context
-	item []stockmodels.ReserveItem
+	ctx     context.Context
+	orderID int64
+	item    []stockmodels.ReserveItem
 }
 
 // RepositoryMockReserveParamPtrs contains pointers to parameters of the Repository.Reserve
 type RepositoryMockReserveParamPtrs struct {
-	ctx  *context.Context
-	item *[]stockmodels.ReserveItem
+	ctx     *context.Context
+	orderID *int64
+	item    *[]stockmodels.ReserveItem
 }
 
 // RepositoryMockReserveResults contains results of the Repository.Reserve
 type RepositoryMockReserveResults struct {
+	la1 []stockmodels.LevelChange
 	err error
 }
 
@@ -439,7 +1074,7 @@ func (mmReserve *mRepositoryMockReserve) Optional() *mRepositoryMockReserve {
 }
 
 // Expect sets up expected params for Repository.Reserve
-func (mmReserve *mRepositoryMockReserve) Expect(ctx context.Context, item []stockmodels.ReserveItem) *mRepositoryMockReserve {
+func (mmReserve *mRepositoryMockReserve) Expect(ctx context.Context, orderID int64, item []stockmodels.ReserveItem) *mRepositoryMockReserve {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by Set")
 	}
@@ -452,7 +1087,7 @@ func (mmReserve *mRepositoryMockReserve) Expect(ctx context.Context, item []stoc
 		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by ExpectParams functions")
 	}
 
-	mmReserve.defaultExpectation.params = &RepositoryMockReserveParams{ctx, item}
+	mmReserve.defaultExpectation.params = &RepositoryMockReserveParams{ctx, orderID, item}
 	for _, e := range mmReserve.expectations {
 		if minimock.Equal(e.params, mmReserve.defaultExpectation.params) {
 			mmReserve.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserve.defaultExpectation.params)
@@ -484,8 +1119,30 @@ func (mmReserve *mRepositoryMockReserve) ExpectCtxParam1(ctx context.Context) *m
 	return mmReserve
 }
 
-// ExpectItemParam2 sets up expected param item for Repository.Reserve
-func (mmReserve *mRepositoryMockReserve) ExpectItemParam2(item []stockmodels.ReserveItem) *mRepositoryMockReserve {
+// ExpectOrderIDParam2 sets up expected param orderID for Repository.Reserve
+func (mmReserve *mRepositoryMockReserve) ExpectOrderIDParam2(orderID int64) *mRepositoryMockReserve {
+	if mmReserve.mock.funcReserve != nil {
+		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by Set")
+	}
+
+	if mmReserve.defaultExpectation == nil {
+		mmReserve.defaultExpectation = &RepositoryMockReserveExpectation{}
+	}
+
+	if mmReserve.defaultExpectation.params != nil {
+		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by Expect")
+	}
+
+	if mmReserve.defaultExpectation.paramPtrs == nil {
+		mmReserve.defaultExpectation.paramPtrs = &RepositoryMockReserveParamPtrs{}
+	}
+	mmReserve.defaultExpectation.paramPtrs.orderID = &orderID
+
+	return mmReserve
+}
+
+// ExpectItemParam3 sets up expected param item for Repository.Reserve
+func (mmReserve *mRepositoryMockReserve) ExpectItemParam3(item []stockmodels.ReserveItem) *mRepositoryMockReserve {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by Set")
 	}
@@ -507,7 +1164,7 @@ func (mmReserve *mRepositoryMockReserve) ExpectItemParam2(item []stockmodels.Res
 }
 
 // Inspect accepts an inspector function that has same arguments as the Repository.Reserve
-func (mmReserve *mRepositoryMockReserve) Inspect(f func(ctx context.Context, item []stockmodels.ReserveItem)) *mRepositoryMockReserve {
+func (mmReserve *mRepositoryMockReserve) Inspect(f func(ctx context.Context, orderID int64, item []stockmodels.ReserveItem)) *mRepositoryMockReserve {
 	if mmReserve.mock.inspectFuncReserve != nil {
 		mmReserve.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Reserve")
 	}
@@ -518,7 +1175,7 @@ func (mmReserve *mRepositoryMockReserve) Inspect(f func(ctx context.Context, ite
 }
 
 // Return sets up results that will be returned by Repository.Reserve
-func (mmReserve *mRepositoryMockReserve) Return(err error) *RepositoryMock {
+func (mmReserve *mRepositoryMockReserve) Return(la1 []stockmodels.LevelChange, err error) *RepositoryMock {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by Set")
 	}
@@ -526,12 +1183,12 @@ func (mmReserve *mRepositoryMockReserve) Return(err error) *RepositoryMock {
 	if mmReserve.defaultExpectation == nil {
 		mmReserve.defaultExpectation = &RepositoryMockReserveExpectation{mock: mmReserve.mock}
 	}
-	mmReserve.defaultExpectation.results = &RepositoryMockReserveResults{err}
+	mmReserve.defaultExpectation.results = &RepositoryMockReserveResults{la1, err}
 	return mmReserve.mock
 }
 
 // Set uses given function f to mock the Repository.Reserve method
-func (mmReserve *mRepositoryMockReserve) Set(f func(ctx context.Context, item []stockmodels.ReserveItem) (err error)) *RepositoryMock {
+func (mmReserve *mRepositoryMockReserve) Set(f func(ctx context.Context, orderID int64, item []stockmodels.ReserveItem) (la1 []stockmodels.LevelChange, err error)) *RepositoryMock {
 	if mmReserve.defaultExpectation != nil {
 		mmReserve.mock.t.Fatalf("Default expectation is already set for the Repository.Reserve method")
 	}
@@ -546,22 +1203,22 @@ func (mmReserve *mRepositoryMockReserve) Set(f func(ctx context.Context, item []
 
 // When sets expectation for the Repository.Reserve which will trigger the result defined by the following
 // Then helper
-func (mmReserve *mRepositoryMockReserve) When(ctx context.Context, item []stockmodels.ReserveItem) *RepositoryMockReserveExpectation {
+func (mmReserve *mRepositoryMockReserve) When(ctx context.Context, orderID int64, item []stockmodels.ReserveItem) *RepositoryMockReserveExpectation {
 	if mmReserve.mock.funcReserve != nil {
 		mmReserve.mock.t.Fatalf("RepositoryMock.Reserve mock is already set by Set")
 	}
 
 	expectation := &RepositoryMockReserveExpectation{
 		mock:   mmReserve.mock,
-		params: &RepositoryMockReserveParams{ctx, item},
+		params: &RepositoryMockReserveParams{ctx, orderID, item},
 	}
 	mmReserve.expectations = append(mmReserve.expectations, expectation)
 	return expectation
 }
 
 // Then sets up Repository.Reserve return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockReserveExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockReserveResults{err}
+func (e *RepositoryMockReserveExpectation) Then(la1 []stockmodels.LevelChange, err error) *RepositoryMock {
+	e.results = &RepositoryMockReserveResults{la1, err}
 	return e.mock
 }
 
@@ -586,15 +1243,15 @@ func (mmReserve *mRepositoryMockReserve) invocationsDone() bool {
 }
 
 // Reserve implements stock.Repository
-func (mmReserve *RepositoryMock) Reserve(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+func (mmReserve *RepositoryMock) Reserve(ctx context.Context, orderID int64, item []stockmodels.ReserveItem) (la1 []stockmodels.LevelChange, err error) {
 	mm_atomic.AddUint64(&mmReserve.beforeReserveCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserve.afterReserveCounter, 1)
 
 	if mmReserve.inspectFuncReserve != nil {
-		mmReserve.inspectFuncReserve(ctx, item)
+		mmReserve.inspectFuncReserve(ctx, orderID, item)
 	}
 
-	mm_params := RepositoryMockReserveParams{ctx, item}
+	mm_params := RepositoryMockReserveParams{ctx, orderID, item}
 
 	// Record call args
 	mmReserve.ReserveMock.mutex.Lock()
@@ -604,7 +1261,7 @@ func (mmReserve *RepositoryMock) Reserve(ctx context.Context, item []stockmodels
 	for _, e := range mmReserve.ReserveMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.la1, e.results.err
 		}
 	}
 
@@ -613,7 +1270,7 @@ func (mmReserve *RepositoryMock) Reserve(ctx context.Context, item []stockmodels
 		mm_want := mmReserve.ReserveMock.defaultExpectation.params
 		mm_want_ptrs := mmReserve.ReserveMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockReserveParams{ctx, item}
+		mm_got := RepositoryMockReserveParams{ctx, orderID, item}
 
 		if mm_want_ptrs != nil {
 
@@ -621,6 +1278,10 @@ func (mmReserve *RepositoryMock) Reserve(ctx context.Context, item []stockmodels
 				mmReserve.t.Errorf("RepositoryMock.Reserve got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmReserve.t.Errorf("RepositoryMock.Reserve got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
 			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
 				mmReserve.t.Errorf("RepositoryMock.Reserve got unexpected parameter item, want: %#v, got: %#v%s\n", *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
 			}
@@ -633,12 +1294,12 @@ func (mmReserve *RepositoryMock) Reserve(ctx context.Context, item []stockmodels
 		if mm_results == nil {
 			mmReserve.t.Fatal("No results are set for the RepositoryMock.Reserve")
 		}
-		return (*mm_results).err
+		return (*mm_results).la1, (*mm_results).err
 	}
 	if mmReserve.funcReserve != nil {
-		return mmReserve.funcReserve(ctx, item)
+		return mmReserve.funcReserve(ctx, orderID, item)
 	}
-	mmReserve.t.Fatalf("Unexpected call to RepositoryMock.Reserve. %v %v", ctx, item)
+	mmReserve.t.Fatalf("Unexpected call to RepositoryMock.Reserve. %v %v %v", ctx, orderID, item)
 	return
 }
 
@@ -733,18 +1394,19 @@ type RepositoryMockReserveCancelExpectation struct {
 
 // RepositoryMockReserveCancelParams contains parameters of the Repository.ReserveCancel
 type RepositoryMockReserveCancelParams struct {
-	ctx  context.Context
-	item []stockmodels.ReserveItem
+	ctx     context.Context
+	orderID int64
 }
 
 // RepositoryMockReserveCancelParamPtrs contains pointers to parameters of the Repository.ReserveCancel
 type RepositoryMockReserveCancelParamPtrs struct {
-	ctx  *context.Context
-	item *[]stockmodels.ReserveItem
+	ctx     *context.Context
+	orderID *int64
 }
 
 // RepositoryMockReserveCancelResults contains results of the Repository.ReserveCancel
 type RepositoryMockReserveCancelResults struct {
+	la1 []stockmodels.LevelChange
 	err error
 }
 
@@ -759,7 +1421,7 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) Optional() *mRepositoryMock
 }
 
 // Expect sets up expected params for Repository.ReserveCancel
-func (mmReserveCancel *mRepositoryMockReserveCancel) Expect(ctx context.Context, item []stockmodels.ReserveItem) *mRepositoryMockReserveCancel {
+func (mmReserveCancel *mRepositoryMockReserveCancel) Expect(ctx context.Context, orderID int64) *mRepositoryMockReserveCancel {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("RepositoryMock.ReserveCancel mock is already set by Set")
 	}
@@ -772,7 +1434,7 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) Expect(ctx context.Context,
 		mmReserveCancel.mock.t.Fatalf("RepositoryMock.ReserveCancel mock is already set by ExpectParams functions")
 	}
 
-	mmReserveCancel.defaultExpectation.params = &RepositoryMockReserveCancelParams{ctx, item}
+	mmReserveCancel.defaultExpectation.params = &RepositoryMockReserveCancelParams{ctx, orderID}
 	for _, e := range mmReserveCancel.expectations {
 		if minimock.Equal(e.params, mmReserveCancel.defaultExpectation.params) {
 			mmReserveCancel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveCancel.defaultExpectation.params)
@@ -804,8 +1466,8 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) ExpectCtxParam1(ctx context
 	return mmReserveCancel
 }
 
-// ExpectItemParam2 sets up expected param item for Repository.ReserveCancel
-func (mmReserveCancel *mRepositoryMockReserveCancel) ExpectItemParam2(item []stockmodels.ReserveItem) *mRepositoryMockReserveCancel {
+// ExpectOrderIDParam2 sets up expected param orderID for Repository.ReserveCancel
+func (mmReserveCancel *mRepositoryMockReserveCancel) ExpectOrderIDParam2(orderID int64) *mRepositoryMockReserveCancel {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("RepositoryMock.ReserveCancel mock is already set by Set")
 	}
@@ -821,13 +1483,13 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) ExpectItemParam2(item []sto
 	if mmReserveCancel.defaultExpectation.paramPtrs == nil {
 		mmReserveCancel.defaultExpectation.paramPtrs = &RepositoryMockReserveCancelParamPtrs{}
 	}
-	mmReserveCancel.defaultExpectation.paramPtrs.item = &item
+	mmReserveCancel.defaultExpectation.paramPtrs.orderID = &orderID
 
 	return mmReserveCancel
 }
 
 // Inspect accepts an inspector function that has same arguments as the Repository.ReserveCancel
-func (mmReserveCancel *mRepositoryMockReserveCancel) Inspect(f func(ctx context.Context, item []stockmodels.ReserveItem)) *mRepositoryMockReserveCancel {
+func (mmReserveCancel *mRepositoryMockReserveCancel) Inspect(f func(ctx context.Context, orderID int64)) *mRepositoryMockReserveCancel {
 	if mmReserveCancel.mock.inspectFuncReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ReserveCancel")
 	}
@@ -838,7 +1500,7 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) Inspect(f func(ctx context.
 }
 
 // Return sets up results that will be returned by Repository.ReserveCancel
-func (mmReserveCancel *mRepositoryMockReserveCancel) Return(err error) *RepositoryMock {
+func (mmReserveCancel *mRepositoryMockReserveCancel) Return(la1 []stockmodels.LevelChange, err error) *RepositoryMock {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("RepositoryMock.ReserveCancel mock is already set by Set")
 	}
@@ -846,12 +1508,12 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) Return(err error) *Reposito
 	if mmReserveCancel.defaultExpectation == nil {
 		mmReserveCancel.defaultExpectation = &RepositoryMockReserveCancelExpectation{mock: mmReserveCancel.mock}
 	}
-	mmReserveCancel.defaultExpectation.results = &RepositoryMockReserveCancelResults{err}
+	mmReserveCancel.defaultExpectation.results = &RepositoryMockReserveCancelResults{la1, err}
 	return mmReserveCancel.mock
 }
 
 // Set uses given function f to mock the Repository.ReserveCancel method
-func (mmReserveCancel *mRepositoryMockReserveCancel) Set(f func(ctx context.Context, item []stockmodels.ReserveItem) (err error)) *RepositoryMock {
+func (mmReserveCancel *mRepositoryMockReserveCancel) Set(f func(ctx context.Context, orderID int64) (la1 []stockmodels.LevelChange, err error)) *RepositoryMock {
 	if mmReserveCancel.defaultExpectation != nil {
 		mmReserveCancel.mock.t.Fatalf("Default expectation is already set for the Repository.ReserveCancel method")
 	}
@@ -866,22 +1528,22 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) Set(f func(ctx context.Cont
 
 // When sets expectation for the Repository.ReserveCancel which will trigger the result defined by the following
 // Then helper
-func (mmReserveCancel *mRepositoryMockReserveCancel) When(ctx context.Context, item []stockmodels.ReserveItem) *RepositoryMockReserveCancelExpectation {
+func (mmReserveCancel *mRepositoryMockReserveCancel) When(ctx context.Context, orderID int64) *RepositoryMockReserveCancelExpectation {
 	if mmReserveCancel.mock.funcReserveCancel != nil {
 		mmReserveCancel.mock.t.Fatalf("RepositoryMock.ReserveCancel mock is already set by Set")
 	}
 
 	expectation := &RepositoryMockReserveCancelExpectation{
 		mock:   mmReserveCancel.mock,
-		params: &RepositoryMockReserveCancelParams{ctx, item},
+		params: &RepositoryMockReserveCancelParams{ctx, orderID},
 	}
 	mmReserveCancel.expectations = append(mmReserveCancel.expectations, expectation)
 	return expectation
 }
 
 // Then sets up Repository.ReserveCancel return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockReserveCancelExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockReserveCancelResults{err}
+func (e *RepositoryMockReserveCancelExpectation) Then(la1 []stockmodels.LevelChange, err error) *RepositoryMock {
+	e.results = &RepositoryMockReserveCancelResults{la1, err}
 	return e.mock
 }
 
@@ -906,15 +1568,15 @@ func (mmReserveCancel *mRepositoryMockReserveCancel) invocationsDone() bool {
 }
 
 // ReserveCancel implements stock.Repository
-func (mmReserveCancel *RepositoryMock) ReserveCancel(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+func (mmReserveCancel *RepositoryMock) ReserveCancel(ctx context.Context, orderID int64) (la1 []stockmodels.LevelChange, err error) {
 	mm_atomic.AddUint64(&mmReserveCancel.beforeReserveCancelCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserveCancel.afterReserveCancelCounter, 1)
 
 	if mmReserveCancel.inspectFuncReserveCancel != nil {
-		mmReserveCancel.inspectFuncReserveCancel(ctx, item)
+		mmReserveCancel.inspectFuncReserveCancel(ctx, orderID)
 	}
 
-	mm_params := RepositoryMockReserveCancelParams{ctx, item}
+	mm_params := RepositoryMockReserveCancelParams{ctx, orderID}
 
 	// Record call args
 	mmReserveCancel.ReserveCancelMock.mutex.Lock()
@@ -924,7 +1586,7 @@ func (mmReserveCancel *RepositoryMock) ReserveCancel(ctx context.Context, item [
 	for _, e := range mmReserveCancel.ReserveCancelMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.la1, e.results.err
 		}
 	}
 
@@ -933,7 +1595,7 @@ func (mmReserveCancel *RepositoryMock) ReserveCancel(ctx context.Context, item [
 		mm_want := mmReserveCancel.ReserveCancelMock.defaultExpectation.params
 		mm_want_ptrs := mmReserveCancel.ReserveCancelMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockReserveCancelParams{ctx, item}
+		mm_got := RepositoryMockReserveCancelParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
@@ -941,8 +1603,8 @@ func (mmReserveCancel *RepositoryMock) ReserveCancel(ctx context.Context, item [
 				mmReserveCancel.t.Errorf("RepositoryMock.ReserveCancel got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
-				mmReserveCancel.t.Errorf("RepositoryMock.ReserveCancel got unexpected parameter item, want: %#v, got: %#v%s\n", *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmReserveCancel.t.Errorf("RepositoryMock.ReserveCancel got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -953,12 +1615,12 @@ func (mmReserveCancel *RepositoryMock) ReserveCancel(ctx context.Context, item [
 		if mm_results == nil {
 			mmReserveCancel.t.Fatal("No results are set for the RepositoryMock.ReserveCancel")
 		}
-		return (*mm_results).err
+		return (*mm_results).la1, (*mm_results).err
 	}
 	if mmReserveCancel.funcReserveCancel != nil {
-		return mmReserveCancel.funcReserveCancel(ctx, item)
+		return mmReserveCancel.funcReserveCancel(ctx, orderID)
 	}
-	mmReserveCancel.t.Fatalf("Unexpected call to RepositoryMock.ReserveCancel. %v %v", ctx, item)
+	mmReserveCancel.t.Fatalf("Unexpected call to RepositoryMock.ReserveCancel. %v %v", ctx, orderID)
 	return
 }
 
@@ -1053,14 +1715,14 @@ type RepositoryMockReserveRemoveExpectation struct {
 
 // RepositoryMockReserveRemoveParams contains parameters of the Repository.ReserveRemove
 type RepositoryMockReserveRemoveParams struct {
-	ctx  context.Context
-	item []stockmodels.ReserveItem
+	ctx     context.Context
+	orderID int64
 }
 
 // RepositoryMockReserveRemoveParamPtrs contains pointers to parameters of the Repository.ReserveRemove
 type RepositoryMockReserveRemoveParamPtrs struct {
-	ctx  *context.Context
-	item *[]stockmodels.ReserveItem
+	ctx     *context.Context
+	orderID *int64
 }
 
 // RepositoryMockReserveRemoveResults contains results of the Repository.ReserveRemove
@@ -1079,7 +1741,7 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) Optional() *mRepositoryMock
 }
 
 // Expect sets up expected params for Repository.ReserveRemove
-func (mmReserveRemove *mRepositoryMockReserveRemove) Expect(ctx context.Context, item []stockmodels.ReserveItem) *mRepositoryMockReserveRemove {
+func (mmReserveRemove *mRepositoryMockReserveRemove) Expect(ctx context.Context, orderID int64) *mRepositoryMockReserveRemove {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("RepositoryMock.ReserveRemove mock is already set by Set")
 	}
@@ -1092,7 +1754,7 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) Expect(ctx context.Context,
 		mmReserveRemove.mock.t.Fatalf("RepositoryMock.ReserveRemove mock is already set by ExpectParams functions")
 	}
 
-	mmReserveRemove.defaultExpectation.params = &RepositoryMockReserveRemoveParams{ctx, item}
+	mmReserveRemove.defaultExpectation.params = &RepositoryMockReserveRemoveParams{ctx, orderID}
 	for _, e := range mmReserveRemove.expectations {
 		if minimock.Equal(e.params, mmReserveRemove.defaultExpectation.params) {
 			mmReserveRemove.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveRemove.defaultExpectation.params)
@@ -1124,8 +1786,8 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) ExpectCtxParam1(ctx context
 	return mmReserveRemove
 }
 
-// ExpectItemParam2 sets up expected param item for Repository.ReserveRemove
-func (mmReserveRemove *mRepositoryMockReserveRemove) ExpectItemParam2(item []stockmodels.ReserveItem) *mRepositoryMockReserveRemove {
+// ExpectOrderIDParam2 sets up expected param orderID for Repository.ReserveRemove
+func (mmReserveRemove *mRepositoryMockReserveRemove) ExpectOrderIDParam2(orderID int64) *mRepositoryMockReserveRemove {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("RepositoryMock.ReserveRemove mock is already set by Set")
 	}
@@ -1141,13 +1803,13 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) ExpectItemParam2(item []sto
 	if mmReserveRemove.defaultExpectation.paramPtrs == nil {
 		mmReserveRemove.defaultExpectation.paramPtrs = &RepositoryMockReserveRemoveParamPtrs{}
 	}
-	mmReserveRemove.defaultExpectation.paramPtrs.item = &item
+	mmReserveRemove.defaultExpectation.paramPtrs.orderID = &orderID
 
 	return mmReserveRemove
 }
 
 // Inspect accepts an inspector function that has same arguments as the Repository.ReserveRemove
-func (mmReserveRemove *mRepositoryMockReserveRemove) Inspect(f func(ctx context.Context, item []stockmodels.ReserveItem)) *mRepositoryMockReserveRemove {
+func (mmReserveRemove *mRepositoryMockReserveRemove) Inspect(f func(ctx context.Context, orderID int64)) *mRepositoryMockReserveRemove {
 	if mmReserveRemove.mock.inspectFuncReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ReserveRemove")
 	}
@@ -1171,7 +1833,7 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) Return(err error) *Reposito
 }
 
 // Set uses given function f to mock the Repository.ReserveRemove method
-func (mmReserveRemove *mRepositoryMockReserveRemove) Set(f func(ctx context.Context, item []stockmodels.ReserveItem) (err error)) *RepositoryMock {
+func (mmReserveRemove *mRepositoryMockReserveRemove) Set(f func(ctx context.Context, orderID int64) (err error)) *RepositoryMock {
 	if mmReserveRemove.defaultExpectation != nil {
 		mmReserveRemove.mock.t.Fatalf("Default expectation is already set for the Repository.ReserveRemove method")
 	}
@@ -1186,14 +1848,14 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) Set(f func(ctx context.Cont
 
 // When sets expectation for the Repository.ReserveRemove which will trigger the result defined by the following
 // Then helper
-func (mmReserveRemove *mRepositoryMockReserveRemove) When(ctx context.Context, item []stockmodels.ReserveItem) *RepositoryMockReserveRemoveExpectation {
+func (mmReserveRemove *mRepositoryMockReserveRemove) When(ctx context.Context, orderID int64) *RepositoryMockReserveRemoveExpectation {
 	if mmReserveRemove.mock.funcReserveRemove != nil {
 		mmReserveRemove.mock.t.Fatalf("RepositoryMock.ReserveRemove mock is already set by Set")
 	}
 
 	expectation := &RepositoryMockReserveRemoveExpectation{
 		mock:   mmReserveRemove.mock,
-		params: &RepositoryMockReserveRemoveParams{ctx, item},
+		params: &RepositoryMockReserveRemoveParams{ctx, orderID},
 	}
 	mmReserveRemove.expectations = append(mmReserveRemove.expectations, expectation)
 	return expectation
@@ -1226,15 +1888,15 @@ func (mmReserveRemove *mRepositoryMockReserveRemove) invocationsDone() bool {
 }
 
 // ReserveRemove implements stock.Repository
-func (mmReserveRemove *RepositoryMock) ReserveRemove(ctx context.Context, item []stockmodels.ReserveItem) (err error) {
+func (mmReserveRemove *RepositoryMock) ReserveRemove(ctx context.Context, orderID int64) (err error) {
 	mm_atomic.AddUint64(&mmReserveRemove.beforeReserveRemoveCounter, 1)
 	defer mm_atomic.AddUint64(&mmReserveRemove.afterReserveRemoveCounter, 1)
 
 	if mmReserveRemove.inspectFuncReserveRemove != nil {
-		mmReserveRemove.inspectFuncReserveRemove(ctx, item)
+		mmReserveRemove.inspectFuncReserveRemove(ctx, orderID)
 	}
 
-	mm_params := RepositoryMockReserveRemoveParams{ctx, item}
+	mm_params := RepositoryMockReserveRemoveParams{ctx, orderID}
 
 	// Record call args
 	mmReserveRemove.ReserveRemoveMock.mutex.Lock()
@@ -1253,7 +1915,7 @@ func (mmReserveRemove *RepositoryMock) ReserveRemove(ctx context.Context, item [
 		mm_want := mmReserveRemove.ReserveRemoveMock.defaultExpectation.params
 		mm_want_ptrs := mmReserveRemove.ReserveRemoveMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockReserveRemoveParams{ctx, item}
+		mm_got := RepositoryMockReserveRemoveParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
@@ -1261,8 +1923,8 @@ func (mmReserveRemove *RepositoryMock) ReserveRemove(ctx context.Context, item [
 				mmReserveRemove.t.Errorf("RepositoryMock.ReserveRemove got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
-				mmReserveRemove.t.Errorf("RepositoryMock.ReserveRemove got unexpected parameter item, want: %#v, got: %#v%s\n", *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmReserveRemove.t.Errorf("RepositoryMock.ReserveRemove got unexpected parameter orderID, want: %#v, got: %#v%s\n", *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1276,9 +1938,9 @@ func (mmReserveRemove *RepositoryMock) ReserveRemove(ctx context.Context, item [
 		return (*mm_results).err
 	}
 	if mmReserveRemove.funcReserveRemove != nil {
-		return mmReserveRemove.funcReserveRemove(ctx, item)
+		return mmReserveRemove.funcReserveRemove(ctx, orderID)
 	}
-	mmReserveRemove.t.Fatalf("Unexpected call to RepositoryMock.ReserveRemove. %v %v", ctx, item)
+	mmReserveRemove.t.Fatalf("Unexpected call to RepositoryMock.ReserveRemove. %v %v", ctx, orderID)
 	return
 }
 
@@ -1356,6 +2018,10 @@ func (m *RepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockGetBySKUInspect()
 
+			m.MinimockGetBySKUsInspect()
+
+			m.MinimockGetReservationDriftInspect()
+
 			m.MinimockReserveInspect()
 
 			m.MinimockReserveCancelInspect()
@@ -1385,6 +2051,8 @@ func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockGetBySKUDone() &&
+		m.MinimockGetBySKUsDone() &&
+		m.MinimockGetReservationDriftDone() &&
 		m.MinimockReserveDone() &&
 		m.MinimockReserveCancelDone() &&
 		m.MinimockReserveRemoveDone()
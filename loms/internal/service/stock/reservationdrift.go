@@ -0,0 +1,29 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ReservationDrift lists the SKUs whose reserved counter differs from the sum of their active reservations.
+func (s *Service) ReservationDrift(ctx context.Context) (drifts []stockmodels.ReservationDrift, err error) {
+	tr := otel.Tracer("stockService")
+	ctx, span := tr.Start(ctx, "ReservationDrift")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	drifts, err = s.stockRepository.GetReservationDrift(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("drift_count", len(drifts)))
+
+	return drifts, nil
+}
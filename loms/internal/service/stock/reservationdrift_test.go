@@ -0,0 +1,71 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/stock/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceReservationDrift(t *testing.T) {
+	mc := minimock.NewController(t)
+	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	s := &Service{
+		stockRepository: stockRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		mockDriftFunc  func()
+		expectedDrifts []stockmodels.ReservationDrift
+		expectedError  error
+	}{
+		{
+			name: "drift found",
+			mockDriftFunc: func() {
+				stockRepositoryMock.GetReservationDriftMock.Expect(minimock.AnyContext).Return([]stockmodels.ReservationDrift{
+					{SKU: 100, Reserved: 10, LedgerReserved: 7},
+				}, nil)
+			},
+			expectedDrifts: []stockmodels.ReservationDrift{
+				{SKU: 100, Reserved: 10, LedgerReserved: 7},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "no drift",
+			mockDriftFunc: func() {
+				stockRepositoryMock.GetReservationDriftMock.Expect(minimock.AnyContext).Return([]stockmodels.ReservationDrift{}, nil)
+			},
+			expectedDrifts: []stockmodels.ReservationDrift{},
+			expectedError:  nil,
+		},
+		{
+			name: "database error",
+			mockDriftFunc: func() {
+				stockRepositoryMock.GetReservationDriftMock.Expect(minimock.AnyContext).Return(nil, errors.New("database error"))
+			},
+			expectedDrifts: nil,
+			expectedError:  errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockDriftFunc()
+			drifts, err := s.ReservationDrift(ctx)
+			assert.Equal(t, tt.expectedDrifts, drifts)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func (s *Service) Reserve(ctx context.Context, items []ordermodels.Item) (err error) {
+func (s *Service) Reserve(ctx context.Context, orderID int64, items []ordermodels.Item) (err error) {
 	tr := otel.Tracer("stockService")
 	ctx, span := tr.Start(ctx, "Reserve")
 	defer func() {
@@ -18,8 +18,19 @@ func (s *Service) Reserve(ctx context.Context, items []ordermodels.Item) (err er
 		span.End()
 	}()
 
-	span.SetAttributes(attribute.Int("item_count", len(items)))
+	span.SetAttributes(
+		attribute.Int64("orderID", orderID),
+		attribute.Int("item_count", len(items)),
+	)
 
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return s.reserve(ctx, orderID, items)
+	})
+
+	return err
+}
+
+func (s *Service) reserve(ctx context.Context, orderID int64, items []ordermodels.Item) error {
 	reserveItems := make([]stock.ReserveItem, 0, len(items))
 
 	for _, i := range items {
@@ -29,10 +40,10 @@ func (s *Service) Reserve(ctx context.Context, items []ordermodels.Item) (err er
 		})
 	}
 
-	err = s.stockRepository.Reserve(ctx, reserveItems)
+	changes, err := s.stockRepository.Reserve(ctx, orderID, reserveItems)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.createLevelChangedEvents(ctx, changes)
 }
@@ -15,8 +15,10 @@ import (
 func TestServiceReserve(t *testing.T) {
 	mc := minimock.NewController(t)
 	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	stockOutboxRepositoryMock := mock.NewStockOutboxRepositoryMock(mc)
 	s := &Service{
-		stockRepository: stockRepositoryMock,
+		stockRepository:       stockRepositoryMock,
+		stockOutboxRepository: stockOutboxRepositoryMock,
 	}
 
 	ctx := context.Background()
@@ -38,7 +40,12 @@ func TestServiceReserve(t *testing.T) {
 					{SKU: 100, Count: 10},
 					{SKU: 101, Count: 5},
 				}
-				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, reserveItems).Return(nil)
+				changes := []stock.LevelChange{
+					{SKU: 100, AvailableBefore: 20, AvailableAfter: 10},
+					{SKU: 101, AvailableBefore: 5, AvailableAfter: 0},
+				}
+				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, 1, reserveItems).Return(changes, nil)
+				stockOutboxRepositoryMock.CreateStockLevelChangedEventsMock.Expect(minimock.AnyContext, changes).Return(nil)
 			},
 			expectedError: nil,
 		},
@@ -51,16 +58,33 @@ func TestServiceReserve(t *testing.T) {
 				reserveItems := []stock.ReserveItem{
 					{SKU: 200, Count: 3},
 				}
-				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, reserveItems).Return(errors.New("database error"))
+				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, 1, reserveItems).Return(nil, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 		},
+		{
+			name: "level event fails to be recorded",
+			items: []ordermodels.Item{
+				{SKU: 300, Count: 1},
+			},
+			mockReserveFunc: func() {
+				reserveItems := []stock.ReserveItem{
+					{SKU: 300, Count: 1},
+				}
+				changes := []stock.LevelChange{
+					{SKU: 300, AvailableBefore: 1, AvailableAfter: 0},
+				}
+				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, 1, reserveItems).Return(changes, nil)
+				stockOutboxRepositoryMock.CreateStockLevelChangedEventsMock.Expect(minimock.AnyContext, changes).Return(errors.New("outbox error"))
+			},
+			expectedError: errors.New("outbox error"),
+		},
 		{
 			name:  "empty items list",
 			items: []ordermodels.Item{},
 			mockReserveFunc: func() {
 				reserveItems := []stock.ReserveItem{}
-				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, reserveItems).Return(nil)
+				stockRepositoryMock.ReserveMock.Expect(minimock.AnyContext, 1, reserveItems).Return(nil, nil)
 			},
 			expectedError: nil,
 		},
@@ -69,7 +93,7 @@ func TestServiceReserve(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockReserveFunc()
-			err := s.Reserve(ctx, tt.items)
+			err := s.reserve(ctx, 1, tt.items)
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 			} else {
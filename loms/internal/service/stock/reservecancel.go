@@ -4,12 +4,11 @@ import (
 	"context"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func (s *Service) ReserveCancel(ctx context.Context, items []stockmodels.ReserveItem) (err error) {
+func (s *Service) ReserveCancel(ctx context.Context, orderID int64) (err error) {
 	tr := otel.Tracer("stockService")
 	ctx, span := tr.Start(ctx, "ReserveCancel")
 	defer func() {
@@ -17,12 +16,20 @@ func (s *Service) ReserveCancel(ctx context.Context, items []stockmodels.Reserve
 		span.End()
 	}()
 
-	span.SetAttributes(attribute.Int("item_count", len(items)))
+	span.SetAttributes(attribute.Int64("orderID", orderID))
 
-	err = s.stockRepository.ReserveCancel(ctx, items)
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return s.reserveCancel(ctx, orderID)
+	})
+
+	return err
+}
+
+func (s *Service) reserveCancel(ctx context.Context, orderID int64) error {
+	changes, err := s.stockRepository.ReserveCancel(ctx, orderID)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.createLevelChangedEvents(ctx, changes)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/service/stock/mock"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
@@ -14,58 +15,54 @@ import (
 func TestServiceReserveCancel(t *testing.T) {
 	mc := minimock.NewController(t)
 	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	stockOutboxRepositoryMock := mock.NewStockOutboxRepositoryMock(mc)
 	s := &Service{
-		stockRepository: stockRepositoryMock,
+		stockRepository:       stockRepositoryMock,
+		stockOutboxRepository: stockOutboxRepositoryMock,
 	}
 
 	ctx := context.Background()
 
 	tests := []struct {
 		name           string
-		items          []stockmodels.ReserveItem
+		orderID        int64
 		mockCancelFunc func()
 		expectedError  error
 	}{
 		{
-			name: "successful reserve cancel",
-			items: []stockmodels.ReserveItem{
-				{SKU: 100, Count: 10},
-				{SKU: 101, Count: 5},
-			},
+			name:    "successful reserve cancel",
+			orderID: 1,
 			mockCancelFunc: func() {
-				stockRepositoryMock.ReserveCancelMock.Expect(minimock.AnyContext, []stockmodels.ReserveItem{
-					{SKU: 100, Count: 10},
-					{SKU: 101, Count: 5},
-				}).Return(nil)
+				changes := []stockmodels.LevelChange{
+					{SKU: 100, AvailableBefore: 0, AvailableAfter: 3},
+				}
+				stockRepositoryMock.ReserveCancelMock.Expect(minimock.AnyContext, 1).Return(changes, nil)
+				stockOutboxRepositoryMock.CreateStockLevelChangedEventsMock.Expect(minimock.AnyContext, changes).Return(nil)
 			},
 			expectedError: nil,
 		},
 		{
-			name: "reserve cancel fails due to database error",
-			items: []stockmodels.ReserveItem{
-				{SKU: 200, Count: 3},
-			},
+			name:    "reserve cancel fails due to database error",
+			orderID: 2,
 			mockCancelFunc: func() {
-				stockRepositoryMock.ReserveCancelMock.Expect(minimock.AnyContext, []stockmodels.ReserveItem{
-					{SKU: 200, Count: 3},
-				}).Return(errors.New("database error"))
+				stockRepositoryMock.ReserveCancelMock.Expect(minimock.AnyContext, 2).Return(nil, errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 		},
 		{
-			name:  "empty items list",
-			items: []stockmodels.ReserveItem{},
+			name:    "no active reservation",
+			orderID: 3,
 			mockCancelFunc: func() {
-				stockRepositoryMock.ReserveCancelMock.Expect(minimock.AnyContext, []stockmodels.ReserveItem{}).Return(nil)
+				stockRepositoryMock.ReserveCancelMock.Expect(minimock.AnyContext, 3).Return(nil, repository.ErrReservationNotFound)
 			},
-			expectedError: nil,
+			expectedError: repository.ErrReservationNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockCancelFunc()
-			err := s.ReserveCancel(ctx, tt.items)
+			err := s.reserveCancel(ctx, tt.orderID)
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 			} else {
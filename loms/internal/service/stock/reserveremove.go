@@ -4,12 +4,13 @@ import (
 	"context"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func (s *Service) ReserveRemove(ctx context.Context, items []stockmodels.ReserveItem) (err error) {
+// ReserveRemove writes off the reserved items of a paid order. Total and reserved drop
+// by the same count, so availability does not change and no level event is recorded.
+func (s *Service) ReserveRemove(ctx context.Context, orderID int64) (err error) {
 	tr := otel.Tracer("stockService")
 	ctx, span := tr.Start(ctx, "ReserveRemove")
 	defer func() {
@@ -17,9 +18,9 @@ func (s *Service) ReserveRemove(ctx context.Context, items []stockmodels.Reserve
 		span.End()
 	}()
 
-	span.SetAttributes(attribute.Int("item_count", len(items)))
+	span.SetAttributes(attribute.Int64("orderID", orderID))
 
-	err = s.stockRepository.ReserveRemove(ctx, items)
+	err = s.stockRepository.ReserveRemove(ctx, orderID)
 	if err != nil {
 		return err
 	}
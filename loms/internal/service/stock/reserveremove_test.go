@@ -5,7 +5,7 @@ import (
 	"errors"
 	"testing"
 
-	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
 	"github.com/BruteMors/marketplace-service/loms/internal/service/stock/mock"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
@@ -22,50 +22,40 @@ func TestServiceReserveRemove(t *testing.T) {
 
 	tests := []struct {
 		name           string
-		items          []stockmodels.ReserveItem
+		orderID        int64
 		mockRemoveFunc func()
 		expectedError  error
 	}{
 		{
-			name: "successful reserve removal",
-			items: []stockmodels.ReserveItem{
-				{SKU: 100, Count: 10},
-				{SKU: 101, Count: 5},
-			},
+			name:    "successful reserve removal",
+			orderID: 1,
 			mockRemoveFunc: func() {
-				stockRepositoryMock.ReserveRemoveMock.Expect(minimock.AnyContext, []stockmodels.ReserveItem{
-					{SKU: 100, Count: 10},
-					{SKU: 101, Count: 5},
-				}).Return(nil)
+				stockRepositoryMock.ReserveRemoveMock.Expect(minimock.AnyContext, 1).Return(nil)
 			},
 			expectedError: nil,
 		},
 		{
-			name: "reserve removal fails due to database error",
-			items: []stockmodels.ReserveItem{
-				{SKU: 200, Count: 3},
-			},
+			name:    "reserve removal fails due to database error",
+			orderID: 2,
 			mockRemoveFunc: func() {
-				stockRepositoryMock.ReserveRemoveMock.Expect(minimock.AnyContext, []stockmodels.ReserveItem{
-					{SKU: 200, Count: 3},
-				}).Return(errors.New("database error"))
+				stockRepositoryMock.ReserveRemoveMock.Expect(minimock.AnyContext, 2).Return(errors.New("database error"))
 			},
 			expectedError: errors.New("database error"),
 		},
 		{
-			name:  "empty items list",
-			items: []stockmodels.ReserveItem{},
+			name:    "no active reservation",
+			orderID: 3,
 			mockRemoveFunc: func() {
-				stockRepositoryMock.ReserveRemoveMock.Expect(minimock.AnyContext, []stockmodels.ReserveItem{}).Return(nil)
+				stockRepositoryMock.ReserveRemoveMock.Expect(minimock.AnyContext, 3).Return(repository.ErrReservationNotFound)
 			},
-			expectedError: nil,
+			expectedError: repository.ErrReservationNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockRemoveFunc()
-			err := s.ReserveRemove(ctx, tt.items)
+			err := s.ReserveRemove(ctx, tt.orderID)
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 			} else {
@@ -8,26 +8,35 @@ import (
 
 type Repository interface {
 	GetBySKU(ctx context.Context, skuID uint32) (stockmodels.Item, error)
-	Reserve(ctx context.Context, item []stockmodels.ReserveItem) error
-	ReserveRemove(ctx context.Context, item []stockmodels.ReserveItem) error
-	ReserveCancel(ctx context.Context, item []stockmodels.ReserveItem) error
+	GetBySKUs(ctx context.Context, skuIDs []uint32) ([]stockmodels.Item, error)
+	Reserve(ctx context.Context, orderID int64, item []stockmodels.ReserveItem) ([]stockmodels.LevelChange, error)
+	ReserveRemove(ctx context.Context, orderID int64) error
+	ReserveCancel(ctx context.Context, orderID int64) ([]stockmodels.LevelChange, error)
+	GetReservationDrift(ctx context.Context) ([]stockmodels.ReservationDrift, error)
 }
 
 type TxManager interface {
 	ReadCommitted(ctx context.Context, f func(context.Context) error) error
 }
 
+type StockOutboxRepository interface {
+	CreateStockLevelChangedEvents(ctx context.Context, changes []stockmodels.LevelChange) error
+}
+
 type Service struct {
-	stockRepository Repository
-	txManager       TxManager
+	stockRepository       Repository
+	txManager             TxManager
+	stockOutboxRepository StockOutboxRepository
 }
 
 func NewService(
 	repo Repository,
 	txManager TxManager,
+	stockOutboxRepository StockOutboxRepository,
 ) *Service {
 	return &Service{
-		stockRepository: repo,
-		txManager:       txManager,
+		stockRepository:       repo,
+		txManager:             txManager,
+		stockOutboxRepository: stockOutboxRepository,
 	}
 }
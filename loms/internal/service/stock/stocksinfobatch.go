@@ -0,0 +1,37 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StocksInfoBatch returns available counts for the given SKUs. Unknown SKUs are omitted from the result.
+func (s *Service) StocksInfoBatch(ctx context.Context, skuIDs []uint32) (counts map[uint32]uint64, err error) {
+	tr := otel.Tracer("stockService")
+	ctx, span := tr.Start(ctx, "StocksInfoBatch")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sku_count", len(skuIDs)))
+
+	items, err := s.stockRepository.GetBySKUs(ctx, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	counts = make(map[uint32]uint64, len(items))
+	for _, item := range items {
+		var count uint64
+		if item.TotalCount > item.Reserved {
+			count = item.TotalCount - item.Reserved
+		}
+		counts[item.SKU] = count
+	}
+
+	return counts, nil
+}
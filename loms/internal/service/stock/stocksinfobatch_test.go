@@ -0,0 +1,90 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/stock/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceStocksInfoBatch(t *testing.T) {
+	mc := minimock.NewController(t)
+	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	s := &Service{
+		stockRepository: stockRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		skuIDs         []uint32
+		mockStocksFunc func()
+		expectedCounts map[uint32]uint64
+		expectedError  error
+	}{
+		{
+			name:   "successful batch retrieval",
+			skuIDs: []uint32{100, 101},
+			mockStocksFunc: func() {
+				items := []stock.Item{
+					{SKU: 100, TotalCount: 15, Reserved: 5},
+					{SKU: 101, TotalCount: 3, Reserved: 3},
+				}
+				stockRepositoryMock.GetBySKUsMock.Expect(minimock.AnyContext, []uint32{100, 101}).Return(items, nil)
+			},
+			expectedCounts: map[uint32]uint64{100: 10, 101: 0},
+			expectedError:  nil,
+		},
+		{
+			name:   "unknown SKU omitted",
+			skuIDs: []uint32{100, 102},
+			mockStocksFunc: func() {
+				items := []stock.Item{
+					{SKU: 100, TotalCount: 15, Reserved: 5},
+				}
+				stockRepositoryMock.GetBySKUsMock.Expect(minimock.AnyContext, []uint32{100, 102}).Return(items, nil)
+			},
+			expectedCounts: map[uint32]uint64{100: 10},
+			expectedError:  nil,
+		},
+		{
+			name:   "reserved exceeds total",
+			skuIDs: []uint32{103},
+			mockStocksFunc: func() {
+				items := []stock.Item{
+					{SKU: 103, TotalCount: 2, Reserved: 5},
+				}
+				stockRepositoryMock.GetBySKUsMock.Expect(minimock.AnyContext, []uint32{103}).Return(items, nil)
+			},
+			expectedCounts: map[uint32]uint64{103: 0},
+			expectedError:  nil,
+		},
+		{
+			name:   "database error",
+			skuIDs: []uint32{104},
+			mockStocksFunc: func() {
+				stockRepositoryMock.GetBySKUsMock.Expect(minimock.AnyContext, []uint32{104}).Return(nil, errors.New("database error"))
+			},
+			expectedCounts: nil,
+			expectedError:  errors.New("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockStocksFunc()
+			counts, err := s.StocksInfoBatch(ctx, tt.skuIDs)
+			assert.Equal(t, tt.expectedCounts, counts)
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package stockadmin
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+)
+
+// apply writes the new totals, then records them in the stock audit and the outbox.
+// Changes that move availability also produce a stock level event.
+// It must run inside the transaction that locked the changed items.
+func (s *Service) apply(
+	ctx context.Context,
+	action stockmodels.ChangeAction,
+	reason string,
+	changes []stockmodels.Change,
+) error {
+	for _, change := range changes {
+		if change.NewTotal > math.MaxInt32 {
+			return fmt.Errorf("%w: sku %d", models.ErrInvalidStockTotal, change.SKU)
+		}
+		if change.NewTotal < change.Reserved {
+			return fmt.Errorf("%w: sku %d has %d reserved", models.ErrTotalBelowReserved, change.SKU, change.Reserved)
+		}
+	}
+
+	err := s.stockRepository.UpsertTotals(ctx, changes)
+	if err != nil {
+		return err
+	}
+
+	err = s.stockRepository.CreateAudit(ctx, action, reason, changes)
+	if err != nil {
+		return err
+	}
+
+	err = s.stockOutboxRepository.CreateStockChangedEvents(ctx, action, changes)
+	if err != nil {
+		return err
+	}
+
+	levelChanges := make([]stockmodels.LevelChange, 0, len(changes))
+	for _, change := range changes {
+		if change.OldTotal == change.NewTotal {
+			continue
+		}
+
+		levelChanges = append(levelChanges, stockmodels.LevelChange{
+			SKU:             change.SKU,
+			AvailableBefore: int64(change.OldTotal) - int64(change.Reserved),
+			AvailableAfter:  int64(change.NewTotal) - int64(change.Reserved),
+		})
+	}
+
+	if len(levelChanges) == 0 {
+		return nil
+	}
+
+	return s.stockOutboxRepository.CreateStockLevelChangedEvents(ctx, levelChanges)
+}
+
+// lockItem locks a single existing item.
+func (s *Service) lockItem(ctx context.Context, sku uint32) (stockmodels.Item, error) {
+	items, err := s.stockRepository.GetBySKUsForUpdate(ctx, []uint32{sku})
+	if err != nil {
+		return stockmodels.Item{}, err
+	}
+
+	if len(items) == 0 {
+		return stockmodels.Item{}, models.ErrSKUNotFound
+	}
+
+	return items[0], nil
+}
@@ -0,0 +1,182 @@
+package stockadmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// importRow is one entry of a JSON import.
+type importRow struct {
+	SKU        uint32 `json:"sku"`
+	TotalCount uint64 `json:"total_count"`
+}
+
+// ImportStock upserts total counts in bulk. Unknown SKUs are created, known ones get their total overwritten.
+// The import is applied atomically: a single invalid row or a total below reserved rejects all of it.
+func (s *Service) ImportStock(
+	ctx context.Context,
+	format stockmodels.ImportFormat,
+	data []byte,
+	reason string,
+) (imported uint32, err error) {
+	tr := otel.Tracer("stockAdminService")
+	ctx, span := tr.Start(ctx, "ImportStock")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.String("format", string(format)))
+
+	totals, err := parseImport(format, data)
+	if err != nil {
+		return 0, err
+	}
+
+	span.SetAttributes(attribute.Int("sku_count", len(totals)))
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		return s.importStock(ctx, totals, reason)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(len(totals)), nil
+}
+
+func (s *Service) importStock(ctx context.Context, totals map[uint32]uint64, reason string) error {
+	skus := make([]uint32, 0, len(totals))
+	for sku := range totals {
+		skus = append(skus, sku)
+	}
+	sort.Slice(skus, func(i, j int) bool {
+		return skus[i] < skus[j]
+	})
+
+	current, err := s.stockRepository.GetBySKUsForUpdate(ctx, skus)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[uint32]stockmodels.Item, len(current))
+	for _, item := range current {
+		existing[item.SKU] = item
+	}
+
+	changes := make([]stockmodels.Change, 0, len(skus))
+	for _, sku := range skus {
+		item := existing[sku]
+		changes = append(changes, stockmodels.Change{
+			SKU:      sku,
+			OldTotal: item.TotalCount,
+			NewTotal: totals[sku],
+			Reserved: item.Reserved,
+		})
+	}
+
+	return s.apply(ctx, stockmodels.ChangeActionImport, reason, changes)
+}
+
+// parseImport decodes an import into new totals by SKU. Every error wraps models.ErrInvalidStockImport.
+func parseImport(format stockmodels.ImportFormat, data []byte) (map[uint32]uint64, error) {
+	var (
+		rows []importRow
+		err  error
+	)
+
+	switch format {
+	case stockmodels.ImportFormatCSV:
+		rows, err = parseImportCSV(data)
+	case stockmodels.ImportFormatJSON:
+		rows, err = parseImportJSON(data)
+	default:
+		err = fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidStockImport, err)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no rows", models.ErrInvalidStockImport)
+	}
+
+	totals := make(map[uint32]uint64, len(rows))
+	for i, row := range rows {
+		if row.SKU == 0 {
+			return nil, fmt.Errorf("%w: row %d: sku must be greater than 0", models.ErrInvalidStockImport, i+1)
+		}
+		if _, ok := totals[row.SKU]; ok {
+			return nil, fmt.Errorf("%w: row %d: duplicate sku %d", models.ErrInvalidStockImport, i+1, row.SKU)
+		}
+		totals[row.SKU] = row.TotalCount
+	}
+
+	return totals, nil
+}
+
+// parseImportCSV reads "sku,total_count" records. A leading header row is skipped.
+func parseImportCSV(data []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var rows []importRow
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && strings.EqualFold(record[0], "sku") {
+			continue
+		}
+
+		sku, err := strconv.ParseUint(record[0], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid sku %q", line, record[0])
+		}
+
+		total, err := strconv.ParseUint(record[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid total count %q", line, record[1])
+		}
+
+		rows = append(rows, importRow{
+			SKU:        uint32(sku),
+			TotalCount: total,
+		})
+	}
+
+	return rows, nil
+}
+
+// parseImportJSON reads an array of {"sku": ..., "total_count": ...} objects.
+func parseImportJSON(data []byte) ([]importRow, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var rows []importRow
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
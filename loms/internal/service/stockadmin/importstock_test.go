@@ -0,0 +1,169 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/stockadmin/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImport(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         stockmodels.ImportFormat
+		data           string
+		expectedTotals map[uint32]uint64
+		expectedError  error
+	}{
+		{
+			name:           "csv with header",
+			format:         stockmodels.ImportFormatCSV,
+			data:           "sku,total_count\n100,10\n200, 0\n",
+			expectedTotals: map[uint32]uint64{100: 10, 200: 0},
+		},
+		{
+			name:           "csv without header",
+			format:         stockmodels.ImportFormatCSV,
+			data:           "100,10",
+			expectedTotals: map[uint32]uint64{100: 10},
+		},
+		{
+			name:          "csv with invalid total",
+			format:        stockmodels.ImportFormatCSV,
+			data:          "100,ten",
+			expectedError: models.ErrInvalidStockImport,
+		},
+		{
+			name:          "csv with missing column",
+			format:        stockmodels.ImportFormatCSV,
+			data:          "100,10\n200",
+			expectedError: models.ErrInvalidStockImport,
+		},
+		{
+			name:           "json",
+			format:         stockmodels.ImportFormatJSON,
+			data:           `[{"sku": 100, "total_count": 10}, {"sku": 200, "total_count": 3}]`,
+			expectedTotals: map[uint32]uint64{100: 10, 200: 3},
+		},
+		{
+			name:          "json with unknown field",
+			format:        stockmodels.ImportFormatJSON,
+			data:          `[{"sku": 100, "count": 10}]`,
+			expectedError: models.ErrInvalidStockImport,
+		},
+		{
+			name:          "duplicate sku",
+			format:        stockmodels.ImportFormatJSON,
+			data:          `[{"sku": 100, "total_count": 10}, {"sku": 100, "total_count": 3}]`,
+			expectedError: models.ErrInvalidStockImport,
+		},
+		{
+			name:          "zero sku",
+			format:        stockmodels.ImportFormatCSV,
+			data:          "0,10",
+			expectedError: models.ErrInvalidStockImport,
+		},
+		{
+			name:          "no rows",
+			format:        stockmodels.ImportFormatCSV,
+			data:          "sku,total_count\n",
+			expectedError: models.ErrInvalidStockImport,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			totals, err := parseImport(tt.format, []byte(tt.data))
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTotals, totals)
+			}
+		})
+	}
+}
+
+func TestServiceImportStock(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	stockOutboxRepositoryMock := mock.NewStockOutboxRepositoryMock(mc)
+
+	s := &Service{
+		stockRepository:       stockRepositoryMock,
+		stockOutboxRepository: stockOutboxRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		totals        map[uint32]uint64
+		mockLockFunc  func()
+		mockApplyFunc func()
+		expectedError error
+	}{
+		{
+			name:   "repository error",
+			totals: map[uint32]uint64{100: 10},
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{100}).Return(nil, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:   "total below reserved",
+			totals: map[uint32]uint64{100: 10, 200: 1},
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{100, 200}).
+					Return([]stockmodels.Item{{SKU: 200, TotalCount: 5, Reserved: 2}}, nil)
+			},
+			expectedError: models.ErrTotalBelowReserved,
+		},
+		{
+			name:   "new and existing skus",
+			totals: map[uint32]uint64{300: 7, 100: 10, 200: 4},
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{100, 200, 300}).
+					Return([]stockmodels.Item{{SKU: 200, TotalCount: 5, Reserved: 2}}, nil)
+			},
+			mockApplyFunc: func() {
+				changes := []stockmodels.Change{
+					{SKU: 100, NewTotal: 10},
+					{SKU: 200, OldTotal: 5, NewTotal: 4, Reserved: 2},
+					{SKU: 300, NewTotal: 7},
+				}
+				stockRepositoryMock.UpsertTotalsMock.Expect(ctx, changes).Return(nil)
+				stockRepositoryMock.CreateAuditMock.Expect(ctx, stockmodels.ChangeActionImport, "supplier feed", changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockChangedEventsMock.Expect(ctx, stockmodels.ChangeActionImport, changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockLevelChangedEventsMock.Expect(ctx, []stockmodels.LevelChange{
+					{SKU: 100, AvailableBefore: 0, AvailableAfter: 10},
+					{SKU: 200, AvailableBefore: 3, AvailableAfter: 2},
+					{SKU: 300, AvailableBefore: 0, AvailableAfter: 7},
+				}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockLockFunc()
+			if tt.mockApplyFunc != nil {
+				tt.mockApplyFunc()
+			}
+
+			err := s.importStock(ctx, tt.totals, "supplier feed")
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
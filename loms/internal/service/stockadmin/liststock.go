@@ -0,0 +1,75 @@
+package stockadmin
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const defaultListStockLimit = 20
+
+// ListStock returns a page of items ordered by SKU and a cursor for the next page, empty on the last one.
+func (s *Service) ListStock(
+	ctx context.Context,
+	cursor string,
+	limit uint32,
+) (items []stockmodels.Item, nextCursor string, err error) {
+	tr := otel.Tracer("stockAdminService")
+	ctx, span := tr.Start(ctx, "ListStock")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	if limit == 0 {
+		limit = defaultListStockLimit
+	}
+
+	var afterSKU uint32
+	if cursor != "" {
+		afterSKU, err = decodeListStockCursor(cursor)
+		if err != nil {
+			return nil, "", models.ErrInvalidCursor
+		}
+	}
+
+	// one extra item tells whether there is a next page
+	items, err = s.stockRepository.List(ctx, afterSKU, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(items) > int(limit) {
+		items = items[:limit]
+		nextCursor = encodeListStockCursor(items[len(items)-1].SKU)
+	}
+
+	span.SetAttributes(attribute.Int("items", len(items)))
+
+	return items, nextCursor, nil
+}
+
+// encodeListStockCursor packs the last returned SKU into an opaque token.
+func encodeListStockCursor(sku uint32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(sku), 10)))
+}
+
+func decodeListStockCursor(token string) (uint32, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	sku, err := strconv.ParseUint(string(raw), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(sku), nil
+}
@@ -0,0 +1,86 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/stockadmin/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceListStock(t *testing.T) {
+	mc := minimock.NewController(t)
+	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	s := &Service{
+		stockRepository: stockRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	first := stockmodels.Item{SKU: 100, TotalCount: 10, Reserved: 2}
+	second := stockmodels.Item{SKU: 200, TotalCount: 5}
+
+	tests := []struct {
+		name               string
+		cursor             string
+		limit              uint32
+		mockStockFunc      func()
+		expectedItems      []stockmodels.Item
+		expectedNextCursor string
+		expectedError      error
+	}{
+		{
+			name:          "invalid cursor",
+			cursor:        "not a cursor",
+			mockStockFunc: func() {},
+			expectedError: models.ErrInvalidCursor,
+		},
+		{
+			name: "database error",
+			mockStockFunc: func() {
+				stockRepositoryMock.ListMock.Expect(minimock.AnyContext, 0, defaultListStockLimit+1).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:  "page with next cursor",
+			limit: 1,
+			mockStockFunc: func() {
+				stockRepositoryMock.ListMock.Expect(minimock.AnyContext, 0, 2).
+					Return([]stockmodels.Item{first, second}, nil)
+			},
+			expectedItems:      []stockmodels.Item{first},
+			expectedNextCursor: encodeListStockCursor(first.SKU),
+		},
+		{
+			name:   "last page",
+			cursor: encodeListStockCursor(first.SKU),
+			limit:  1,
+			mockStockFunc: func() {
+				stockRepositoryMock.ListMock.Expect(minimock.AnyContext, first.SKU, 2).
+					Return([]stockmodels.Item{second}, nil)
+			},
+			expectedItems: []stockmodels.Item{second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockStockFunc()
+
+			items, nextCursor, err := s.ListStock(ctx, tt.cursor, tt.limit)
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedItems, items)
+				assert.Equal(t, tt.expectedNextCursor, nextCursor)
+			}
+		})
+	}
+}
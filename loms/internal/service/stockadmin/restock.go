@@ -0,0 +1,69 @@
+package stockadmin
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Restock adds delta to the total count of a SKU. A negative delta writes stock off.
+func (s *Service) Restock(ctx context.Context, sku uint32, delta int64, reason string) (item stockmodels.Item, err error) {
+	tr := otel.Tracer("stockAdminService")
+	ctx, span := tr.Start(ctx, "Restock")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("sku", int64(sku)),
+		attribute.Int64("delta", delta),
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		item, err = s.restock(ctx, sku, delta, reason)
+		return err
+	})
+
+	return item, err
+}
+
+func (s *Service) restock(ctx context.Context, sku uint32, delta int64, reason string) (stockmodels.Item, error) {
+	if delta > math.MaxInt32 || delta < -math.MaxInt32 {
+		return stockmodels.Item{}, fmt.Errorf("%w: sku %d", models.ErrInvalidStockTotal, sku)
+	}
+
+	current, err := s.lockItem(ctx, sku)
+	if err != nil {
+		return stockmodels.Item{}, err
+	}
+
+	newTotal := int64(current.TotalCount) + delta
+	if newTotal < 0 {
+		return stockmodels.Item{}, fmt.Errorf("%w: sku %d has %d reserved", models.ErrTotalBelowReserved, sku, current.Reserved)
+	}
+
+	change := stockmodels.Change{
+		SKU:      sku,
+		OldTotal: current.TotalCount,
+		NewTotal: uint64(newTotal),
+		Reserved: current.Reserved,
+	}
+
+	err = s.apply(ctx, stockmodels.ChangeActionRestock, reason, []stockmodels.Change{change})
+	if err != nil {
+		return stockmodels.Item{}, err
+	}
+
+	return stockmodels.Item{
+		SKU:        sku,
+		TotalCount: change.NewTotal,
+		Reserved:   change.Reserved,
+	}, nil
+}
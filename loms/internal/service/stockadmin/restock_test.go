@@ -0,0 +1,137 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/stockadmin/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceRestock(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	stockOutboxRepositoryMock := mock.NewStockOutboxRepositoryMock(mc)
+
+	s := &Service{
+		stockRepository:       stockRepositoryMock,
+		stockOutboxRepository: stockOutboxRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		sku           uint32
+		delta         int64
+		mockLockFunc  func()
+		mockApplyFunc func()
+		expectedItem  stockmodels.Item
+		expectedError error
+	}{
+		{
+			name:  "sku not found",
+			sku:   1,
+			delta: 5,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{1}).Return(nil, nil)
+			},
+			expectedError: models.ErrSKUNotFound,
+		},
+		{
+			name:  "repository error",
+			sku:   2,
+			delta: 5,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{2}).Return(nil, errors.New("database error"))
+			},
+			expectedError: errors.New("database error"),
+		},
+		{
+			name:          "delta out of range",
+			sku:           3,
+			delta:         math.MaxInt32 + 1,
+			mockLockFunc:  func() {},
+			expectedError: models.ErrInvalidStockTotal,
+		},
+		{
+			name:  "write-off below reserved",
+			sku:   4,
+			delta: -5,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{4}).
+					Return([]stockmodels.Item{{SKU: 4, TotalCount: 10, Reserved: 8}}, nil)
+			},
+			expectedError: models.ErrTotalBelowReserved,
+		},
+		{
+			name:  "write-off below zero",
+			sku:   5,
+			delta: -11,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{5}).
+					Return([]stockmodels.Item{{SKU: 5, TotalCount: 10}}, nil)
+			},
+			expectedError: models.ErrTotalBelowReserved,
+		},
+		{
+			name:  "successful restock",
+			sku:   6,
+			delta: 5,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{6}).
+					Return([]stockmodels.Item{{SKU: 6, TotalCount: 10, Reserved: 3}}, nil)
+			},
+			mockApplyFunc: func() {
+				changes := []stockmodels.Change{{SKU: 6, OldTotal: 10, NewTotal: 15, Reserved: 3}}
+				stockRepositoryMock.UpsertTotalsMock.Expect(ctx, changes).Return(nil)
+				stockRepositoryMock.CreateAuditMock.Expect(ctx, stockmodels.ChangeActionRestock, "delivery", changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockChangedEventsMock.Expect(ctx, stockmodels.ChangeActionRestock, changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockLevelChangedEventsMock.Expect(ctx, []stockmodels.LevelChange{
+					{SKU: 6, AvailableBefore: 7, AvailableAfter: 12},
+				}).Return(nil)
+			},
+			expectedItem: stockmodels.Item{SKU: 6, TotalCount: 15, Reserved: 3},
+		},
+		{
+			name:  "outbox error",
+			sku:   7,
+			delta: -2,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{7}).
+					Return([]stockmodels.Item{{SKU: 7, TotalCount: 10, Reserved: 3}}, nil)
+			},
+			mockApplyFunc: func() {
+				changes := []stockmodels.Change{{SKU: 7, OldTotal: 10, NewTotal: 8, Reserved: 3}}
+				stockRepositoryMock.UpsertTotalsMock.Expect(ctx, changes).Return(nil)
+				stockRepositoryMock.CreateAuditMock.Expect(ctx, stockmodels.ChangeActionRestock, "delivery", changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockChangedEventsMock.Expect(ctx, stockmodels.ChangeActionRestock, changes).
+					Return(errors.New("outbox error"))
+			},
+			expectedError: errors.New("outbox error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockLockFunc()
+			if tt.mockApplyFunc != nil {
+				tt.mockApplyFunc()
+			}
+
+			item, err := s.restock(ctx, tt.sku, tt.delta, "delivery")
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedItem, item)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package stockadmin
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetTotal overwrites the total count of a SKU. The total may not drop below what is already reserved.
+func (s *Service) SetTotal(ctx context.Context, sku uint32, total uint64, reason string) (item stockmodels.Item, err error) {
+	tr := otel.Tracer("stockAdminService")
+	ctx, span := tr.Start(ctx, "SetTotal")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("sku", int64(sku)),
+		attribute.Int64("total", int64(total)),
+	)
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		item, err = s.setTotal(ctx, sku, total, reason)
+		return err
+	})
+
+	return item, err
+}
+
+func (s *Service) setTotal(ctx context.Context, sku uint32, total uint64, reason string) (stockmodels.Item, error) {
+	current, err := s.lockItem(ctx, sku)
+	if err != nil {
+		return stockmodels.Item{}, err
+	}
+
+	change := stockmodels.Change{
+		SKU:      sku,
+		OldTotal: current.TotalCount,
+		NewTotal: total,
+		Reserved: current.Reserved,
+	}
+
+	err = s.apply(ctx, stockmodels.ChangeActionSetTotal, reason, []stockmodels.Change{change})
+	if err != nil {
+		return stockmodels.Item{}, err
+	}
+
+	return stockmodels.Item{
+		SKU:        sku,
+		TotalCount: total,
+		Reserved:   current.Reserved,
+	}, nil
+}
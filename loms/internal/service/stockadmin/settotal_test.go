@@ -0,0 +1,136 @@
+package stockadmin
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/models"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/service/stockadmin/mock"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceSetTotal(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	stockRepositoryMock := mock.NewRepositoryMock(mc)
+	stockOutboxRepositoryMock := mock.NewStockOutboxRepositoryMock(mc)
+
+	s := &Service{
+		stockRepository:       stockRepositoryMock,
+		stockOutboxRepository: stockOutboxRepositoryMock,
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		sku           uint32
+		total         uint64
+		mockLockFunc  func()
+		mockApplyFunc func()
+		expectedItem  stockmodels.Item
+		expectedError error
+	}{
+		{
+			name:  "sku not found",
+			sku:   1,
+			total: 10,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{1}).Return(nil, nil)
+			},
+			expectedError: models.ErrSKUNotFound,
+		},
+		{
+			name:  "total below reserved",
+			sku:   2,
+			total: 4,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{2}).
+					Return([]stockmodels.Item{{SKU: 2, TotalCount: 10, Reserved: 5}}, nil)
+			},
+			expectedError: models.ErrTotalBelowReserved,
+		},
+		{
+			name:  "total out of range",
+			sku:   3,
+			total: math.MaxInt32 + 1,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{3}).
+					Return([]stockmodels.Item{{SKU: 3, TotalCount: 10}}, nil)
+			},
+			expectedError: models.ErrInvalidStockTotal,
+		},
+		{
+			name:  "total equal to reserved",
+			sku:   4,
+			total: 5,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{4}).
+					Return([]stockmodels.Item{{SKU: 4, TotalCount: 10, Reserved: 5}}, nil)
+			},
+			mockApplyFunc: func() {
+				changes := []stockmodels.Change{{SKU: 4, OldTotal: 10, NewTotal: 5, Reserved: 5}}
+				stockRepositoryMock.UpsertTotalsMock.Expect(ctx, changes).Return(nil)
+				stockRepositoryMock.CreateAuditMock.Expect(ctx, stockmodels.ChangeActionSetTotal, "inventory", changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockChangedEventsMock.Expect(ctx, stockmodels.ChangeActionSetTotal, changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockLevelChangedEventsMock.Expect(ctx, []stockmodels.LevelChange{
+					{SKU: 4, AvailableBefore: 5, AvailableAfter: 0},
+				}).Return(nil)
+			},
+			expectedItem: stockmodels.Item{SKU: 4, TotalCount: 5, Reserved: 5},
+		},
+		{
+			name:  "unchanged total",
+			sku:   6,
+			total: 10,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{6}).
+					Return([]stockmodels.Item{{SKU: 6, TotalCount: 10, Reserved: 1}}, nil)
+			},
+			mockApplyFunc: func() {
+				changes := []stockmodels.Change{{SKU: 6, OldTotal: 10, NewTotal: 10, Reserved: 1}}
+				stockRepositoryMock.UpsertTotalsMock.Expect(ctx, changes).Return(nil)
+				stockRepositoryMock.CreateAuditMock.Expect(ctx, stockmodels.ChangeActionSetTotal, "inventory", changes).Return(nil)
+				stockOutboxRepositoryMock.CreateStockChangedEventsMock.Expect(ctx, stockmodels.ChangeActionSetTotal, changes).Return(nil)
+			},
+			expectedItem: stockmodels.Item{SKU: 6, TotalCount: 10, Reserved: 1},
+		},
+		{
+			name:  "audit error",
+			sku:   5,
+			total: 20,
+			mockLockFunc: func() {
+				stockRepositoryMock.GetBySKUsForUpdateMock.Expect(ctx, []uint32{5}).
+					Return([]stockmodels.Item{{SKU: 5, TotalCount: 10}}, nil)
+			},
+			mockApplyFunc: func() {
+				changes := []stockmodels.Change{{SKU: 5, OldTotal: 10, NewTotal: 20}}
+				stockRepositoryMock.UpsertTotalsMock.Expect(ctx, changes).Return(nil)
+				stockRepositoryMock.CreateAuditMock.Expect(ctx, stockmodels.ChangeActionSetTotal, "inventory", changes).
+					Return(errors.New("audit error"))
+			},
+			expectedError: errors.New("audit error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockLockFunc()
+			if tt.mockApplyFunc != nil {
+				tt.mockApplyFunc()
+			}
+
+			item, err := s.setTotal(ctx, tt.sku, tt.total, "inventory")
+			if tt.expectedError != nil {
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedItem, item)
+			}
+		})
+	}
+}
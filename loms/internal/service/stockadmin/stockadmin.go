@@ -0,0 +1,41 @@
+package stockadmin
+
+import (
+	"context"
+
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+)
+
+type Repository interface {
+	GetBySKUsForUpdate(ctx context.Context, skuIDs []uint32) ([]stockmodels.Item, error)
+	UpsertTotals(ctx context.Context, changes []stockmodels.Change) error
+	List(ctx context.Context, afterSKU uint32, limit uint32) ([]stockmodels.Item, error)
+	CreateAudit(ctx context.Context, action stockmodels.ChangeAction, reason string, changes []stockmodels.Change) error
+}
+
+type TxManager interface {
+	ReadCommitted(ctx context.Context, f func(context.Context) error) error
+}
+
+type StockOutboxRepository interface {
+	CreateStockChangedEvents(ctx context.Context, action stockmodels.ChangeAction, changes []stockmodels.Change) error
+	CreateStockLevelChangedEvents(ctx context.Context, changes []stockmodels.LevelChange) error
+}
+
+type Service struct {
+	stockRepository       Repository
+	txManager             TxManager
+	stockOutboxRepository StockOutboxRepository
+}
+
+func NewService(
+	repo Repository,
+	txManager TxManager,
+	stockOutboxRepository StockOutboxRepository,
+) *Service {
+	return &Service{
+		stockRepository:       repo,
+		txManager:             txManager,
+		stockOutboxRepository: stockOutboxRepository,
+	}
+}
@@ -2,8 +2,11 @@ package pg
 
 import (
 	"context"
+	"log/slog"
 	"math/rand"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
 )
@@ -59,3 +62,47 @@ func (c *Client) ReplicaDB() *pgxpool.Pool {
 func (c *Client) ReplicaDBs() []*pgxpool.Pool {
 	return c.replicaDBCs
 }
+
+// Listen calls notify on every notification of the channel until ctx is done.
+// It holds a master connection for the whole time and reconnects after errors.
+func (c *Client) Listen(ctx context.Context, channel string, notify func()) {
+	for ctx.Err() == nil {
+		err := c.listen(ctx, channel, notify)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Listening to postgres notifications failed", "channel", channel, "error", err)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+func (c *Client) listen(ctx context.Context, channel string, notify func()) error {
+	pooled, err := c.masterDBC.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the connection stays subscribed to the channel, so it is taken out of the pool and closed afterwards
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return err
+	}
+
+	// a notification may have been missed while reconnecting
+	notify()
+
+	for {
+		_, err = conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		notify()
+	}
+}
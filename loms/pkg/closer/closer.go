@@ -19,7 +19,7 @@ func Wait() {
 	globalCloser.Wait()
 }
 
-// CloseAll calls all closer functions.
+// CloseAll calls all closer functions in reverse order of adding.
 func CloseAll() {
 	globalCloser.CloseAll()
 }
@@ -60,7 +60,8 @@ func (c *Closer) Wait() {
 	<-c.done
 }
 
-// CloseAll calls all closer functions.
+// CloseAll calls all closer functions one by one in reverse order of adding,
+// so a function added after the ones it depends on is called before them.
 func (c *Closer) CloseAll() {
 	c.once.Do(func() {
 		defer close(c.done)
@@ -70,16 +71,8 @@ func (c *Closer) CloseAll() {
 		c.funcs = nil
 		c.mu.Unlock()
 
-		// call all Closer funcs async
-		errs := make(chan error, len(funcs))
-		for _, f := range funcs {
-			go func(f func() error) {
-				errs <- f()
-			}(f)
-		}
-
-		for i := 0; i < cap(errs); i++ {
-			if err := <-errs; err != nil {
+		for i := len(funcs) - 1; i >= 0; i-- {
+			if err := funcs[i](); err != nil {
 				log.Println("error returned from Closer")
 			}
 		}
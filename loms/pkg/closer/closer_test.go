@@ -0,0 +1,29 @@
+package closer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloserCloseAllInReverseOrder(t *testing.T) {
+	c := New()
+
+	var closed []string
+	for _, name := range []string{"db", "producer", "dispatcher"} {
+		c.Add(func() error {
+			closed = append(closed, name)
+			if name == "producer" {
+				return errors.New("close error")
+			}
+			return nil
+		})
+	}
+
+	c.CloseAll()
+	c.Wait()
+	c.CloseAll()
+
+	assert.Equal(t, []string{"dispatcher", "producer", "db"}, closed)
+}
@@ -5,6 +5,10 @@ INSERT INTO items(sku, total_count) VALUES (1076963, 100), (1148162, 200);
 
 -- +goose Down
 -- +goose StatementBegin
+DELETE FROM outbox_messages WHERE topic = 'loms.stock-level-events' AND key IN ('1076963'::bytea, '1148162'::bytea);
+DELETE FROM outbox_messages WHERE topic = 'loms.stock-events' AND key IN ('1076963'::bytea, '1148162'::bytea);
+DELETE FROM stock_audit WHERE sku IN (1076963, 1148162);
+DELETE FROM reservations WHERE sku IN (1076963, 1148162);
 DELETE FROM orders_to_items WHERE item_sku IN (1076963, 1148162);
 DELETE FROM items WHERE sku IN (1076963, 1148162);
 -- +goose StatementEnd
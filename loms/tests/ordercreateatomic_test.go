@@ -0,0 +1,233 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/models/order/requests"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock"
+	orderservice "github.com/BruteMors/marketplace-service/loms/internal/service/order"
+	stockservice "github.com/BruteMors/marketplace-service/loms/internal/service/stock"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+var errInjected = errors.New("injected failure")
+
+// failingStockService reserves items and then fails, as if the process died right after the reservation.
+type failingStockService struct {
+	orderservice.StockService
+}
+
+func (s failingStockService) Reserve(ctx context.Context, orderID int64, items []ordermodels.Item) error {
+	if err := s.StockService.Reserve(ctx, orderID, items); err != nil {
+		return err
+	}
+	return errInjected
+}
+
+// failingOrderRepository fails to move the order out of "new".
+type failingOrderRepository struct {
+	orderservice.Repository
+}
+
+func (r failingOrderRepository) SetStatus(context.Context, int64, ordermodels.Status) error {
+	return errInjected
+}
+
+// failingOutboxRepository fails to record the final status of the order.
+type failingOutboxRepository struct {
+	orderservice.StatusOutboxRepository
+}
+
+func (r failingOutboxRepository) CreateOrderStatusChangedEvent(ctx context.Context, orderID int64, status ordermodels.Status) error {
+	if status != ordermodels.OrderStatusNew {
+		return errInjected
+	}
+	return r.StatusOutboxRepository.CreateOrderStatusChangedEvent(ctx, orderID, status)
+}
+
+type orderCreateDeps struct {
+	client       *pg.Client
+	orderRepo    orderservice.Repository
+	stockService orderservice.StockService
+	outboxRepo   orderservice.StatusOutboxRepository
+}
+
+func newOrderCreateDeps(t *testing.T, ctx context.Context) orderCreateDeps {
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	txManager := transaction.NewTransactionManager(client)
+
+	return orderCreateDeps{
+		client:       client,
+		orderRepo:    order.NewRepository(client),
+		stockService: stockservice.NewService(stock.NewRepository(client), txManager, outbox.NewRepository(client)),
+		outboxRepo:   outbox.NewRepository(client),
+	}
+}
+
+func (d orderCreateDeps) service(ctx context.Context) *orderservice.Service {
+	return orderservice.NewService(
+		ctx,
+		d.orderRepo,
+		d.stockService,
+		transaction.NewTransactionManager(d.client),
+		d.outboxRepo,
+		time.Hour,
+		time.Hour,
+	)
+}
+
+func reservedCount(t *testing.T, ctx context.Context, client *pg.Client, sku uint32) int {
+	var reserved int
+	err := client.MasterDB().QueryRow(ctx, "SELECT reserved FROM items WHERE sku=$1", sku).Scan(&reserved)
+	require.NoError(t, err)
+	return reserved
+}
+
+func userOrdersCount(t *testing.T, ctx context.Context, client *pg.Client, userID int64) int {
+	var count int
+	err := client.MasterDB().QueryRow(ctx, "SELECT COUNT(*) FROM orders WHERE user_id=$1", userID).Scan(&count)
+	require.NoError(t, err)
+	return count
+}
+
+func orderEventStatuses(t *testing.T, ctx context.Context, client *pg.Client, orderID int64) []string {
+	rows, err := client.MasterDB().Query(ctx,
+		"SELECT convert_from(payload, 'UTF8')::jsonb ->> 'status' FROM outbox_messages WHERE key=$1 ORDER BY id",
+		[]byte(strconv.FormatInt(orderID, 10)),
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var statuses []string
+	for rows.Next() {
+		var status string
+		require.NoError(t, rows.Scan(&status))
+		statuses = append(statuses, status)
+	}
+	require.NoError(t, rows.Err())
+
+	return statuses
+}
+
+func TestOrderCreateCommitsAllSteps(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deps := newOrderCreateDeps(t, ctx)
+	s := deps.service(ctx)
+	defer s.Close()
+
+	orderID, err := s.OrderCreate(ctx, &requests.OrderCreate{
+		User:  9001,
+		Items: []requests.Item{{SKU: 1076963, Count: 3}},
+	})
+	require.NoError(t, err)
+
+	var status string
+	err = deps.client.MasterDB().QueryRow(ctx, "SELECT status FROM orders WHERE order_id=$1", orderID).Scan(&status)
+	require.NoError(t, err)
+	require.Equal(t, string(ordermodels.OrderStatusAwaitingPayment), status)
+	require.Equal(t, 3, reservedCount(t, ctx, deps.client, 1076963))
+	require.Equal(t, []string{"new", "awaiting payment"}, orderEventStatuses(t, ctx, deps.client, orderID))
+}
+
+func TestOrderCreateInsufficientStockCommitsFailedOrder(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deps := newOrderCreateDeps(t, ctx)
+	s := deps.service(ctx)
+	defer s.Close()
+
+	_, err := s.OrderCreate(ctx, &requests.OrderCreate{
+		User:  9002,
+		Items: []requests.Item{{SKU: 1076963, Count: 1000}},
+	})
+	require.ErrorIs(t, err, repository.ErrInsufficientStock)
+
+	var (
+		orderID int64
+		status  string
+	)
+	err = deps.client.MasterDB().QueryRow(ctx, "SELECT order_id, status FROM orders WHERE user_id=$1 ORDER BY order_id DESC LIMIT 1", 9002).Scan(&orderID, &status)
+	require.NoError(t, err)
+	require.Equal(t, string(ordermodels.OrderStatusFailed), status)
+	require.Equal(t, 0, reservedCount(t, ctx, deps.client, 1076963))
+	require.Equal(t, []string{"new", "failed"}, orderEventStatuses(t, ctx, deps.client, orderID))
+}
+
+func TestOrderCreateRollsBackOnFailureBetweenSteps(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID int64
+		inject func(deps *orderCreateDeps)
+	}{
+		{
+			name:   "failure after reservation",
+			userID: 9003,
+			inject: func(deps *orderCreateDeps) {
+				deps.stockService = failingStockService{StockService: deps.stockService}
+			},
+		},
+		{
+			name:   "failure on status update",
+			userID: 9004,
+			inject: func(deps *orderCreateDeps) {
+				deps.orderRepo = failingOrderRepository{Repository: deps.orderRepo}
+			},
+		},
+		{
+			name:   "failure on final outbox event",
+			userID: 9005,
+			inject: func(deps *orderCreateDeps) {
+				deps.outboxRepo = failingOutboxRepository{StatusOutboxRepository: deps.outboxRepo}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupTest(t)
+			defer teardownTest(t)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			deps := newOrderCreateDeps(t, ctx)
+			tt.inject(&deps)
+			s := deps.service(ctx)
+			defer s.Close()
+
+			_, err := s.OrderCreate(ctx, &requests.OrderCreate{
+				User: tt.userID,
+				Items: []requests.Item{
+					{SKU: 1076963, Count: 2},
+					{SKU: 1148162, Count: 5},
+				},
+			})
+			require.ErrorIs(t, err, errInjected)
+
+			require.Equal(t, 0, userOrdersCount(t, ctx, deps.client, tt.userID))
+			require.Equal(t, 0, reservedCount(t, ctx, deps.client, 1076963))
+			require.Equal(t, 0, reservedCount(t, ctx, deps.client, 1148162))
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/stretchr/testify/require"
+)
+
+// ordersEvents keeps status events of the given orders, the outbox may hold messages of other tests.
+func ordersEvents(t *testing.T, msgs []liboutbox.Message, orderIDs ...int64) map[int64][]ordermodels.Status {
+	byOrder := make(map[int64][]ordermodels.Status)
+	for _, msg := range msgs {
+		var event ordermodels.StatusChangedEvent
+		require.NoError(t, json.Unmarshal(msg.Payload, &event))
+
+		for _, orderID := range orderIDs {
+			if event.OrderID == orderID {
+				require.Equal(t, msg.ID, event.ID)
+				require.Equal(t, config.GetSendStatusChangedEventTopic(), msg.Topic)
+				require.Equal(t, strconv.FormatInt(orderID, 10), string(msg.Key))
+
+				byOrder[orderID] = append(byOrder[orderID], event.Status)
+			}
+		}
+	}
+	return byOrder
+}
+
+func TestOrderStatusOutboxBatch(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	outboxRepo := outbox.NewRepository(client)
+	store := outbox.NewStore(client)
+
+	items := []stockmodels.ReserveItem{{SKU: 1076963, Count: 1}}
+	first := createReservationOrder(t, ctx, client, items)
+	second := createReservationOrder(t, ctx, client, items)
+
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, first, ordermodels.OrderStatusNew))
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, first, ordermodels.OrderStatusAwaitingPayment))
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, second, ordermodels.OrderStatusNew))
+
+	tx, err := store.Begin(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	locked, err := store.Fetch(ctx, tx, 10000)
+	require.NoError(t, err)
+
+	// the second event of an order waits until the first one is sent
+	require.Equal(t, map[int64][]ordermodels.Status{
+		first:  {ordermodels.OrderStatusNew},
+		second: {ordermodels.OrderStatusNew},
+	}, ordersEvents(t, locked, first, second))
+
+	var sentIDs []int64
+	for _, msg := range locked {
+		sentIDs = append(sentIDs, msg.ID)
+	}
+
+	// another dispatcher skips the locked messages
+	other, err := store.Begin(ctx)
+	require.NoError(t, err)
+	otherLocked, err := store.Fetch(ctx, other, 10000)
+	require.NoError(t, err)
+	require.Empty(t, ordersEvents(t, otherLocked, first, second))
+	require.NoError(t, other.Rollback(ctx))
+
+	require.NoError(t, store.MarkSent(ctx, tx, sentIDs))
+	require.NoError(t, tx.Commit(ctx))
+
+	msgs, err := store.Fetch(ctx, client.MasterDB(), 10000)
+	require.NoError(t, err)
+	require.Equal(t, map[int64][]ordermodels.Status{
+		first: {ordermodels.OrderStatusAwaitingPayment},
+	}, ordersEvents(t, msgs, first, second))
+
+	backlog, err := store.Backlog(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, backlog.Size, int64(1))
+}
+
+// TestOrderStatusOutboxKeyOrder checks that concurrent dispatchers never publish two events
+// of an order at once, and that an event that failed to be published holds back the later ones.
+func TestOrderStatusOutboxKeyOrder(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	outboxRepo := outbox.NewRepository(client)
+	store := outbox.NewStore(client)
+
+	items := []stockmodels.ReserveItem{{SKU: 1076963, Count: 1}}
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusNew))
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusAwaitingPayment))
+
+	first, err := store.Begin(ctx)
+	require.NoError(t, err)
+	locked, err := store.Fetch(ctx, first, 10000)
+	require.NoError(t, err)
+	require.Equal(t, map[int64][]ordermodels.Status{
+		orderID: {ordermodels.OrderStatusNew},
+	}, ordersEvents(t, locked, orderID))
+
+	// the second worker gets neither the locked event nor the one queued behind it
+	second, err := store.Begin(ctx)
+	require.NoError(t, err)
+	otherLocked, err := store.Fetch(ctx, second, 10000)
+	require.NoError(t, err)
+	require.Empty(t, ordersEvents(t, otherLocked, orderID))
+	require.NoError(t, second.Rollback(ctx))
+
+	// publishing failed, the first event is fetched again before the second one
+	require.NoError(t, first.Rollback(ctx))
+
+	retry, err := store.Begin(ctx)
+	require.NoError(t, err)
+	defer func() {
+		_ = retry.Rollback(ctx)
+	}()
+
+	locked, err = store.Fetch(ctx, retry, 10000)
+	require.NoError(t, err)
+	require.Equal(t, map[int64][]ordermodels.Status{
+		orderID: {ordermodels.OrderStatusNew},
+	}, ordersEvents(t, locked, orderID))
+
+	var sentIDs []int64
+	for _, msg := range locked {
+		sentIDs = append(sentIDs, msg.ID)
+	}
+	require.NoError(t, store.MarkSent(ctx, retry, sentIDs))
+	require.NoError(t, retry.Commit(ctx))
+
+	msgs, err := store.Fetch(ctx, client.MasterDB(), 10000)
+	require.NoError(t, err)
+	require.Equal(t, map[int64][]ordermodels.Status{
+		orderID: {ordermodels.OrderStatusAwaitingPayment},
+	}, ordersEvents(t, msgs, orderID))
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	liboutbox "github.com/BruteMors/marketplace-service/libs/outbox"
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/stretchr/testify/require"
+)
+
+const outboxRetention = 29 * 24 * time.Hour
+
+func orderKey(orderID int64) []byte {
+	return []byte(strconv.FormatInt(orderID, 10))
+}
+
+// createSentStatusEvents adds sent events of a new order made age ago.
+func createSentStatusEvents(t *testing.T, ctx context.Context, client *pg.Client, age time.Duration) int64 {
+	items := []stockmodels.ReserveItem{{SKU: 1076963, Count: 1}}
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	outboxRepo := outbox.NewRepository(client)
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusNew))
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, orderID, ordermodels.OrderStatusAwaitingPayment))
+
+	_, err := client.MasterDB().Exec(ctx,
+		"UPDATE outbox_messages SET sent = TRUE, created_at = created_at - make_interval(secs => $2) WHERE key = $1",
+		orderKey(orderID), age.Seconds(),
+	)
+	require.NoError(t, err)
+
+	return orderID
+}
+
+func countRows(t *testing.T, ctx context.Context, client *pg.Client, table string, orderID int64) int {
+	var count int
+	err := client.MasterDB().QueryRow(ctx, "SELECT COUNT(*) FROM "+table+" WHERE key = $1", orderKey(orderID)).Scan(&count)
+	require.NoError(t, err)
+	return count
+}
+
+// deleteAllOldMessages repeats cleanup batches like the outbox dispatcher does.
+func deleteAllOldMessages(t *testing.T, ctx context.Context, store *liboutbox.Store, archive bool) int64 {
+	var total int64
+	for {
+		deleted, err := store.DeleteSent(ctx, outboxRetention, 1, archive)
+		require.NoError(t, err)
+		require.LessOrEqual(t, deleted, int64(1))
+
+		if deleted == 0 {
+			return total
+		}
+		total += deleted
+	}
+}
+
+func TestOrderStatusOutboxRetention(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	outboxRepo := outbox.NewRepository(client)
+	store := outbox.NewStore(client)
+
+	archived := createSentStatusEvents(t, ctx, client, 30*24*time.Hour)
+	recent := createSentStatusEvents(t, ctx, client, time.Hour)
+
+	items := []stockmodels.ReserveItem{{SKU: 1076963, Count: 1}}
+	unsent := createReservationOrder(t, ctx, client, items)
+	require.NoError(t, outboxRepo.CreateOrderStatusChangedEvent(ctx, unsent, ordermodels.OrderStatusNew))
+	_, err = client.MasterDB().Exec(ctx,
+		"UPDATE outbox_messages SET created_at = created_at - interval '30 days' WHERE key = $1", orderKey(unsent),
+	)
+	require.NoError(t, err)
+
+	require.GreaterOrEqual(t, deleteAllOldMessages(t, ctx, store, true), int64(2))
+	require.Equal(t, 0, countRows(t, ctx, client, "outbox_messages", archived))
+	require.Equal(t, 2, countRows(t, ctx, client, "outbox_messages_archive", archived))
+
+	deleted := createSentStatusEvents(t, ctx, client, 30*24*time.Hour)
+
+	require.GreaterOrEqual(t, deleteAllOldMessages(t, ctx, store, false), int64(2))
+	require.Equal(t, 0, countRows(t, ctx, client, "outbox_messages", deleted))
+	require.Equal(t, 0, countRows(t, ctx, client, "outbox_messages_archive", deleted))
+
+	// recent and unsent messages stay in the outbox
+	require.Equal(t, 2, countRows(t, ctx, client, "outbox_messages", recent))
+	require.Equal(t, 1, countRows(t, ctx, client, "outbox_messages", unsent))
+}
+
+func TestOrderStatusOutboxReset(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	store := outbox.NewStore(client)
+	topic := config.GetSendStatusChangedEventTopic()
+
+	byOrder := createSentStatusEvents(t, ctx, client, time.Hour)
+	byRange := createSentStatusEvents(t, ctx, client, 10*24*time.Hour)
+	untouched := createSentStatusEvents(t, ctx, client, 20*24*time.Hour)
+
+	_, err = store.Reset(ctx, liboutbox.ResetFilter{Topic: topic})
+	require.ErrorIs(t, err, liboutbox.ErrInvalidResetFilter)
+
+	reset, err := store.Reset(ctx, liboutbox.ResetFilter{Topic: topic, Key: orderKey(byOrder)})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), reset)
+
+	from := time.Now().Add(-11 * 24 * time.Hour)
+	to := time.Now().Add(-9 * 24 * time.Hour)
+	reset, err = store.Reset(ctx, liboutbox.ResetFilter{Topic: topic, From: &from, To: &to})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, reset, int64(2))
+
+	// messages already unsent are not counted again
+	reset, err = store.Reset(ctx, liboutbox.ResetFilter{Topic: topic, Key: orderKey(byOrder)})
+	require.NoError(t, err)
+	require.Zero(t, reset)
+
+	msgs, err := store.Fetch(ctx, client.MasterDB(), 10000)
+	require.NoError(t, err)
+	require.Equal(t, map[int64][]ordermodels.Status{
+		byOrder: {ordermodels.OrderStatusNew},
+		byRange: {ordermodels.OrderStatusNew},
+	}, ordersEvents(t, msgs, byOrder, byRange, untouched))
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	ordermodels "github.com/BruteMors/marketplace-service/loms/internal/models/order"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/order"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/stretchr/testify/require"
+)
+
+// createReservationOrder places an order the reservations ledger can refer to.
+func createReservationOrder(t *testing.T, ctx context.Context, client *pg.Client, items []stockmodels.ReserveItem) int64 {
+	orderItems := make([]ordermodels.Item, 0, len(items))
+	for _, item := range items {
+		orderItems = append(orderItems, ordermodels.Item{SKU: item.SKU, Count: item.Count})
+	}
+
+	orderID, err := order.NewRepository(client).Create(ctx, ordermodels.NewOrder{
+		User:   1,
+		Status: ordermodels.OrderStatusNew,
+		Items:  orderItems,
+	})
+	require.NoError(t, err)
+
+	return orderID
+}
+
+func TestReservationLedger(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	repo := stock.NewRepository(client)
+
+	items := []stockmodels.ReserveItem{
+		{SKU: 1076963, Count: 10},
+		{SKU: 1148162, Count: 20},
+	}
+
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	_, err = repo.Reserve(ctx, orderID, items)
+	require.NoError(t, err)
+
+	var active int
+	err = client.MasterDB().QueryRow(ctx, "SELECT COUNT(*) FROM reservations WHERE order_id=$1 AND state='active'", orderID).Scan(&active)
+	require.NoError(t, err)
+	require.Equal(t, 2, active)
+
+	_, err = repo.ReserveCancel(ctx, orderID)
+	require.NoError(t, err)
+
+	var cancelled int
+	err = client.MasterDB().QueryRow(ctx, "SELECT COUNT(*) FROM reservations WHERE order_id=$1 AND state='cancelled'", orderID).Scan(&cancelled)
+	require.NoError(t, err)
+	require.Equal(t, 2, cancelled)
+
+	_, err = repo.ReserveCancel(ctx, orderID)
+	require.ErrorIs(t, err, repository.ErrReservationNotFound)
+
+	err = repo.ReserveRemove(ctx, orderID)
+	require.ErrorIs(t, err, repository.ErrReservationNotFound)
+}
+
+func TestReservationDrift(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	repo := stock.NewRepository(client)
+
+	items := []stockmodels.ReserveItem{
+		{SKU: 1076963, Count: 10},
+	}
+
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	_, err = repo.Reserve(ctx, orderID, items)
+	require.NoError(t, err)
+
+	drifts, err := repo.GetReservationDrift(ctx)
+	require.NoError(t, err)
+	require.Empty(t, drifts)
+
+	_, err = client.MasterDB().Exec(ctx, "UPDATE items SET reserved = reserved + 5 WHERE sku=$1", items[0].SKU)
+	require.NoError(t, err)
+
+	drifts, err = repo.GetReservationDrift(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []stockmodels.ReservationDrift{
+		{SKU: 1076963, Reserved: 15, LedgerReserved: 10},
+	}, drifts)
+
+	_, err = client.MasterDB().Exec(ctx, "UPDATE items SET reserved = 0 WHERE sku=$1", items[0].SKU)
+	require.NoError(t, err)
+
+	_, err = repo.ReserveCancel(ctx, orderID)
+	require.ErrorIs(t, err, repository.ErrReservationDrift)
+}
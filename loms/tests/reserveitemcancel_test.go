@@ -26,7 +26,9 @@ func TestReserveCancel(t *testing.T) {
 		{SKU: 1148162, Count: 20},
 	}
 
-	err = repo.Reserve(ctx, items)
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	_, err = repo.Reserve(ctx, orderID, items)
 	require.NoError(t, err)
 
 	var reservedCount int
@@ -38,8 +40,12 @@ func TestReserveCancel(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 20, reservedCount)
 
-	err = repo.ReserveCancel(ctx, items)
+	changes, err := repo.ReserveCancel(ctx, orderID)
 	require.NoError(t, err)
+	require.ElementsMatch(t, []stockmodels.LevelChange{
+		{SKU: 1076963, AvailableBefore: 90, AvailableAfter: 100},
+		{SKU: 1148162, AvailableBefore: 180, AvailableAfter: 200},
+	}, changes)
 
 	err = client.MasterDB().QueryRow(ctx, "SELECT reserved FROM items WHERE sku=$1", items[0].SKU).Scan(&reservedCount)
 	require.NoError(t, err)
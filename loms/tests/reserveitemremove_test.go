@@ -26,7 +26,9 @@ func TestReserveRemove(t *testing.T) {
 		{SKU: 1148162, Count: 20},
 	}
 
-	err = repo.Reserve(ctx, items)
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	_, err = repo.Reserve(ctx, orderID, items)
 	require.NoError(t, err)
 
 	var reservedCount int
@@ -34,7 +36,7 @@ func TestReserveRemove(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 10, reservedCount)
 
-	err = repo.ReserveRemove(ctx, items)
+	err = repo.ReserveRemove(ctx, orderID)
 	require.NoError(t, err)
 
 	err = client.MasterDB().QueryRow(ctx, "SELECT reserved FROM items WHERE sku=$1", items[0].SKU).Scan(&reservedCount)
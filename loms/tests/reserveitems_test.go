@@ -27,8 +27,14 @@ func TestReserveItems(t *testing.T) {
 		{SKU: 1148162, Count: 20},
 	}
 
-	err = repo.Reserve(ctx, items)
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	changes, err := repo.Reserve(ctx, orderID, items)
 	require.NoError(t, err)
+	require.ElementsMatch(t, []stockmodels.LevelChange{
+		{SKU: 1076963, AvailableBefore: 100, AvailableAfter: 90},
+		{SKU: 1148162, AvailableBefore: 200, AvailableAfter: 180},
+	}, changes)
 
 	var reservedCount int
 	err = client.MasterDB().QueryRow(ctx, "SELECT reserved FROM items WHERE sku=$1", items[0].SKU).Scan(&reservedCount)
@@ -55,7 +61,9 @@ func TestReserveItemsInsufficientStock(t *testing.T) {
 		{SKU: 1076963, Count: 1000},
 	}
 
-	err = repo.Reserve(ctx, items)
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	_, err = repo.Reserve(ctx, orderID, items)
 	require.Error(t, err)
 	require.Equal(t, repository.ErrInsufficientStock, err)
 }
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/stretchr/testify/require"
+)
+
+// stockEvents returns the unsent outbox messages of the topic for the sku, oldest first.
+func stockEvents(t *testing.T, ctx context.Context, client *pg.Client, topic string, sku uint32) [][]byte {
+	rows, err := client.MasterDB().Query(ctx,
+		"SELECT payload FROM outbox_messages WHERE topic = $1 AND key = $2 AND sent = FALSE ORDER BY id",
+		topic, []byte(strconv.FormatUint(uint64(sku), 10)),
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var payloads [][]byte
+	for rows.Next() {
+		var payload []byte
+		require.NoError(t, rows.Scan(&payload))
+		payloads = append(payloads, payload)
+	}
+	require.NoError(t, rows.Err())
+
+	return payloads
+}
+
+func TestStockAdminChanges(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	stockRepo := stock.NewRepository(client)
+	outboxRepo := outbox.NewRepository(client)
+
+	// the import below creates this SKU, the test data migration only knows the fixture ones
+	defer func() {
+		for _, query := range []string{
+			"DELETE FROM outbox_messages WHERE key = '999999001'::bytea",
+			"DELETE FROM stock_audit WHERE sku = 999999001",
+			"DELETE FROM items WHERE sku = 999999001",
+		} {
+			_, err := client.MasterDB().Exec(ctx, query)
+			require.NoError(t, err)
+		}
+	}()
+
+	items, err := stockRepo.GetBySKUsForUpdate(ctx, []uint32{1076963, 999999001})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+
+	changes := []stockmodels.Change{
+		{SKU: 1076963, OldTotal: items[0].TotalCount, NewTotal: items[0].TotalCount + 10, Reserved: items[0].Reserved},
+		{SKU: 999999001, NewTotal: 5},
+	}
+
+	err = stockRepo.UpsertTotals(ctx, changes)
+	require.NoError(t, err)
+
+	err = stockRepo.CreateAudit(ctx, stockmodels.ChangeActionImport, "supplier feed", changes)
+	require.NoError(t, err)
+
+	err = outboxRepo.CreateStockChangedEvents(ctx, stockmodels.ChangeActionImport, changes)
+	require.NoError(t, err)
+
+	created, err := stockRepo.GetBySKU(ctx, 999999001)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), created.TotalCount)
+	require.Equal(t, uint64(0), created.Reserved)
+
+	var audited int
+	err = client.MasterDB().QueryRow(ctx, "SELECT COUNT(*) FROM stock_audit WHERE action='import' AND reason='supplier feed'").Scan(&audited)
+	require.NoError(t, err)
+	require.Equal(t, 2, audited)
+
+	page, err := stockRepo.List(ctx, 1076963, 100)
+	require.NoError(t, err)
+	require.NotEmpty(t, page)
+	require.Greater(t, page[0].SKU, uint32(1076963))
+
+	for _, change := range changes {
+		payloads := stockEvents(t, ctx, client, config.GetSendStockChangedEventTopic(), change.SKU)
+		require.NotEmpty(t, payloads)
+
+		var event stockmodels.ChangedEvent
+		require.NoError(t, json.Unmarshal(payloads[len(payloads)-1], &event))
+		require.NotZero(t, event.ID)
+		require.Equal(t, change.SKU, event.SKU)
+		require.Equal(t, stockmodels.ChangeActionImport, event.Action)
+		require.Equal(t, change.NewTotal, event.TotalCount)
+		require.Equal(t, change.Reserved, event.Reserved)
+	}
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/loms/internal/config"
+	stockmodels "github.com/BruteMors/marketplace-service/loms/internal/models/stock"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/outbox"
+	"github.com/BruteMors/marketplace-service/loms/internal/repository/postgres/stock"
+	"github.com/BruteMors/marketplace-service/loms/pkg/client/db/pg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStockLevelChangedEvents(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+
+	client, err := pg.New(ctx, pgMasterDSN, pgReplicaDSNs)
+	require.NoError(t, err)
+
+	stockRepo := stock.NewRepository(client)
+	outboxRepo := outbox.NewRepository(client)
+
+	items := []stockmodels.ReserveItem{
+		{SKU: 1076963, Count: 100},
+	}
+
+	orderID := createReservationOrder(t, ctx, client, items)
+
+	changes, err := stockRepo.Reserve(ctx, orderID, items)
+	require.NoError(t, err)
+
+	err = outboxRepo.CreateStockLevelChangedEvents(ctx, changes)
+	require.NoError(t, err)
+
+	payloads := stockEvents(t, ctx, client, config.GetSendStockLevelChangedEventTopic(), 1076963)
+	require.Len(t, payloads, 1)
+
+	var event stockmodels.LevelChangedEvent
+	require.NoError(t, json.Unmarshal(payloads[0], &event))
+	require.NotZero(t, event.ID)
+	require.Equal(t, uint32(1076963), event.SKU)
+	require.Equal(t, int64(100), event.AvailableBefore)
+	require.Equal(t, int64(0), event.AvailableAfter)
+}
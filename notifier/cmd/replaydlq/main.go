@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	"github.com/BruteMors/marketplace-service/libs/kafka/consumergroup"
+	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
+	"github.com/BruteMors/marketplace-service/libs/logger"
+	"github.com/BruteMors/marketplace-service/notifier/internal/config"
+)
+
+// replaydlq sends messages of the dead-letter queue of a topic back to the topic,
+// so that notifier handles them again.
+func main() {
+	envPath := flag.String("env", ".env", "path to the env file")
+	topic := flag.String("topic", "", "topic whose dead-letter queue is replayed")
+	groupID := flag.String("group", "", "consumer group that tracks replayed messages, <GROUP_ID>.replay by default")
+	flag.Parse()
+
+	handler := logger.NewCustomTextHandler(os.Stderr, "notifier-replaydlq", nil)
+
+	slog.SetDefault(slog.New(handler))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	replayed, err := run(ctx, *envPath, *topic, *groupID)
+	if err != nil {
+		log.Fatalf("failed to replay dead-letter queue: %s", err.Error())
+	}
+
+	slog.Info("dead-letter queue replayed", "topic", *topic, "messages", replayed)
+}
+
+func run(ctx context.Context, envPath, topic, groupID string) (int, error) {
+	if topic == "" {
+		return 0, errors.New("topic is required")
+	}
+
+	err := config.Load(envPath)
+	if err != nil {
+		return 0, err
+	}
+
+	kafkaConfig, err := config.NewKafkaConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	if groupID == "" {
+		groupID = kafkaConfig.GroupID() + ".replay"
+	}
+
+	syncProducer, err := producer.NewSyncProducer(kafka.Config{Brokers: kafkaConfig.Brokers()}, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer syncProducer.Close()
+
+	return consumergroup.Replay(ctx, kafkaConfig.Brokers(), groupID, topic, syncProducer)
+}
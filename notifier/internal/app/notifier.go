@@ -2,19 +2,25 @@ package app
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/BruteMors/marketplace-service/libs/kafka/consumergroup"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
 	"github.com/BruteMors/marketplace-service/notifier/internal/config"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/middleware"
+	"github.com/BruteMors/marketplace-service/notifier/internal/metric"
 	"github.com/BruteMors/marketplace-service/notifier/pkg/closer"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type NotifierApp struct {
 	serviceProvider *serviceProvider
 	consumerGroup   *consumergroup.ConsumerGroup
+	httpServer      *http.Server
 	shutdownTracer  func(context.Context) error
 	wg              sync.WaitGroup
 }
@@ -42,6 +48,13 @@ func (c *NotifierApp) Run() error {
 		}
 	}()
 
+	go func() {
+		err := c.runHTTPServer()
+		if err != nil {
+			log.Fatalf("failed to run http server: %v", err)
+		}
+	}()
+
 	wg := sync.WaitGroup{}
 	c.consumerGroup.Run(context.Background(), &wg)
 
@@ -54,7 +67,9 @@ func (c *NotifierApp) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		c.initConfig,
 		c.initServiceProvider,
+		c.initMetrics,
 		c.initTracing,
+		c.initHTTPServer,
 		c.initKafkaConsumerGroup,
 	}
 
@@ -82,6 +97,49 @@ func (c *NotifierApp) initServiceProvider(_ context.Context) error {
 	return nil
 }
 
+func (c *NotifierApp) initMetrics(ctx context.Context) error {
+	err := metric.Init(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *NotifierApp) initHTTPServer(ctx context.Context) error {
+	contact := c.serviceProvider.ContactHttpApi(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /user/{user_id}/contact", middleware.ErrorWrapper(contact.GetContact))
+	mux.Handle("PUT /user/{user_id}/contact", middleware.ErrorWrapper(contact.SaveContact))
+	mux.Handle("GET /user/{user_id}/preferences", middleware.ErrorWrapper(contact.GetPreferences))
+	mux.Handle("PUT /user/{user_id}/preferences", middleware.ErrorWrapper(contact.SetPreferences))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	c.httpServer = &http.Server{
+		Addr:    c.serviceProvider.HTTPServerConfig().Address(),
+		Handler: mux,
+	}
+
+	closer.Add(func() error {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return c.httpServer.Shutdown(shutdownCtx)
+	})
+
+	return nil
+}
+
+func (c *NotifierApp) runHTTPServer() error {
+	log.Printf("HTTP server is running on %s", c.serviceProvider.HTTPServerConfig().Address())
+
+	err := c.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
 func (c *NotifierApp) initKafkaConsumerGroup(ctx context.Context) error {
 	c.consumerGroup = c.serviceProvider.KafkaConsumerGroup(ctx)
 	return nil
@@ -4,19 +4,47 @@ import (
 	"context"
 	"log"
 
+	"github.com/BruteMors/marketplace-service/libs/kafka"
 	"github.com/BruteMors/marketplace-service/libs/kafka/consumergroup"
-	"github.com/BruteMors/marketplace-service/loms/pkg/closer"
+	"github.com/BruteMors/marketplace-service/libs/kafka/producer"
+	"github.com/BruteMors/marketplace-service/notifier/internal/channel/email"
+	"github.com/BruteMors/marketplace-service/notifier/internal/channel/file"
+	"github.com/BruteMors/marketplace-service/notifier/internal/channel/webhook"
 	"github.com/BruteMors/marketplace-service/notifier/internal/config"
+	contactHttpApi "github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/handlers/contact"
 	"github.com/BruteMors/marketplace-service/notifier/internal/controller/kafka/orderstatus"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/kafka/stocklevel"
+	"github.com/BruteMors/marketplace-service/notifier/internal/metric"
+	contactRepository "github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/contact"
+	inboxRepository "github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/routing"
+	contactService "github.com/BruteMors/marketplace-service/notifier/internal/service/contact"
 	"github.com/BruteMors/marketplace-service/notifier/internal/service/notifier"
+	"github.com/BruteMors/marketplace-service/notifier/pkg/closer"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type serviceProvider struct {
 	kafkaConfig             *config.KafkaConfig
+	retryConfig             *config.RetryConfig
+	consumerConfig          *config.ConsumerConfig
+	httpServerConfig        *config.HTTPServerConfig
+	notificationConfig      *config.NotificationConfig
+	inboxConfig             *config.InboxConfig
+	pgConfig                *config.PGConfig
+	dbPool                  *pgxpool.Pool
+	inboxRepository         *inboxRepository.Repository
+	contactRepository       *contactRepository.Repository
+	contactService          *contactService.Service
+	contactHttpApi          *contactHttpApi.HttpApi
+	routingRules            *routing.Rules
+	channels                []notifier.Channel
+	mqSyncProducer          *producer.SyncProducer
 	consumerGroup           *consumergroup.ConsumerGroup
 	consumerGroupHandler    *consumergroup.Handler
 	notifierService         *notifier.Service
 	orderStatusKafkaHandler *orderstatus.KafkaHandler
+	stockLevelKafkaHandler  *stocklevel.KafkaHandler
 }
 
 func newServiceProvider() *serviceProvider {
@@ -36,12 +64,113 @@ func (s *serviceProvider) KafkaConfig() *config.KafkaConfig {
 	return s.kafkaConfig
 }
 
+func (s *serviceProvider) RetryConfig() *config.RetryConfig {
+	if s.retryConfig == nil {
+		cfg, err := config.NewRetryConfig()
+		if err != nil {
+			log.Fatalf("failed to get retry config: %s", err.Error())
+		}
+
+		s.retryConfig = cfg
+	}
+
+	return s.retryConfig
+}
+
+func (s *serviceProvider) ConsumerConfig() *config.ConsumerConfig {
+	if s.consumerConfig == nil {
+		cfg, err := config.NewConsumerConfig()
+		if err != nil {
+			log.Fatalf("failed to get consumer config: %s", err.Error())
+		}
+
+		s.consumerConfig = cfg
+	}
+
+	return s.consumerConfig
+}
+
+func (s *serviceProvider) HTTPServerConfig() *config.HTTPServerConfig {
+	if s.httpServerConfig == nil {
+		cfg, err := config.NewHTTPServerConfig()
+		if err != nil {
+			log.Fatalf("failed to get http server config: %s", err.Error())
+		}
+
+		s.httpServerConfig = cfg
+	}
+
+	return s.httpServerConfig
+}
+
+func (s *serviceProvider) NotificationConfig() *config.NotificationConfig {
+	if s.notificationConfig == nil {
+		cfg, err := config.NewNotificationConfig()
+		if err != nil {
+			log.Fatalf("failed to get notification config: %s", err.Error())
+		}
+
+		s.notificationConfig = cfg
+	}
+
+	return s.notificationConfig
+}
+
+func (s *serviceProvider) InboxConfig() *config.InboxConfig {
+	if s.inboxConfig == nil {
+		cfg, err := config.NewInboxConfig()
+		if err != nil {
+			log.Fatalf("failed to get inbox config: %s", err.Error())
+		}
+
+		s.inboxConfig = cfg
+	}
+
+	return s.inboxConfig
+}
+
+func (s *serviceProvider) PGConfig() *config.PGConfig {
+	if s.pgConfig == nil {
+		cfg, err := config.NewPGConfig()
+		if err != nil {
+			log.Fatalf("failed to get pg config: %s", err.Error())
+		}
+
+		s.pgConfig = cfg
+	}
+
+	return s.pgConfig
+}
+
+func (s *serviceProvider) DBPool(ctx context.Context) *pgxpool.Pool {
+	if s.dbPool == nil {
+		pool, err := pgxpool.New(ctx, s.PGConfig().DSN())
+		if err != nil {
+			log.Fatalf("failed to create db pool: %s", err.Error())
+		}
+
+		err = pool.Ping(ctx)
+		if err != nil {
+			log.Fatalf("ping error: %s", err.Error())
+		}
+
+		closer.Add(func() error {
+			pool.Close()
+			return nil
+		})
+
+		s.dbPool = pool
+	}
+
+	return s.dbPool
+}
+
 func (s *serviceProvider) KafkaConsumerGroup(ctx context.Context) *consumergroup.ConsumerGroup {
 	if s.consumerGroup == nil {
 		consumerGroup, err := consumergroup.NewConsumerGroup(
 			s.KafkaConfig().Brokers(),
 			s.KafkaConfig().GroupID(),
-			[]string{s.KafkaConfig().GetOrderEventsTopic()},
+			s.KafkaConsumerGroupHandler(ctx).Topics(),
 			s.KafkaConsumerGroupHandler(ctx),
 			nil,
 		)
@@ -61,8 +190,15 @@ func (s *serviceProvider) KafkaConsumerGroupHandler(ctx context.Context) *consum
 	if s.consumerGroupHandler == nil {
 		topicHandlers := make(map[string]consumergroup.TopicHandler)
 		topicHandlers[s.KafkaConfig().GetOrderEventsTopic()] = s.OrderStatusKafkaHandler(ctx)
+		topicHandlers[s.KafkaConfig().GetStockLevelEventsTopic()] = s.StockLevelKafkaHandler(ctx)
 
-		consumerGroupHandler := consumergroup.NewConsumerGroupHandler(topicHandlers)
+		consumerGroupHandler := consumergroup.NewConsumerGroupHandler(
+			topicHandlers,
+			consumergroup.WithErrorPolicy(s.ErrorPolicy()),
+			consumergroup.WithConcurrency(s.ConsumerConfig().Workers()),
+			consumergroup.WithCommitBatch(s.ConsumerConfig().CommitBatch(), s.ConsumerConfig().CommitInterval()),
+			consumergroup.WithCommitLagObserver(metric.SetCommitLag),
+		)
 
 		s.consumerGroupHandler = consumerGroupHandler
 	}
@@ -70,6 +206,39 @@ func (s *serviceProvider) KafkaConsumerGroupHandler(ctx context.Context) *consum
 	return s.consumerGroupHandler
 }
 
+// ErrorPolicy publishes to retry topics and the DLQ only when they are configured,
+// so the producer is not created otherwise.
+func (s *serviceProvider) ErrorPolicy() consumergroup.ErrorPolicy {
+	policy := consumergroup.ErrorPolicy{
+		Attempts:    s.RetryConfig().Attempts(),
+		Backoff:     s.RetryConfig().Backoff(),
+		MaxBackoff:  s.RetryConfig().MaxBackoff(),
+		RetryDelays: s.RetryConfig().RetryDelays(),
+		DLQ:         s.RetryConfig().DLQEnabled(),
+	}
+
+	if len(policy.RetryDelays) > 0 || policy.DLQ {
+		policy.Publisher = s.KafkaSyncProducer()
+	}
+
+	return policy
+}
+
+func (s *serviceProvider) KafkaSyncProducer() *producer.SyncProducer {
+	if s.mqSyncProducer == nil {
+		syncProducer, err := producer.NewSyncProducer(kafka.Config{Brokers: s.KafkaConfig().Brokers()}, nil)
+		if err != nil {
+			log.Fatalf("failed to create kafka sync producer: %s", err.Error())
+		}
+
+		s.mqSyncProducer = syncProducer
+
+		closer.Add(s.mqSyncProducer.Close)
+	}
+
+	return s.mqSyncProducer
+}
+
 func (s *serviceProvider) OrderStatusKafkaHandler(ctx context.Context) *orderstatus.KafkaHandler {
 	if s.orderStatusKafkaHandler == nil {
 		orderStatusKafkaHandler := orderstatus.NewKafkaHandler(s.NotifierService(ctx))
@@ -79,11 +248,131 @@ func (s *serviceProvider) OrderStatusKafkaHandler(ctx context.Context) *ordersta
 	return s.orderStatusKafkaHandler
 }
 
-func (s *serviceProvider) NotifierService(_ context.Context) *notifier.Service {
+func (s *serviceProvider) StockLevelKafkaHandler(ctx context.Context) *stocklevel.KafkaHandler {
+	if s.stockLevelKafkaHandler == nil {
+		stockLevelKafkaHandler := stocklevel.NewKafkaHandler(s.NotifierService(ctx))
+		s.stockLevelKafkaHandler = stockLevelKafkaHandler
+	}
+
+	return s.stockLevelKafkaHandler
+}
+
+func (s *serviceProvider) RoutingRules() *routing.Rules {
+	if s.routingRules == nil {
+		rules, err := routing.Load(s.NotificationConfig().RoutesPath())
+		if err != nil {
+			log.Fatalf("failed to load notification routes: %s", err.Error())
+		}
+
+		s.routingRules = rules
+	}
+
+	return s.routingRules
+}
+
+// Channels builds only the channels the routes deliver to, so settings
+// of a channel are required only when it is used.
+func (s *serviceProvider) Channels() []notifier.Channel {
+	if s.channels == nil {
+		channels := make([]notifier.Channel, 0)
+
+		for _, name := range s.RoutingRules().Channels() {
+			switch name {
+			case email.Name:
+				cfg, err := config.NewSMTPConfig()
+				if err != nil {
+					log.Fatalf("failed to get smtp config: %s", err.Error())
+				}
+
+				emailChannel, err := email.NewChannel(cfg.Addr(), cfg.From(), cfg.To(), cfg.Username(), cfg.Password(), cfg.Timeout())
+				if err != nil {
+					log.Fatalf("failed to create email channel: %s", err.Error())
+				}
+
+				channels = append(channels, emailChannel)
+			case webhook.Name:
+				cfg, err := config.NewWebhookConfig()
+				if err != nil {
+					log.Fatalf("failed to get webhook config: %s", err.Error())
+				}
+
+				channels = append(channels, webhook.NewChannel(cfg.URL(), cfg.Secret(), cfg.Timeout()))
+			case file.Name:
+				path := s.NotificationConfig().FilePath()
+				if path == config.StdoutPath {
+					channels = append(channels, file.NewStdoutChannel())
+					continue
+				}
+
+				fileChannel, err := file.NewFileChannel(path)
+				if err != nil {
+					log.Fatalf("failed to create file channel: %s", err.Error())
+				}
+
+				closer.Add(fileChannel.Close)
+
+				channels = append(channels, fileChannel)
+			default:
+				log.Fatalf("unknown notification channel: %s", name)
+			}
+		}
+
+		s.channels = channels
+	}
+
+	return s.channels
+}
+
+func (s *serviceProvider) NotifierService(ctx context.Context) *notifier.Service {
 	if s.notifierService == nil {
-		notifierSrv := notifier.NewNotifierService()
+		notifierSrv := notifier.NewNotifierService(
+			ctx,
+			s.RoutingRules(),
+			s.Channels(),
+			s.ContactRepository(ctx),
+			s.InboxRepository(ctx),
+			s.InboxConfig().Retention(),
+			s.InboxConfig().CleanupInterval(),
+		)
 		s.notifierService = notifierSrv
+
+		closer.Add(func() error {
+			notifierSrv.Close()
+			return nil
+		})
 	}
 
 	return s.notifierService
 }
+
+func (s *serviceProvider) ContactRepository(ctx context.Context) *contactRepository.Repository {
+	if s.contactRepository == nil {
+		s.contactRepository = contactRepository.NewRepository(s.DBPool(ctx))
+	}
+
+	return s.contactRepository
+}
+
+func (s *serviceProvider) InboxRepository(ctx context.Context) *inboxRepository.Repository {
+	if s.inboxRepository == nil {
+		s.inboxRepository = inboxRepository.NewRepository(s.DBPool(ctx))
+	}
+
+	return s.inboxRepository
+}
+
+func (s *serviceProvider) ContactService(ctx context.Context) *contactService.Service {
+	if s.contactService == nil {
+		s.contactService = contactService.NewService(s.ContactRepository(ctx))
+	}
+
+	return s.contactService
+}
+
+func (s *serviceProvider) ContactHttpApi(ctx context.Context) *contactHttpApi.HttpApi {
+	if s.contactHttpApi == nil {
+		s.contactHttpApi = contactHttpApi.NewContactHttpApi(s.ContactService(ctx))
+	}
+
+	return s.contactHttpApi
+}
@@ -0,0 +1,166 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+)
+
+const Name = notification.ChannelEmail
+
+type Channel struct {
+	addr      string
+	host      string
+	from      string
+	defaultTo []string
+	auth      smtp.Auth
+	timeout   time.Duration
+	dialer    net.Dialer
+}
+
+// NewChannel sends plain text mail through the SMTP server at addr. Messages without
+// a recipient address go to defaultTo. An empty username disables authentication,
+// which local stub servers expect. A session with the server takes at most timeout.
+func NewChannel(addr, from string, defaultTo []string, username, password string, timeout time.Duration) (*Channel, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &Channel{
+		addr:      addr,
+		host:      host,
+		from:      from,
+		defaultTo: defaultTo,
+		auth:      auth,
+		timeout:   timeout,
+	}, nil
+}
+
+func (c *Channel) Name() string {
+	return Name
+}
+
+// Send delivers the message the way smtp.SendMail does, but the session stops
+// when ctx is done or the timeout passes, so a stuck server does not hold the event.
+func (c *Channel) Send(ctx context.Context, recipient notification.Recipient, message notification.Message) error {
+	to := c.defaultTo
+	if recipient.Address != "" {
+		to = []string{recipient.Address}
+	}
+
+	if len(to) == 0 {
+		return errors.New("no recipient address")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := c.dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		err = conn.SetDeadline(deadline)
+		if err != nil {
+			return err
+		}
+	}
+
+	// unblocks the session when ctx is canceled before the deadline
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	err = c.send(conn, to, c.compose(to, message))
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", ctx.Err(), err)
+	}
+
+	return err
+}
+
+func (c *Channel) send(conn net.Conn, to []string, msg []byte) error {
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: c.host})
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp server does not support AUTH")
+		}
+
+		err = client.Auth(c.auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(c.from)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range to {
+		err = client.Rcpt(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (c *Channel) compose(to []string, message notification.Message) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "From: %s\r\n", c.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+
+	return []byte(b.String())
+}
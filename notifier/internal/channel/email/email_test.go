@@ -0,0 +1,153 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mail struct {
+	from string
+	to   []string
+	data string
+}
+
+// stubServer accepts a single SMTP session and sends the mail it received to the returned channel.
+// With hang set it greets the client and then never answers.
+func stubServer(t *testing.T, hang bool) (string, <-chan mail) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	mails := make(chan mail, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 localhost ESMTP stub")
+
+		if hang {
+			_, _ = bufio.NewReader(conn).ReadString(0)
+			return
+		}
+
+		var m mail
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+
+			cmd := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				_ = tp.PrintfLine("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				m.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+				_ = tp.PrintfLine("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				m.to = append(m.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+				_ = tp.PrintfLine("250 OK")
+			case cmd == "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				m.data = string(data)
+				_ = tp.PrintfLine("250 queued")
+			case cmd == "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				mails <- m
+				return
+			default:
+				_ = tp.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+
+	return ln.Addr().String(), mails
+}
+
+func TestChannelSend(t *testing.T) {
+	addr, mails := stubServer(t, false)
+
+	c, err := NewChannel(addr, "notifier@marketplace.local", []string{"ops@marketplace.local"}, "", "", time.Second)
+	require.NoError(t, err)
+
+	err = c.Send(context.Background(), notification.Recipient{UserID: 1, Address: "user@example.com"}, notification.Message{
+		Subject: "Заказ 10 оплачен",
+		Body:    "line 1\nline 2",
+	})
+	require.NoError(t, err)
+
+	m := <-mails
+	assert.Equal(t, "notifier@marketplace.local", m.from)
+	assert.Equal(t, []string{"user@example.com"}, m.to)
+	assert.Contains(t, m.data, "To: user@example.com\n")
+	assert.Contains(t, m.data, "Subject: =?utf-8?q?")
+	assert.True(t, strings.HasSuffix(m.data, "\nline 1\nline 2\n"))
+}
+
+func TestChannelSendDefaultRecipients(t *testing.T) {
+	addr, mails := stubServer(t, false)
+
+	c, err := NewChannel(addr, "notifier@marketplace.local", []string{"ops@marketplace.local", "stock@marketplace.local"}, "", "", time.Second)
+	require.NoError(t, err)
+
+	err = c.Send(context.Background(), notification.Recipient{}, notification.Message{Subject: "out of stock"})
+	require.NoError(t, err)
+
+	m := <-mails
+	assert.Equal(t, []string{"ops@marketplace.local", "stock@marketplace.local"}, m.to)
+}
+
+func TestChannelSendNoRecipient(t *testing.T) {
+	c, err := NewChannel("127.0.0.1:1", "notifier@marketplace.local", nil, "", "", time.Second)
+	require.NoError(t, err)
+
+	err = c.Send(context.Background(), notification.Recipient{}, notification.Message{})
+	assert.EqualError(t, err, "no recipient address")
+}
+
+func TestChannelSendTimeout(t *testing.T) {
+	addr, _ := stubServer(t, true)
+
+	c, err := NewChannel(addr, "notifier@marketplace.local", nil, "", "", 100*time.Millisecond)
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = c.Send(context.Background(), notification.Recipient{Address: "user@example.com"}, notification.Message{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestChannelSendCanceled(t *testing.T) {
+	addr, _ := stubServer(t, true)
+
+	c, err := NewChannel(addr, "notifier@marketplace.local", nil, "", "", time.Minute)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	err = c.Send(ctx, notification.Recipient{Address: "user@example.com"}, notification.Message{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,77 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+)
+
+const Name = notification.ChannelFile
+
+type record struct {
+	At      time.Time `json:"at"`
+	UserID  int64     `json:"user_id,omitempty"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+}
+
+// Channel writes every message as a JSON line, to a file or to the standard output.
+type Channel struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+func NewStdoutChannel() *Channel {
+	return &Channel{
+		w: os.Stdout,
+	}
+}
+
+func NewFileChannel(path string) (*Channel, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Channel{
+		w:      f,
+		closer: f,
+	}, nil
+}
+
+func (c *Channel) Name() string {
+	return Name
+}
+
+func (c *Channel) Send(_ context.Context, recipient notification.Recipient, message notification.Message) error {
+	line, err := json.Marshal(record{
+		At:      time.Now().UTC(),
+		UserID:  recipient.UserID,
+		Subject: message.Subject,
+		Body:    message.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err = c.w.Write(append(line, '\n'))
+
+	return err
+}
+
+func (c *Channel) Close() error {
+	if c.closer == nil {
+		return nil
+	}
+
+	return c.closer.Close()
+}
@@ -0,0 +1,66 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileChannelSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+
+	c, err := NewFileChannel(path)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := c.Send(context.Background(), notification.Recipient{UserID: 1}, notification.Message{
+				Subject: "Order 10 is paid",
+				Body:    "line 1\nline 2",
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, c.Close())
+
+	// the file is appended to, not truncated, when the channel is opened again
+	c, err = NewFileChannel(path)
+	require.NoError(t, err)
+	require.NoError(t, c.Send(context.Background(), notification.Recipient{}, notification.Message{Subject: "SKU 1 is out of stock"}))
+	require.NoError(t, c.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var r record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &r))
+		records = append(records, r)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, records, 11)
+
+	assert.Equal(t, int64(1), records[0].UserID)
+	assert.Equal(t, "Order 10 is paid", records[0].Subject)
+	assert.Equal(t, "line 1\nline 2", records[0].Body)
+	assert.WithinDuration(t, time.Now(), records[0].At, time.Minute)
+
+	assert.Zero(t, records[10].UserID)
+	assert.Equal(t, "SKU 1 is out of stock", records[10].Subject)
+}
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	Name = notification.ChannelWebhook
+
+	SignatureHeader = "X-Notifier-Signature"
+	TimestampHeader = "X-Notifier-Timestamp"
+)
+
+type payload struct {
+	UserID  int64     `json:"user_id,omitempty"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+type Channel struct {
+	defaultURL string
+	secret     []byte
+	client     *http.Client
+}
+
+// NewChannel posts messages to the recipient address, or to defaultURL when there is none.
+func NewChannel(defaultURL, secret string, timeout time.Duration) *Channel {
+	return &Channel{
+		defaultURL: defaultURL,
+		secret:     []byte(secret),
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *Channel) Name() string {
+	return Name
+}
+
+// Send posts the message as JSON. The receiver verifies it by computing
+// hex(HMAC-SHA256(secret, timestamp + "." + body)) and comparing it with the signature header.
+func (c *Channel) Send(ctx context.Context, recipient notification.Recipient, message notification.Message) error {
+	url := c.defaultURL
+	if recipient.Address != "" {
+		url = recipient.Address
+	}
+
+	if url == "" {
+		return errors.New("no recipient address")
+	}
+
+	now := time.Now().UTC()
+
+	body, err := json.Marshal(payload{
+		UserID:  recipient.UserID,
+		Subject: message.Subject,
+		Body:    message.Body,
+		SentAt:  now,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(c.secret, timestamp, body))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the timestamp and the body joined with a dot.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"subject":"s"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"dc2c74c4b1ad24d2f6476ff02499595566bad18cf8e1eb7469c1101a2e523846",
+		Sign([]byte("secret"), "1700000000", []byte(`{"subject":"s"}`)),
+	)
+}
+
+func TestChannelSend(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+
+	requests := make(chan request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{header: r.Header, body: body}
+	}))
+	defer server.Close()
+
+	c := NewChannel(server.URL+"/operator", "secret", time.Second)
+
+	err := c.Send(context.Background(), notification.Recipient{UserID: 1, Address: server.URL + "/user"}, notification.Message{
+		Subject: "Заказ 10 оплачен",
+		Body:    "body",
+	})
+	require.NoError(t, err)
+
+	req := <-requests
+	assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+
+	timestamp := req.header.Get(TimestampHeader)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), time.Unix(unix, 0), time.Minute)
+
+	assert.Equal(t, "sha256="+Sign([]byte("secret"), timestamp, req.body), req.header.Get(SignatureHeader))
+	assert.NotEqual(t, "sha256="+Sign([]byte("other"), timestamp, req.body), req.header.Get(SignatureHeader))
+
+	var p payload
+	require.NoError(t, json.Unmarshal(req.body, &p))
+	assert.Equal(t, int64(1), p.UserID)
+	assert.Equal(t, "Заказ 10 оплачен", p.Subject)
+	assert.Equal(t, "body", p.Body)
+}
+
+func TestChannelSendAddress(t *testing.T) {
+	paths := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		defaultURL string
+		recipient  notification.Recipient
+		path       string
+		err        string
+	}{
+		{
+			name:       "recipient address",
+			defaultURL: server.URL + "/operator",
+			recipient:  notification.Recipient{UserID: 1, Address: server.URL + "/user"},
+			path:       "/user",
+		},
+		{
+			name:       "default url",
+			defaultURL: server.URL + "/operator",
+			recipient:  notification.Recipient{},
+			path:       "/operator",
+		},
+		{
+			name:      "no address",
+			recipient: notification.Recipient{},
+			err:       "no recipient address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChannel(tt.defaultURL, "secret", time.Second)
+
+			err := c.Send(context.Background(), tt.recipient, notification.Message{})
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.path, <-paths)
+		})
+	}
+}
+
+func TestChannelSendErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := NewChannel(server.URL, "secret", time.Second)
+
+	err := c.Send(context.Background(), notification.Recipient{}, notification.Message{})
+	assert.EqualError(t, err, "webhook responded with status 502")
+}
+
+func TestChannelSendTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := NewChannel(server.URL, "secret", 100*time.Millisecond)
+
+	err := c.Send(context.Background(), notification.Recipient{}, notification.Message{})
+	assert.Error(t, err)
+}
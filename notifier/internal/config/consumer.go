@@ -0,0 +1,76 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	handleWorkersEnvName  = "KAFKA_HANDLE_WORKERS"
+	commitBatchEnvName    = "KAFKA_COMMIT_BATCH"
+	commitIntervalEnvName = "KAFKA_COMMIT_INTERVAL"
+
+	defaultHandleWorkers  = 1
+	defaultCommitBatch    = 1
+	defaultCommitInterval = time.Second
+)
+
+type ConsumerConfig struct {
+	workers        int
+	commitBatch    int
+	commitInterval time.Duration
+}
+
+func NewConsumerConfig() (*ConsumerConfig, error) {
+	workers, err := positiveIntEnv(handleWorkersEnvName, defaultHandleWorkers)
+	if err != nil {
+		return nil, errors.New("handle workers is invalid")
+	}
+
+	commitBatch, err := positiveIntEnv(commitBatchEnvName, defaultCommitBatch)
+	if err != nil {
+		return nil, errors.New("commit batch is invalid")
+	}
+
+	commitInterval, err := durationEnv(commitIntervalEnvName, defaultCommitInterval)
+	if err != nil {
+		return nil, errors.New("commit interval is invalid")
+	}
+
+	return &ConsumerConfig{
+		workers:        workers,
+		commitBatch:    commitBatch,
+		commitInterval: commitInterval,
+	}, nil
+}
+
+func positiveIntEnv(name string, fallback int) (int, error) {
+	raw := os.Getenv(name)
+	if len(raw) == 0 {
+		return fallback, nil
+	}
+
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed <= 0 {
+		return 0, errors.New("invalid number")
+	}
+
+	return parsed, nil
+}
+
+// Workers is how many messages of a partition are handled at once. Messages of one order stay in order.
+func (c *ConsumerConfig) Workers() int {
+	return c.workers
+}
+
+// CommitBatch is how many handled messages are committed at once.
+func (c *ConsumerConfig) CommitBatch() int {
+	return c.commitBatch
+}
+
+// CommitInterval commits handled messages of an unfilled batch. Zero commits by batch size only.
+func (c *ConsumerConfig) CommitInterval() time.Duration {
+	return c.commitInterval
+}
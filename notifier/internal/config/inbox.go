@@ -0,0 +1,55 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	inboxRetentionEnvName       = "INBOX_RETENTION"
+	inboxCleanupIntervalEnvName = "INBOX_CLEANUP_INTERVAL"
+
+	defaultInboxRetention       = 7 * 24 * time.Hour
+	defaultInboxCleanupInterval = time.Hour
+)
+
+type InboxConfig struct {
+	retention       time.Duration
+	cleanupInterval time.Duration
+}
+
+func NewInboxConfig() (*InboxConfig, error) {
+	retention := defaultInboxRetention
+	if raw := os.Getenv(inboxRetentionEnvName); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid inbox retention")
+		}
+		retention = parsed
+	}
+
+	cleanupInterval := defaultInboxCleanupInterval
+	if raw := os.Getenv(inboxCleanupIntervalEnvName); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			return nil, errors.New("invalid inbox cleanup interval")
+		}
+		cleanupInterval = parsed
+	}
+
+	return &InboxConfig{
+		retention:       retention,
+		cleanupInterval: cleanupInterval,
+	}, nil
+}
+
+// Retention is how long a processed event is remembered. It has to outlast
+// the time loms may redeliver the event, older duplicates are processed again.
+func (c *InboxConfig) Retention() time.Duration {
+	return c.retention
+}
+
+func (c *InboxConfig) CleanupInterval() time.Duration {
+	return c.cleanupInterval
+}
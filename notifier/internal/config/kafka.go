@@ -6,15 +6,17 @@ import (
 )
 
 const (
-	kafkaBrokersEnvName     = "KAFKA_BROKERS"
-	orderEventsTopicEnvName = "ORDER_EVENTS_TOPIC"
-	groupIDEnvName          = "GROUP_ID"
+	kafkaBrokersEnvName          = "KAFKA_BROKERS"
+	orderEventsTopicEnvName      = "ORDER_EVENTS_TOPIC"
+	stockLevelEventsTopicEnvName = "STOCK_LEVEL_EVENTS_TOPIC"
+	groupIDEnvName               = "GROUP_ID"
 )
 
 type KafkaConfig struct {
-	brokers          []string
-	orderEventsTopic string
-	groupID          string
+	brokers               []string
+	orderEventsTopic      string
+	stockLevelEventsTopic string
+	groupID               string
 }
 
 func NewKafkaConfig() (*KafkaConfig, error) {
@@ -28,15 +30,21 @@ func NewKafkaConfig() (*KafkaConfig, error) {
 		return nil, errors.New("order events topic not found")
 	}
 
+	stockLevelEventsTopic := os.Getenv(stockLevelEventsTopicEnvName)
+	if len(stockLevelEventsTopic) == 0 {
+		return nil, errors.New("stock level events topic not found")
+	}
+
 	groupID := os.Getenv(groupIDEnvName)
 	if len(groupID) == 0 {
 		return nil, errors.New("group id not found")
 	}
 
 	return &KafkaConfig{
-		brokers:          []string{brokers},
-		orderEventsTopic: orderEventsTopic,
-		groupID:          groupID,
+		brokers:               []string{brokers},
+		orderEventsTopic:      orderEventsTopic,
+		stockLevelEventsTopic: stockLevelEventsTopic,
+		groupID:               groupID,
 	}, nil
 }
 
@@ -48,6 +56,10 @@ func (c *KafkaConfig) GetOrderEventsTopic() string {
 	return c.orderEventsTopic
 }
 
+func (c *KafkaConfig) GetStockLevelEventsTopic() string {
+	return c.stockLevelEventsTopic
+}
+
 func (c *KafkaConfig) GroupID() string {
 	return c.groupID
 }
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+const (
+	notificationRoutesEnvName = "NOTIFICATION_ROUTES_PATH"
+	notificationFileEnvName   = "NOTIFICATION_FILE_PATH"
+
+	// StdoutPath makes the file channel write to the standard output.
+	StdoutPath = "stdout"
+)
+
+type NotificationConfig struct {
+	routesPath string
+	filePath   string
+}
+
+func NewNotificationConfig() (*NotificationConfig, error) {
+	routesPath := os.Getenv(notificationRoutesEnvName)
+	if len(routesPath) == 0 {
+		return nil, errors.New("notification routes path not found")
+	}
+
+	filePath := os.Getenv(notificationFileEnvName)
+	if len(filePath) == 0 {
+		filePath = StdoutPath
+	}
+
+	return &NotificationConfig{
+		routesPath: routesPath,
+		filePath:   filePath,
+	}, nil
+}
+
+func (c *NotificationConfig) RoutesPath() string {
+	return c.routesPath
+}
+
+func (c *NotificationConfig) FilePath() string {
+	return c.filePath
+}
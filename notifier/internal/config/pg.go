@@ -0,0 +1,29 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+const (
+	pgDSNEnvName = "PG_DSN"
+)
+
+type PGConfig struct {
+	dsn string
+}
+
+func NewPGConfig() (*PGConfig, error) {
+	dsn := os.Getenv(pgDSNEnvName)
+	if dsn == "" {
+		return nil, errors.New("pg dsn is not set")
+	}
+
+	return &PGConfig{
+		dsn: dsn,
+	}, nil
+}
+
+func (c *PGConfig) DSN() string {
+	return c.dsn
+}
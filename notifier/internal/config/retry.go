@@ -0,0 +1,109 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	handleAttemptsEnvName   = "KAFKA_HANDLE_ATTEMPTS"
+	handleBackoffEnvName    = "KAFKA_HANDLE_BACKOFF"
+	handleMaxBackoffEnvName = "KAFKA_HANDLE_MAX_BACKOFF"
+	retryDelaysEnvName      = "KAFKA_RETRY_DELAYS"
+	dlqEnabledEnvName       = "KAFKA_DLQ_ENABLED"
+
+	defaultHandleAttempts   = 3
+	defaultHandleBackoff    = 100 * time.Millisecond
+	defaultHandleMaxBackoff = 2 * time.Second
+)
+
+type RetryConfig struct {
+	attempts    int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	retryDelays []time.Duration
+	dlqEnabled  bool
+}
+
+func NewRetryConfig() (*RetryConfig, error) {
+	attempts, err := positiveIntEnv(handleAttemptsEnvName, defaultHandleAttempts)
+	if err != nil {
+		return nil, errors.New("handle attempts is invalid")
+	}
+
+	backoff, err := durationEnv(handleBackoffEnvName, defaultHandleBackoff)
+	if err != nil {
+		return nil, errors.New("handle backoff is invalid")
+	}
+
+	maxBackoff, err := durationEnv(handleMaxBackoffEnvName, defaultHandleMaxBackoff)
+	if err != nil {
+		return nil, errors.New("handle max backoff is invalid")
+	}
+
+	var retryDelays []time.Duration
+	if raw := os.Getenv(retryDelaysEnvName); len(raw) != 0 {
+		for _, item := range strings.Split(raw, ",") {
+			delay, err := time.ParseDuration(strings.TrimSpace(item))
+			if err != nil || delay < 0 {
+				return nil, errors.New("retry delays are invalid")
+			}
+			retryDelays = append(retryDelays, delay)
+		}
+	}
+
+	dlqEnabled := false
+	if raw := os.Getenv(dlqEnabledEnvName); len(raw) != 0 {
+		dlqEnabled, err = strconv.ParseBool(raw)
+		if err != nil {
+			return nil, errors.New("dlq enabled is invalid")
+		}
+	}
+
+	return &RetryConfig{
+		attempts:    attempts,
+		backoff:     backoff,
+		maxBackoff:  maxBackoff,
+		retryDelays: retryDelays,
+		dlqEnabled:  dlqEnabled,
+	}, nil
+}
+
+func durationEnv(name string, fallback time.Duration) (time.Duration, error) {
+	raw := os.Getenv(name)
+	if len(raw) == 0 {
+		return fallback, nil
+	}
+
+	parsed, err := time.ParseDuration(raw)
+	if err != nil || parsed < 0 {
+		return 0, errors.New("invalid duration")
+	}
+
+	return parsed, nil
+}
+
+// Attempts is how many times a message is handled in place before it moves to a retry topic.
+func (c *RetryConfig) Attempts() int {
+	return c.attempts
+}
+
+func (c *RetryConfig) Backoff() time.Duration {
+	return c.backoff
+}
+
+func (c *RetryConfig) MaxBackoff() time.Duration {
+	return c.maxBackoff
+}
+
+// RetryDelays are the delays of the retry topics, one topic per delay.
+func (c *RetryConfig) RetryDelays() []time.Duration {
+	return c.retryDelays
+}
+
+func (c *RetryConfig) DLQEnabled() bool {
+	return c.dlqEnabled
+}
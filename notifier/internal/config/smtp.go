@@ -0,0 +1,90 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	smtpAddrEnvName     = "SMTP_ADDR"
+	smtpFromEnvName     = "SMTP_FROM"
+	smtpToEnvName       = "SMTP_TO"
+	smtpUsernameEnvName = "SMTP_USERNAME"
+	smtpPasswordEnvName = "SMTP_PASSWORD"
+	smtpTimeoutEnvName  = "SMTP_TIMEOUT"
+
+	defaultSMTPTimeout = 10 * time.Second
+)
+
+type SMTPConfig struct {
+	addr     string
+	from     string
+	to       []string
+	username string
+	password string
+	timeout  time.Duration
+}
+
+func NewSMTPConfig() (*SMTPConfig, error) {
+	addr := os.Getenv(smtpAddrEnvName)
+	if len(addr) == 0 {
+		return nil, errors.New("smtp addr not found")
+	}
+
+	from := os.Getenv(smtpFromEnvName)
+	if len(from) == 0 {
+		return nil, errors.New("smtp from not found")
+	}
+
+	var to []string
+	if raw := os.Getenv(smtpToEnvName); len(raw) != 0 {
+		to = strings.Split(raw, ",")
+	}
+
+	timeout := defaultSMTPTimeout
+	if raw := os.Getenv(smtpTimeoutEnvName); len(raw) != 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.New("smtp timeout is invalid")
+		}
+		timeout = parsed
+	}
+
+	return &SMTPConfig{
+		addr:     addr,
+		from:     from,
+		to:       to,
+		username: os.Getenv(smtpUsernameEnvName),
+		password: os.Getenv(smtpPasswordEnvName),
+		timeout:  timeout,
+	}, nil
+}
+
+func (c *SMTPConfig) Addr() string {
+	return c.addr
+}
+
+func (c *SMTPConfig) From() string {
+	return c.from
+}
+
+// To is where mail without a user goes, such as stock notifications. It may be empty.
+func (c *SMTPConfig) To() []string {
+	return c.to
+}
+
+// Username is empty when the server accepts mail without authentication.
+func (c *SMTPConfig) Username() string {
+	return c.username
+}
+
+func (c *SMTPConfig) Password() string {
+	return c.password
+}
+
+// Timeout bounds a whole SMTP session, from dialing the server to QUIT.
+func (c *SMTPConfig) Timeout() time.Duration {
+	return c.timeout
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"time"
+)
+
+const (
+	webhookURLEnvName     = "WEBHOOK_URL"
+	webhookSecretEnvName  = "WEBHOOK_SECRET"
+	webhookTimeoutEnvName = "WEBHOOK_TIMEOUT"
+
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+type WebhookConfig struct {
+	url     string
+	secret  string
+	timeout time.Duration
+}
+
+func NewWebhookConfig() (*WebhookConfig, error) {
+	secret := os.Getenv(webhookSecretEnvName)
+	if len(secret) == 0 {
+		return nil, errors.New("webhook secret not found")
+	}
+
+	timeout := defaultWebhookTimeout
+	if raw := os.Getenv(webhookTimeoutEnvName); len(raw) != 0 {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, errors.New("webhook timeout is invalid")
+		}
+		timeout = parsed
+	}
+
+	return &WebhookConfig{
+		url:     os.Getenv(webhookURLEnvName),
+		secret:  secret,
+		timeout: timeout,
+	}, nil
+}
+
+// URL is where messages without a user go, such as stock notifications. It may be empty.
+func (c *WebhookConfig) URL() string {
+	return c.url
+}
+
+func (c *WebhookConfig) Secret() string {
+	return c.secret
+}
+
+func (c *WebhookConfig) Timeout() time.Duration {
+	return c.timeout
+}
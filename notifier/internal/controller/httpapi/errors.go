@@ -0,0 +1,19 @@
+package httpapi
+
+type Error struct {
+	Message string `json:"message"`
+}
+
+func (e Error) Error() string {
+	return e.Message
+}
+
+func NewError(message string) Error {
+	return Error{
+		Message: message,
+	}
+}
+
+var (
+	ErrValidation = NewError("validation error")
+)
@@ -0,0 +1,82 @@
+package contact
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+)
+
+type Service interface {
+	GetContact(ctx context.Context, userID int64) (contactmodels.Contact, error)
+	SaveContact(ctx context.Context, contact contactmodels.Contact) error
+	GetPreferences(ctx context.Context, userID int64) ([]contactmodels.Preference, error)
+	SetPreferences(ctx context.Context, userID int64, preferences []contactmodels.Preference) error
+}
+
+type HttpApi struct {
+	contactService Service
+}
+
+func NewContactHttpApi(
+	contactService Service,
+) *HttpApi {
+	return &HttpApi{
+		contactService: contactService,
+	}
+}
+
+func parseUserID(request *http.Request) (int64, error) {
+	userID, err := strconv.ParseInt(request.PathValue("user_id"), 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, httpapi.ErrValidation
+	}
+
+	return userID, nil
+}
+
+func decodeBody(request *http.Request, v any) error {
+	defer func(Body io.ReadCloser) {
+		errBodyClose := Body.Close()
+		if errBodyClose != nil {
+			slog.LogAttrs(
+				context.Background(),
+				slog.LevelError,
+				"error with Body.Close()",
+				slog.String("method", "HttpApi.decodeBody"),
+				slog.String("error", errBodyClose.Error()),
+			)
+		}
+	}(request.Body)
+
+	buf, err := io.ReadAll(request.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(buf, v)
+	if err != nil {
+		return httpapi.ErrValidation
+	}
+
+	return nil
+}
+
+func writeResponse(writer http.ResponseWriter, response any) error {
+	responseBuf, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(responseBuf)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package contact
+
+import (
+	"net/http"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/handlers/contact/responses"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (h *HttpApi) GetContact(writer http.ResponseWriter, request *http.Request) (err error) {
+	tr := otel.Tracer("httpApi")
+	ctx, span := tr.Start(request.Context(), "GetContact")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, err := parseUserID(request)
+	if err != nil {
+		return err
+	}
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	contact, err := h.contactService.GetContact(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return writeResponse(writer, responses.Contact{
+		UserID:     contact.UserID,
+		Email:      contact.Email,
+		WebhookURL: contact.WebhookURL,
+	})
+}
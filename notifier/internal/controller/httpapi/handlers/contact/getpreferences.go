@@ -0,0 +1,47 @@
+package contact
+
+import (
+	"net/http"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/handlers/contact/responses"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (h *HttpApi) GetPreferences(writer http.ResponseWriter, request *http.Request) (err error) {
+	tr := otel.Tracer("httpApi")
+	ctx, span := tr.Start(request.Context(), "GetPreferences")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, err := parseUserID(request)
+	if err != nil {
+		return err
+	}
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	preferences, err := h.contactService.GetPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	response := responses.Preferences{
+		UserID:      userID,
+		Preferences: make([]responses.Preference, 0, len(preferences)),
+	}
+
+	for _, p := range preferences {
+		response.Preferences = append(response.Preferences, responses.Preference{
+			Status:  p.Status.String(),
+			Channel: p.Channel,
+			Enabled: p.Enabled,
+		})
+	}
+
+	return writeResponse(writer, response)
+}
@@ -0,0 +1,6 @@
+package requests
+
+type SaveContact struct {
+	Email      string `json:"email"`
+	WebhookURL string `json:"webhook_url"`
+}
@@ -0,0 +1,11 @@
+package requests
+
+type Preference struct {
+	Status  string `json:"status"`
+	Channel string `json:"channel"`
+	Enabled bool   `json:"enabled"`
+}
+
+type SetPreferences struct {
+	Preferences []Preference `json:"preferences"`
+}
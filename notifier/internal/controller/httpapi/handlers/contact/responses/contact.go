@@ -0,0 +1,7 @@
+package responses
+
+type Contact struct {
+	UserID     int64  `json:"user_id"`
+	Email      string `json:"email"`
+	WebhookURL string `json:"webhook_url"`
+}
@@ -0,0 +1,12 @@
+package responses
+
+type Preference struct {
+	Status  string `json:"status"`
+	Channel string `json:"channel"`
+	Enabled bool   `json:"enabled"`
+}
+
+type Preferences struct {
+	UserID      int64        `json:"user_id"`
+	Preferences []Preference `json:"preferences"`
+}
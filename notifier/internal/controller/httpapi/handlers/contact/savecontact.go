@@ -0,0 +1,52 @@
+package contact
+
+import (
+	"net/http"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/handlers/contact/requests"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/handlers/contact/responses"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (h *HttpApi) SaveContact(writer http.ResponseWriter, request *http.Request) (err error) {
+	tr := otel.Tracer("httpApi")
+	ctx, span := tr.Start(request.Context(), "SaveContact")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, err := parseUserID(request)
+	if err != nil {
+		return err
+	}
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	var req requests.SaveContact
+	err = decodeBody(request, &req)
+	if err != nil {
+		return err
+	}
+
+	contact := contactmodels.Contact{
+		UserID:     userID,
+		Email:      req.Email,
+		WebhookURL: req.WebhookURL,
+	}
+
+	err = h.contactService.SaveContact(ctx, contact)
+	if err != nil {
+		return err
+	}
+
+	return writeResponse(writer, responses.Contact{
+		UserID:     contact.UserID,
+		Email:      contact.Email,
+		WebhookURL: contact.WebhookURL,
+	})
+}
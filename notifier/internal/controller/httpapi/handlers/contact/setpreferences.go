@@ -0,0 +1,55 @@
+package contact
+
+import (
+	"net/http"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi"
+	"github.com/BruteMors/marketplace-service/notifier/internal/controller/httpapi/handlers/contact/requests"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetPreferences stores the given preferences and responds with all preferences of the user.
+func (h *HttpApi) SetPreferences(writer http.ResponseWriter, request *http.Request) (err error) {
+	tr := otel.Tracer("httpApi")
+	ctx, span := tr.Start(request.Context(), "SetPreferences")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	userID, err := parseUserID(request)
+	if err != nil {
+		return err
+	}
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	var req requests.SetPreferences
+	err = decodeBody(request, &req)
+	if err != nil {
+		return err
+	}
+
+	if len(req.Preferences) == 0 {
+		return httpapi.ErrValidation
+	}
+
+	preferences := make([]contactmodels.Preference, 0, len(req.Preferences))
+	for _, p := range req.Preferences {
+		preferences = append(preferences, contactmodels.Preference{
+			Status:  order.Status(p.Status),
+			Channel: p.Channel,
+			Enabled: p.Enabled,
+		})
+	}
+
+	err = h.contactService.SetPreferences(ctx, userID, preferences)
+	if err != nil {
+		return err
+	}
+
+	return h.GetPreferences(writer, request.WithContext(ctx))
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/BruteMors/marketplace-service/libs/kafka"
 	"github.com/BruteMors/marketplace-service/libs/kafka/consumergroup"
 	"github.com/BruteMors/marketplace-service/libs/tracing"
-	tracingCarrier "github.com/BruteMors/marketplace-service/notifier/internal/controller/kafka/tracing"
 	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -15,9 +15,7 @@ import (
 func (k *KafkaHandler) Handle(msg consumergroup.Msg) (err error) {
 	tr := otel.Tracer("OrderStatusKafkaHandler")
 
-	propagator := otel.GetTextMapPropagator()
-	carrier := tracingCarrier.MapCarrier(msg.Headers)
-	ctx := propagator.Extract(context.Background(), carrier)
+	ctx := kafka.ExtractTraceContext(context.Background(), msg.Headers)
 
 	ctx, span := tr.Start(ctx, "Handle")
 	defer func() {
@@ -5,6 +5,7 @@ import "time"
 type StatusChangedEvent struct {
 	ID      int64     `json:"id"`
 	OrderID int64     `json:"order_id"`
+	UserID  int64     `json:"user_id"`
 	Status  Status    `json:"status"`
 	At      time.Time `json:"at"`
 }
@@ -0,0 +1,23 @@
+package stocklevel
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/stock"
+)
+
+type Service interface {
+	ProcessStockNotification(ctx context.Context, notification stock.Notification) error
+}
+
+type KafkaHandler struct {
+	stockService Service
+}
+
+func NewKafkaHandler(
+	stockService Service,
+) *KafkaHandler {
+	return &KafkaHandler{
+		stockService: stockService,
+	}
+}
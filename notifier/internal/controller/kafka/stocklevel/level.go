@@ -0,0 +1,49 @@
+package stocklevel
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/BruteMors/marketplace-service/libs/kafka"
+	"github.com/BruteMors/marketplace-service/libs/kafka/consumergroup"
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (k *KafkaHandler) Handle(msg consumergroup.Msg) (err error) {
+	tr := otel.Tracer("StockLevelKafkaHandler")
+
+	ctx := kafka.ExtractTraceContext(context.Background(), msg.Headers)
+
+	ctx, span := tr.Start(ctx, "Handle")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	var event stock.LevelChangedEvent
+	err = json.Unmarshal(msg.Payload, &event)
+	if err != nil {
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("sku", int64(event.SKU)),
+		attribute.Int64("available_before", event.AvailableBefore),
+		attribute.Int64("available_after", event.AvailableAfter),
+	)
+
+	notification, ok := event.Notification()
+	if !ok {
+		return nil
+	}
+
+	err = k.stockService.ProcessStockNotification(ctx, notification)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
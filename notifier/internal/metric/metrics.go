@@ -0,0 +1,104 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespaceEnvName = "NAMESPACE"
+	appNameEnvName   = "APP_NAME"
+)
+
+type Metrics struct {
+	deliveryCounter           *prometheus.CounterVec
+	deliveryHistogramDuration *prometheus.HistogramVec
+	inboxDuplicateCounter     *prometheus.CounterVec
+	commitLagGauge            *prometheus.GaugeVec
+}
+
+var metrics *Metrics
+
+func Init(_ context.Context) error {
+	namespace := os.Getenv(namespaceEnvName)
+	if namespace == "" {
+		return errors.New("namespace is not set")
+	}
+
+	appName := os.Getenv(appNameEnvName)
+	if appName == "" {
+		return errors.New("app name is not set")
+	}
+
+	metrics = &Metrics{
+		deliveryCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "notification",
+				Name:      appName + "_deliveries_total",
+				Help:      "Количество доставок уведомлений по каналам",
+			},
+			[]string{"channel", "status"},
+		),
+		deliveryHistogramDuration: promauto.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "notification",
+				Name:      appName + "_delivery_time_seconds",
+				Help:      "Время доставки уведомления через канал",
+				Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+			},
+			[]string{"channel", "status"},
+		),
+		inboxDuplicateCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "inbox",
+				Name:      appName + "_duplicates_total",
+				Help:      "Количество пропущенных повторно доставленных событий",
+			},
+			[]string{"source"},
+		),
+		commitLagGauge: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "kafka",
+				Name:      appName + "_commit_lag",
+				Help:      "Количество полученных, но еще не закоммиченных сообщений партиции",
+			},
+			[]string{"topic", "partition"},
+		),
+	}
+
+	return nil
+}
+
+func IncDeliveryCounter(channel, status string) {
+	metrics.deliveryCounter.WithLabelValues(channel, status).Inc()
+}
+
+func ObserveDeliveryTime(channel, status string, time float64) {
+	metrics.deliveryHistogramDuration.WithLabelValues(channel, status).Observe(time)
+}
+
+func RecordDeliveryMetric(channel string, err error, duration float64) {
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	IncDeliveryCounter(channel, status)
+	ObserveDeliveryTime(channel, status, duration)
+}
+
+func IncInboxDuplicateCounter(source string) {
+	metrics.inboxDuplicateCounter.WithLabelValues(source).Inc()
+}
+
+func SetCommitLag(topic string, partition int32, lag int64) {
+	metrics.commitLagGauge.WithLabelValues(topic, strconv.FormatInt(int64(partition), 10)).Set(float64(lag))
+}
@@ -0,0 +1,66 @@
+package contact
+
+import (
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
+)
+
+type Contact struct {
+	UserID     int64
+	Email      string
+	WebhookURL string
+}
+
+// Address returns where the channel delivers to the user. It is false when the
+// channel needs an address the user has not given. The file channel needs none.
+func (c Contact) Address(channel string) (string, bool) {
+	switch channel {
+	case notification.ChannelEmail:
+		return c.Email, c.Email != ""
+	case notification.ChannelWebhook:
+		return c.WebhookURL, c.WebhookURL != ""
+	default:
+		return "", true
+	}
+}
+
+type Preference struct {
+	Status  order.Status
+	Channel string
+	Enabled bool
+}
+
+// Preferences are the explicit opt-ins and opt-outs of a user by status and channel.
+type Preferences map[order.Status]map[string]bool
+
+// Allows reports whether the user wants the status through the channel.
+// Everything the user has not opted out of is allowed.
+func (p Preferences) Allows(status order.Status, channel string) bool {
+	enabled, ok := p[status][channel]
+	if !ok {
+		return true
+	}
+
+	return enabled
+}
+
+// List returns the preferences sorted by status and channel.
+func (p Preferences) List() []Preference {
+	list := make([]Preference, 0)
+	for _, status := range order.Statuses {
+		for _, channel := range notification.Channels {
+			enabled, ok := p[status][channel]
+			if !ok {
+				continue
+			}
+
+			list = append(list, Preference{
+				Status:  status,
+				Channel: channel,
+				Enabled: enabled,
+			})
+		}
+	}
+
+	return list
+}
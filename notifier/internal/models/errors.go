@@ -0,0 +1,21 @@
+package models
+
+type Error struct {
+	Message string
+}
+
+func (e Error) Error() string {
+	return e.Message
+}
+
+func NewError(message string) Error {
+	return Error{
+		Message: message,
+	}
+}
+
+var (
+	ErrContactNotFound   = NewError("contact not found")
+	ErrInvalidContact    = NewError("invalid contact")
+	ErrInvalidPreference = NewError("invalid preference")
+)
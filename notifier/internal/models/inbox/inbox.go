@@ -0,0 +1,13 @@
+package inbox
+
+// Sources tell apart events of different kinds, the inbox keeps their ids apart.
+const (
+	SourceOrderStatus = "order_status"
+	SourceStockLevel  = "stock_level"
+)
+
+// Key identifies an outbox event.
+type Key struct {
+	Source  string
+	EventID int64
+}
@@ -0,0 +1,29 @@
+package notification
+
+const (
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+	ChannelFile    = "file"
+)
+
+// Channels lists every channel a notification can be delivered through.
+var Channels = []string{ChannelEmail, ChannelWebhook, ChannelFile}
+
+// Message is a rendered notification ready to be delivered through a channel.
+type Message struct {
+	Subject string
+	Body    string
+}
+
+// Route is where and what to deliver for a single event.
+type Route struct {
+	Channels []string
+	Message  Message
+}
+
+// Recipient is who a message is delivered to. A recipient without a user
+// is the operator, channels send it to their configured default address.
+type Recipient struct {
+	UserID  int64
+	Address string
+}
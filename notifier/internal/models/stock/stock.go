@@ -0,0 +1,51 @@
+package stock
+
+import "time"
+
+type LevelChangedEvent struct {
+	ID              int64     `json:"id"`
+	SKU             uint32    `json:"sku"`
+	AvailableBefore int64     `json:"available_before"`
+	AvailableAfter  int64     `json:"available_after"`
+	At              time.Time `json:"at"`
+}
+
+// Notification returns what to tell about the SKU when its availability crossed zero.
+// Changes that keep the SKU on the same side of zero are not notified.
+func (e LevelChangedEvent) Notification() (Notification, bool) {
+	var availability Availability
+
+	switch {
+	case e.AvailableBefore > 0 && e.AvailableAfter <= 0:
+		availability = AvailabilityOutOfStock
+	case e.AvailableBefore <= 0 && e.AvailableAfter > 0:
+		availability = AvailabilityBackInStock
+	default:
+		return Notification{}, false
+	}
+
+	return Notification{
+		EventID:      e.ID,
+		SKU:          e.SKU,
+		Availability: availability,
+		At:           e.At,
+	}, true
+}
+
+type Notification struct {
+	EventID      int64
+	SKU          uint32
+	Availability Availability
+	At           time.Time
+}
+
+type Availability string
+
+const (
+	AvailabilityOutOfStock  Availability = "out of stock"
+	AvailabilityBackInStock Availability = "back in stock"
+)
+
+func (a Availability) String() string {
+	return string(a)
+}
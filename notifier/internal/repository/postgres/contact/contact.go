@@ -0,0 +1,17 @@
+package contact
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Repository keeps contacts and preferences in postgres, so every notifier instance
+// delivers by the same registry.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
@@ -0,0 +1,27 @@
+package contact
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/contact/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetContact(ctx context.Context, userID int64) (contactmodels.Contact, error) {
+	row, err := sqlc.New(r.db).GetContact(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return contactmodels.Contact{}, models.ErrContactNotFound
+		}
+		return contactmodels.Contact{}, err
+	}
+
+	return contactmodels.Contact{
+		UserID:     userID,
+		Email:      row.Email,
+		WebhookURL: row.WebhookUrl,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package contact
+
+import (
+	"context"
+
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/contact/sqlc"
+)
+
+// GetPreferences returns the user preferences, empty when the user has none.
+func (r *Repository) GetPreferences(ctx context.Context, userID int64) (contactmodels.Preferences, error) {
+	rows, err := sqlc.New(r.db).GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := make(contactmodels.Preferences)
+	for _, row := range rows {
+		status := order.Status(row.Status)
+		if preferences[status] == nil {
+			preferences[status] = make(map[string]bool)
+		}
+		preferences[status][row.Channel] = row.Enabled
+	}
+
+	return preferences, nil
+}
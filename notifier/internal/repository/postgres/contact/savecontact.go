@@ -0,0 +1,16 @@
+package contact
+
+import (
+	"context"
+
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/contact/sqlc"
+)
+
+func (r *Repository) SaveContact(ctx context.Context, contact contactmodels.Contact) error {
+	return sqlc.New(r.db).SaveContact(ctx, sqlc.SaveContactParams{
+		UserID:     contact.UserID,
+		Email:      contact.Email,
+		WebhookUrl: contact.WebhookURL,
+	})
+}
@@ -0,0 +1,35 @@
+package contact
+
+import (
+	"context"
+
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/contact/sqlc"
+)
+
+// SetPreferences stores the given preferences over the existing ones in one transaction,
+// the rest stay as they were.
+func (r *Repository) SetPreferences(ctx context.Context, userID int64, preferences []contactmodels.Preference) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	queries := sqlc.New(tx)
+	for _, p := range preferences {
+		err = queries.SetPreference(ctx, sqlc.SetPreferenceParams{
+			UserID:  userID,
+			Status:  string(p.Status),
+			Channel: p.Channel,
+			Enabled: p.Enabled,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
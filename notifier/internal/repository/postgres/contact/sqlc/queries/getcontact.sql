@@ -0,0 +1,4 @@
+-- name: GetContact :one
+SELECT email, webhook_url
+FROM "contacts"
+WHERE user_id = $1;
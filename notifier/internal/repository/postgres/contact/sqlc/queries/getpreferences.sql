@@ -0,0 +1,4 @@
+-- name: GetPreferences :many
+SELECT status, channel, enabled
+FROM "contact_preferences"
+WHERE user_id = $1;
@@ -0,0 +1,7 @@
+-- name: SaveContact :exec
+INSERT INTO "contacts" (user_id, email, webhook_url, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET email = EXCLUDED.email,
+    webhook_url = EXCLUDED.webhook_url,
+    updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,5 @@
+-- name: SetPreference :exec
+INSERT INTO "contact_preferences" (user_id, status, channel, enabled)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, status, channel) DO UPDATE
+SET enabled = EXCLUDED.enabled;
@@ -0,0 +1,29 @@
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/inbox/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DeleteProcessedBefore forgets events processed and deliveries made before the given time
+// and returns how many events were forgotten. A forgotten event is processed again
+// if it is ever redelivered.
+func (r *Repository) DeleteProcessedBefore(ctx context.Context, before time.Time) (int, error) {
+	queries := sqlc.New(r.db)
+	at := pgtype.Timestamp{Time: before.UTC(), Valid: true}
+
+	deleted, err := queries.DeleteEventsBefore(ctx, at)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = queries.DeleteDeliveriesBefore(ctx, at)
+	if err != nil {
+		return int(deleted), err
+	}
+
+	return int(deleted), nil
+}
@@ -0,0 +1,28 @@
+package inbox
+
+import (
+	"context"
+
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/inbox/sqlc"
+)
+
+// Delivered reports whether the event has been delivered through the channel.
+func (r *Repository) Delivered(ctx context.Context, key inboxmodels.Key, channel string) (bool, error) {
+	return sqlc.New(r.db).IsDelivered(ctx, sqlc.IsDeliveredParams{
+		Source:  key.Source,
+		EventID: key.EventID,
+		Channel: channel,
+	})
+}
+
+// MarkDelivered records the delivery of the event through the channel. It is written
+// outside the transaction of Process, so it is kept when another channel fails and
+// the event is processed again.
+func (r *Repository) MarkDelivered(ctx context.Context, key inboxmodels.Key, channel string) error {
+	return sqlc.New(r.db).InsertDelivery(ctx, sqlc.InsertDeliveryParams{
+		Source:  key.Source,
+		EventID: key.EventID,
+		Channel: channel,
+	})
+}
@@ -0,0 +1,17 @@
+package inbox
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Repository keeps processed events and their deliveries in postgres, so every notifier
+// instance of the consumer group sees the events the others have processed.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
@@ -0,0 +1,42 @@
+package inbox
+
+import (
+	"context"
+
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/inbox/sqlc"
+)
+
+// Process runs f for the event unless the inbox already has it. The event is inserted
+// in a transaction before f runs and committed only when f succeeds, so a failed f leaves
+// no record and a redelivered event is processed again. The inserted row is locked until
+// the transaction ends, so a concurrent call for the same event, on this or another
+// instance, waits and then skips the event if it was processed. It reports whether f has run.
+func (r *Repository) Process(ctx context.Context, key inboxmodels.Key, f func(context.Context) error) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	inserted, err := sqlc.New(tx).InsertEvent(ctx, sqlc.InsertEventParams{
+		Source:  key.Source,
+		EventID: key.EventID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if inserted == 0 {
+		return false, nil
+	}
+
+	err = f(ctx)
+	if err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit(ctx)
+}
@@ -0,0 +1,3 @@
+-- name: DeleteDeliveriesBefore :execrows
+DELETE FROM "inbox_deliveries"
+WHERE delivered_at < $1;
@@ -0,0 +1,3 @@
+-- name: DeleteEventsBefore :execrows
+DELETE FROM "inbox_events"
+WHERE processed_at < $1;
@@ -0,0 +1,4 @@
+-- name: InsertDelivery :exec
+INSERT INTO "inbox_deliveries" (source, event_id, channel)
+VALUES ($1, $2, $3)
+ON CONFLICT (source, event_id, channel) DO NOTHING;
@@ -0,0 +1,4 @@
+-- name: InsertEvent :execrows
+INSERT INTO "inbox_events" (source, event_id)
+VALUES ($1, $2)
+ON CONFLICT (source, event_id) DO NOTHING;
@@ -0,0 +1,8 @@
+-- name: IsDelivered :one
+SELECT EXISTS (
+    SELECT 1
+    FROM "inbox_deliveries"
+    WHERE source = $1
+      AND event_id = $2
+      AND channel = $3
+);
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "inbox_events" (
+                                            source TEXT NOT NULL,
+                                            event_id BIGINT NOT NULL,
+                                            processed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                            PRIMARY KEY (source, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS inbox_events_processed_at_idx ON "inbox_events" (processed_at);
+
+CREATE TABLE IF NOT EXISTS "inbox_deliveries" (
+                                                source TEXT NOT NULL,
+                                                event_id BIGINT NOT NULL,
+                                                channel TEXT NOT NULL,
+                                                delivered_at TIMESTAMP NOT NULL DEFAULT NOW(),
+                                                PRIMARY KEY (source, event_id, channel)
+);
+
+CREATE INDEX IF NOT EXISTS inbox_deliveries_delivered_at_idx ON "inbox_deliveries" (delivered_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "inbox_deliveries";
+DROP TABLE IF EXISTS "inbox_events";
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "contacts" (
+                                        user_id BIGINT PRIMARY KEY,
+                                        email TEXT NOT NULL DEFAULT '',
+                                        webhook_url TEXT NOT NULL DEFAULT '',
+                                        updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- a user may opt out of a channel before giving a contact, so preferences do not reference contacts
+CREATE TABLE IF NOT EXISTS "contact_preferences" (
+                                                   user_id BIGINT NOT NULL,
+                                                   status TEXT NOT NULL,
+                                                   channel TEXT NOT NULL,
+                                                   enabled BOOLEAN NOT NULL,
+                                                   PRIMARY KEY (user_id, status, channel)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "contact_preferences";
+DROP TABLE IF EXISTS "contacts";
+-- +goose StatementEnd
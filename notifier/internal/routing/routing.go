@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/template"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+)
+
+type routeConfig struct {
+	Channels []string `json:"channels"`
+	Subject  string   `json:"subject"`
+	Body     string   `json:"body"`
+}
+
+type fileConfig struct {
+	Routes map[string]routeConfig `json:"routes"`
+}
+
+type route struct {
+	channels []string
+	subject  *template.Template
+	body     *template.Template
+}
+
+// Rules maps an event, an order status or a stock availability, to channels and message templates.
+type Rules struct {
+	routes map[string]route
+}
+
+// Load reads the rules from a JSON file and parses every template up front,
+// so a broken template stops the service at startup instead of on the first event.
+func Load(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg fileConfig
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("parse routes %s: %w", path, err)
+	}
+
+	rules := &Rules{
+		routes: make(map[string]route, len(cfg.Routes)),
+	}
+
+	for event, rc := range cfg.Routes {
+		subject, err := template.New(event + " subject").Option("missingkey=error").Parse(rc.Subject)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := template.New(event + " body").Option("missingkey=error").Parse(rc.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		rules.routes[event] = route{
+			channels: rc.Channels,
+			subject:  subject,
+			body:     body,
+		}
+	}
+
+	return rules, nil
+}
+
+// Route renders the message for the event. An event without a rule gets a route with no channels.
+func (r *Rules) Route(event string, data any) (notification.Route, error) {
+	rt, ok := r.routes[event]
+	if !ok || len(rt.channels) == 0 {
+		return notification.Route{}, nil
+	}
+
+	var subject, body bytes.Buffer
+
+	err := rt.subject.Execute(&subject, data)
+	if err != nil {
+		return notification.Route{}, err
+	}
+
+	err = rt.body.Execute(&body, data)
+	if err != nil {
+		return notification.Route{}, err
+	}
+
+	return notification.Route{
+		Channels: rt.channels,
+		Message: notification.Message{
+			Subject: subject.String(),
+			Body:    body.String(),
+		},
+	}, nil
+}
+
+// Channels returns the names of all channels the rules deliver to.
+func (r *Rules) Channels() []string {
+	seen := make(map[string]struct{})
+	for _, rt := range r.routes {
+		for _, name := range rt.channels {
+			seen[name] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
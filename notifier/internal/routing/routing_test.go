@@ -0,0 +1,179 @@
+package routing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRules(t *testing.T, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "routes.json")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	return path
+}
+
+type orderData struct {
+	OrderID int64
+	At      time.Time
+}
+
+func TestRulesRoute(t *testing.T) {
+	rules, err := Load(writeRules(t, `{
+		"routes": {
+			"payed": {
+				"channels": ["file", "email"],
+				"subject": "Order {{.OrderID}} is paid",
+				"body": "Order {{.OrderID}} was paid at {{.At.Format \"2006-01-02\"}}."
+			},
+			"new": {
+				"channels": [],
+				"subject": "Order {{.OrderID}} is created",
+				"body": ""
+			},
+			"failed": {
+				"channels": ["webhook"],
+				"subject": "Order {{.Missing}} has failed",
+				"body": ""
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	data := orderData{OrderID: 10, At: time.Date(2024, 10, 8, 12, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name     string
+		event    string
+		data     any
+		expected notification.Route
+		err      bool
+	}{
+		{
+			name:  "rendered",
+			event: "payed",
+			data:  data,
+			expected: notification.Route{
+				Channels: []string{"file", "email"},
+				Message: notification.Message{
+					Subject: "Order 10 is paid",
+					Body:    "Order 10 was paid at 2024-10-08.",
+				},
+			},
+		},
+		{
+			name:     "no channels",
+			event:    "new",
+			data:     data,
+			expected: notification.Route{},
+		},
+		{
+			name:     "no rule",
+			event:    "cancelled",
+			data:     data,
+			expected: notification.Route{},
+		},
+		{
+			name:  "missing field",
+			event: "failed",
+			data:  data,
+			err:   true,
+		},
+		{
+			name:  "missing map key",
+			event: "payed",
+			data:  map[string]any{"At": data.At},
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, err := rules.Route(tt.event, tt.data)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, route)
+		})
+	}
+}
+
+func TestRulesChannels(t *testing.T) {
+	rules, err := Load(writeRules(t, `{
+		"routes": {
+			"payed": {"channels": ["file", "email"]},
+			"failed": {"channels": ["webhook", "email"]},
+			"new": {"channels": []}
+		}
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"email", "file", "webhook"}, rules.Channels())
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "invalid json",
+			data: `{"routes": [}`,
+		},
+		{
+			name: "broken subject",
+			data: `{"routes": {"payed": {"channels": ["file"], "subject": "{{.OrderID"}}}`,
+		},
+		{
+			name: "broken body",
+			data: `{"routes": {"payed": {"channels": ["file"], "body": "{{end}}"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeRules(t, tt.data))
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// The shipped rules render every event of both sources.
+func TestLoadShippedRules(t *testing.T) {
+	rules, err := Load("../../routes.json")
+	require.NoError(t, err)
+
+	at := time.Date(2024, 10, 8, 12, 0, 0, 0, time.UTC)
+	order := orderData{OrderID: 10, At: at}
+	stock := struct {
+		SKU uint32
+		At  time.Time
+	}{SKU: 1076963, At: at}
+
+	for _, event := range []string{"new", "awaiting payment", "failed", "payed", "cancelled"} {
+		route, err := rules.Route(event, order)
+		require.NoError(t, err, event)
+		assert.NotEmpty(t, route.Channels, event)
+		assert.Contains(t, route.Message.Subject, "10", event)
+	}
+
+	for _, event := range []string{"out of stock", "back in stock"} {
+		route, err := rules.Route(event, stock)
+		require.NoError(t, err, event)
+		assert.NotEmpty(t, route.Channels, event)
+		assert.Contains(t, route.Message.Body, "2024-10-08 12:00:00", event)
+	}
+}
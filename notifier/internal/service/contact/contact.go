@@ -0,0 +1,24 @@
+package contact
+
+import (
+	"context"
+
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+)
+
+type Repository interface {
+	GetContact(ctx context.Context, userID int64) (contactmodels.Contact, error)
+	SaveContact(ctx context.Context, contact contactmodels.Contact) error
+	GetPreferences(ctx context.Context, userID int64) (contactmodels.Preferences, error)
+	SetPreferences(ctx context.Context, userID int64, preferences []contactmodels.Preference) error
+}
+
+type Service struct {
+	contactRepository Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{
+		contactRepository: repo,
+	}
+}
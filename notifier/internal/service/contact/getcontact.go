@@ -0,0 +1,23 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *Service) GetContact(ctx context.Context, userID int64) (contact contactmodels.Contact, err error) {
+	tr := otel.Tracer("contactService")
+	ctx, span := tr.Start(ctx, "GetContact")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	return s.contactRepository.GetContact(ctx, userID)
+}
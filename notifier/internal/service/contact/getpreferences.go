@@ -0,0 +1,28 @@
+package contact
+
+import (
+	"context"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *Service) GetPreferences(ctx context.Context, userID int64) (preferences []contactmodels.Preference, err error) {
+	tr := otel.Tracer("contactService")
+	ctx, span := tr.Start(ctx, "GetPreferences")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", userID))
+
+	stored, err := s.contactRepository.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return stored.List(), nil
+}
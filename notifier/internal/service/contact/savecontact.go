@@ -0,0 +1,51 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SaveContact replaces the user contact. An empty address turns its channel off for the user.
+func (s *Service) SaveContact(ctx context.Context, contact contactmodels.Contact) (err error) {
+	tr := otel.Tracer("contactService")
+	ctx, span := tr.Start(ctx, "SaveContact")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("userID", contact.UserID))
+
+	err = validateContact(contact)
+	if err != nil {
+		return err
+	}
+
+	return s.contactRepository.SaveContact(ctx, contact)
+}
+
+func validateContact(contact contactmodels.Contact) error {
+	if contact.Email != "" {
+		address, err := mail.ParseAddress(contact.Email)
+		if err != nil || address.Address != contact.Email {
+			return fmt.Errorf("%w: email %q", models.ErrInvalidContact, contact.Email)
+		}
+	}
+
+	if contact.WebhookURL != "" {
+		u, err := url.Parse(contact.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: webhook url %q", models.ErrInvalidContact, contact.WebhookURL)
+		}
+	}
+
+	return nil
+}
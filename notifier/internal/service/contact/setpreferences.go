@@ -0,0 +1,42 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetPreferences opts the user in or out of statuses per channel. Preferences
+// not mentioned keep their value, and a status never set is delivered.
+func (s *Service) SetPreferences(ctx context.Context, userID int64, preferences []contactmodels.Preference) (err error) {
+	tr := otel.Tracer("contactService")
+	ctx, span := tr.Start(ctx, "SetPreferences")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("userID", userID),
+		attribute.Int("preference_count", len(preferences)),
+	)
+
+	for _, p := range preferences {
+		if !slices.Contains(order.Statuses, p.Status) {
+			return fmt.Errorf("%w: unknown status %q", models.ErrInvalidPreference, p.Status)
+		}
+		if !slices.Contains(notification.Channels, p.Channel) {
+			return fmt.Errorf("%w: unknown channel %q", models.ErrInvalidPreference, p.Channel)
+		}
+	}
+
+	return s.contactRepository.SetPreferences(ctx, userID, preferences)
+}
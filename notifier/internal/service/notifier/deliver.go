@@ -0,0 +1,104 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/metric"
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// resolveFunc returns who receives the event through the channel, false skips the channel.
+type resolveFunc func(channel string) (notification.Recipient, bool)
+
+// operator sends messages without a user to the default address of every channel.
+func operator(string) (notification.Recipient, bool) {
+	return notification.Recipient{}, true
+}
+
+// deliver renders the message for the event and sends it to every routed channel
+// the resolver has a recipient for. A failed channel does not stop the others,
+// all failures are returned together. Every successful delivery is recorded in the inbox,
+// so when the event is processed again only the failed channels are retried.
+func (s *Service) deliver(ctx context.Context, key inboxmodels.Key, event string, data any, resolve resolveFunc) error {
+	route, err := s.router.Route(event, data)
+	if err != nil {
+		return err
+	}
+
+	if len(route.Channels) == 0 {
+		slog.DebugContext(ctx, "no route for event", "event", event)
+		return nil
+	}
+
+	var errs []error
+	for _, name := range route.Channels {
+		recipient, ok := resolve(name)
+		if !ok {
+			slog.DebugContext(ctx, "no recipient for channel", "event", event, "channel", name)
+			continue
+		}
+
+		delivered, err := s.inboxRepository.Delivered(ctx, key, name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		if delivered {
+			slog.InfoContext(ctx, "skipping channel the event was delivered to", "event", event, "channel", name)
+			continue
+		}
+
+		err = s.send(ctx, name, recipient, route.Message)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		err = s.inboxRepository.MarkDelivered(ctx, key, name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Service) send(
+	ctx context.Context,
+	name string,
+	recipient notification.Recipient,
+	message notification.Message,
+) (err error) {
+	tr := otel.Tracer("Service")
+	ctx, span := tr.Start(ctx, "Deliver")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("channel", name),
+		attribute.Int64("userID", recipient.UserID),
+	)
+
+	channel, ok := s.channels[name]
+	if !ok {
+		return errors.New("unknown channel")
+	}
+
+	start := time.Now()
+	err = channel.Send(ctx, recipient, message)
+	duration := time.Since(start).Seconds()
+	metric.RecordDeliveryMetric(name, err, duration)
+
+	return err
+}
@@ -0,0 +1,164 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/metric"
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	_ = os.Setenv("NAMESPACE", "test")
+	_ = os.Setenv("APP_NAME", "notifier")
+
+	if err := metric.Init(context.Background()); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+type fakeRouter struct {
+	route notification.Route
+}
+
+func (r fakeRouter) Route(string, any) (notification.Route, error) {
+	return r.route, nil
+}
+
+type fakeChannel struct {
+	name string
+	errs []error
+	sent int
+}
+
+func (c *fakeChannel) Name() string {
+	return c.name
+}
+
+// Send fails with the next error of errs, nil errors and calls after them succeed.
+func (c *fakeChannel) Send(context.Context, notification.Recipient, notification.Message) error {
+	call := c.sent
+	c.sent++
+
+	if call < len(c.errs) {
+		return c.errs[call]
+	}
+	return nil
+}
+
+// fakeInbox keeps deliveries in memory and runs f of Process without recording the event.
+type fakeInbox struct {
+	mu         sync.Mutex
+	deliveries map[inboxmodels.Key]map[string]bool
+}
+
+func newFakeInbox() *fakeInbox {
+	return &fakeInbox{deliveries: make(map[inboxmodels.Key]map[string]bool)}
+}
+
+func (i *fakeInbox) Process(ctx context.Context, _ inboxmodels.Key, f func(context.Context) error) (bool, error) {
+	return true, f(ctx)
+}
+
+func (i *fakeInbox) Delivered(_ context.Context, key inboxmodels.Key, channel string) (bool, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.deliveries[key][channel], nil
+}
+
+func (i *fakeInbox) MarkDelivered(_ context.Context, key inboxmodels.Key, channel string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.deliveries[key] == nil {
+		i.deliveries[key] = make(map[string]bool)
+	}
+	i.deliveries[key][channel] = true
+	return nil
+}
+
+func (i *fakeInbox) DeleteProcessedBefore(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestServiceDeliverRetriesOnlyFailedChannels(t *testing.T) {
+	emailChannel := &fakeChannel{name: notification.ChannelEmail, errs: []error{errors.New("smtp error")}}
+	webhookChannel := &fakeChannel{name: notification.ChannelWebhook}
+	inbox := newFakeInbox()
+
+	s := &Service{
+		router: fakeRouter{route: notification.Route{
+			Channels: []string{notification.ChannelEmail, notification.ChannelWebhook},
+		}},
+		channels: map[string]Channel{
+			emailChannel.name:   emailChannel,
+			webhookChannel.name: webhookChannel,
+		},
+		inboxRepository: inbox,
+	}
+
+	ctx := context.Background()
+	key := inboxmodels.Key{Source: inboxmodels.SourceStockLevel, EventID: 1}
+
+	err := s.deliver(ctx, key, "out_of_stock", nil, operator)
+	assert.ErrorContains(t, err, "email: smtp error")
+	assert.Equal(t, 1, emailChannel.sent)
+	assert.Equal(t, 1, webhookChannel.sent)
+
+	// the redelivered event goes only to the channel that failed
+	err = s.deliver(ctx, key, "out_of_stock", nil, operator)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, emailChannel.sent)
+	assert.Equal(t, 1, webhookChannel.sent)
+
+	err = s.deliver(ctx, key, "out_of_stock", nil, operator)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, emailChannel.sent)
+	assert.Equal(t, 1, webhookChannel.sent)
+
+	// deliveries of another event are kept apart
+	err = s.deliver(ctx, inboxmodels.Key{Source: inboxmodels.SourceOrderStatus, EventID: 1}, "out_of_stock", nil, operator)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, emailChannel.sent)
+	assert.Equal(t, 2, webhookChannel.sent)
+}
+
+func TestServiceDeliverSkipsChannelsWithoutRecipient(t *testing.T) {
+	emailChannel := &fakeChannel{name: notification.ChannelEmail}
+	webhookChannel := &fakeChannel{name: notification.ChannelWebhook}
+	inbox := newFakeInbox()
+
+	s := &Service{
+		router: fakeRouter{route: notification.Route{
+			Channels: []string{notification.ChannelEmail, notification.ChannelWebhook},
+		}},
+		channels: map[string]Channel{
+			emailChannel.name:   emailChannel,
+			webhookChannel.name: webhookChannel,
+		},
+		inboxRepository: inbox,
+	}
+
+	key := inboxmodels.Key{Source: inboxmodels.SourceOrderStatus, EventID: 7}
+	resolve := func(channel string) (notification.Recipient, bool) {
+		return notification.Recipient{UserID: 1, Address: "user@example.com"}, channel == notification.ChannelEmail
+	}
+
+	err := s.deliver(context.Background(), key, "payed", nil, resolve)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, emailChannel.sent)
+	assert.Equal(t, 0, webhookChannel.sent)
+
+	delivered, err := inbox.Delivered(context.Background(), key, notification.ChannelWebhook)
+	assert.NoError(t, err)
+	assert.False(t, delivered)
+}
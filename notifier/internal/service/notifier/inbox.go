@@ -0,0 +1,54 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/metric"
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+)
+
+// once runs f for the event only if it has not been processed yet. Loms may send an
+// outbox event more than once, a redelivered event is skipped.
+func (s *Service) once(ctx context.Context, key inboxmodels.Key, f func(context.Context) error) error {
+	processed, err := s.inboxRepository.Process(ctx, key, f)
+	if err != nil {
+		return err
+	}
+
+	if !processed {
+		slog.InfoContext(ctx, "skipping already processed event", "source", key.Source, "eventID", key.EventID)
+		metric.IncInboxDuplicateCounter(key.Source)
+	}
+
+	return nil
+}
+
+func (s *Service) StartInboxCleaner(ctx context.Context) {
+	ticker := time.NewTicker(s.inboxCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.cleanInbox(ctx)
+		}
+	}
+}
+
+func (s *Service) cleanInbox(ctx context.Context) {
+	deleted, err := s.inboxRepository.DeleteProcessedBefore(ctx, time.Now().UTC().Add(-s.inboxRetention))
+	if err != nil {
+		slog.Error("Error cleaning inbox", "error", err)
+		return
+	}
+
+	if deleted > 0 {
+		slog.Info("cleaned inbox", "deleted", deleted)
+	}
+}
@@ -1,8 +1,74 @@
 package notifier
 
+import (
+	"context"
+	"time"
+
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+)
+
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, recipient notification.Recipient, message notification.Message) error
+}
+
+type Router interface {
+	Route(event string, data any) (notification.Route, error)
+}
+
+type ContactRepository interface {
+	GetContact(ctx context.Context, userID int64) (contactmodels.Contact, error)
+	GetPreferences(ctx context.Context, userID int64) (contactmodels.Preferences, error)
+}
+
+type InboxRepository interface {
+	Process(ctx context.Context, key inboxmodels.Key, f func(context.Context) error) (bool, error)
+	Delivered(ctx context.Context, key inboxmodels.Key, channel string) (bool, error)
+	MarkDelivered(ctx context.Context, key inboxmodels.Key, channel string) error
+	DeleteProcessedBefore(ctx context.Context, before time.Time) (int, error)
+}
+
 type Service struct {
+	router               Router
+	channels             map[string]Channel
+	contactRepository    ContactRepository
+	inboxRepository      InboxRepository
+	inboxRetention       time.Duration
+	inboxCleanupInterval time.Duration
+	stopChan             chan struct{}
+}
+
+func NewNotifierService(
+	ctx context.Context,
+	router Router,
+	channels []Channel,
+	contactRepository ContactRepository,
+	inboxRepository InboxRepository,
+	inboxRetention time.Duration,
+	inboxCleanupInterval time.Duration,
+) *Service {
+	byName := make(map[string]Channel, len(channels))
+	for _, ch := range channels {
+		byName[ch.Name()] = ch
+	}
+
+	s := &Service{
+		router:               router,
+		channels:             byName,
+		contactRepository:    contactRepository,
+		inboxRepository:      inboxRepository,
+		inboxRetention:       inboxRetention,
+		inboxCleanupInterval: inboxCleanupInterval,
+		stopChan:             make(chan struct{}),
+	}
+
+	go s.StartInboxCleaner(ctx)
+
+	return s
 }
 
-func NewNotifierService() *Service {
-	return &Service{}
+func (s *Service) Close() {
+	close(s.stopChan)
 }
@@ -2,9 +2,14 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/BruteMors/marketplace-service/libs/tracing"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
 	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -20,10 +25,57 @@ func (s *Service) ProcessOrderStatus(ctx context.Context, event order.StatusChan
 
 	span.SetAttributes(
 		attribute.Int64("orderID", event.OrderID),
+		attribute.Int64("userID", event.UserID),
 		attribute.String("status", event.Status.String()),
 	)
 
-	slog.InfoContext(ctx, "processing order status", "event", event)
+	key := inboxmodels.Key{
+		Source:  inboxmodels.SourceOrderStatus,
+		EventID: event.ID,
+	}
 
-	return nil
+	return s.once(ctx, key, func(ctx context.Context) error {
+		slog.InfoContext(ctx, "processing order status", "event", event)
+
+		resolve, err := s.resolveUser(ctx, event.UserID, event.Status)
+		if err != nil {
+			return err
+		}
+
+		return s.deliver(ctx, key, event.Status.String(), event, resolve)
+	})
+}
+
+// resolveUser looks the user up in the contact registry. A channel is used only when
+// the user has not opted out of the status on it and has an address for it.
+// A user without a contact still gets channels that need no address.
+func (s *Service) resolveUser(ctx context.Context, userID int64, status order.Status) (resolveFunc, error) {
+	contact, err := s.contactRepository.GetContact(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, models.ErrContactNotFound) {
+			return nil, err
+		}
+		contact = contactmodels.Contact{UserID: userID}
+	}
+
+	preferences, err := s.contactRepository.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(channel string) (notification.Recipient, bool) {
+		if !preferences.Allows(status, channel) {
+			return notification.Recipient{}, false
+		}
+
+		address, ok := contact.Address(channel)
+		if !ok {
+			return notification.Recipient{}, false
+		}
+
+		return notification.Recipient{
+			UserID:  userID,
+			Address: address,
+		}, true
+	}, nil
 }
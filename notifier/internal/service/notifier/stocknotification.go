@@ -0,0 +1,37 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/BruteMors/marketplace-service/libs/tracing"
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/stock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *Service) ProcessStockNotification(ctx context.Context, notification stock.Notification) (err error) {
+	tr := otel.Tracer("Service")
+	ctx, span := tr.Start(ctx, "ProcessStockNotification")
+	defer func() {
+		tracing.RecordSpanError(span, err)
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("sku", int64(notification.SKU)),
+		attribute.String("availability", notification.Availability.String()),
+	)
+
+	key := inboxmodels.Key{
+		Source:  inboxmodels.SourceStockLevel,
+		EventID: notification.EventID,
+	}
+
+	return s.once(ctx, key, func(ctx context.Context) error {
+		slog.InfoContext(ctx, "processing stock notification", "notification", notification)
+
+		return s.deliver(ctx, key, notification.Availability.String(), notification, operator)
+	})
+}
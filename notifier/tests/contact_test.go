@@ -0,0 +1,79 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/models"
+	contactmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/contact"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/notification"
+	"github.com/BruteMors/marketplace-service/notifier/internal/models/order"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/contact"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactRepositoryContact(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := contact.NewRepository(conn)
+
+	_, err := repo.GetContact(ctx, 1)
+	require.ErrorIs(t, err, models.ErrContactNotFound)
+
+	saved := contactmodels.Contact{UserID: 1, Email: "user@marketplace.local", WebhookURL: "http://hooks.local/1"}
+	require.NoError(t, repo.SaveContact(ctx, saved))
+
+	got, err := repo.GetContact(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, saved, got)
+
+	// a saved contact replaces the old one, an empty address included
+	saved = contactmodels.Contact{UserID: 1, Email: "new@marketplace.local"}
+	require.NoError(t, repo.SaveContact(ctx, saved))
+
+	got, err = repo.GetContact(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, saved, got)
+}
+
+func TestContactRepositoryPreferences(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := contact.NewRepository(conn)
+
+	preferences, err := repo.GetPreferences(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, preferences)
+
+	err = repo.SetPreferences(ctx, 1, []contactmodels.Preference{
+		{Status: order.OrderStatusPayed, Channel: notification.ChannelEmail, Enabled: false},
+		{Status: order.OrderStatusFailed, Channel: notification.ChannelWebhook, Enabled: true},
+	})
+	require.NoError(t, err)
+
+	err = repo.SetPreferences(ctx, 1, []contactmodels.Preference{
+		{Status: order.OrderStatusPayed, Channel: notification.ChannelEmail, Enabled: true},
+	})
+	require.NoError(t, err)
+
+	preferences, err = repo.GetPreferences(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, contactmodels.Preferences{
+		order.OrderStatusPayed:  {notification.ChannelEmail: true},
+		order.OrderStatusFailed: {notification.ChannelWebhook: true},
+	}, preferences)
+
+	// preferences do not need a contact and are kept per user
+	_, err = repo.GetContact(ctx, 1)
+	require.ErrorIs(t, err, models.ErrContactNotFound)
+
+	preferences, err = repo.GetPreferences(ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, preferences)
+}
@@ -0,0 +1,159 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	inboxmodels "github.com/BruteMors/marketplace-service/notifier/internal/models/inbox"
+	"github.com/BruteMors/marketplace-service/notifier/internal/repository/postgres/inbox"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInboxProcessOnce(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := inbox.NewRepository(conn)
+	key := inboxmodels.Key{Source: inboxmodels.SourceOrderStatus, EventID: 1}
+
+	calls := 0
+	process := func(context.Context) error {
+		calls++
+		return nil
+	}
+
+	processed, err := repo.Process(ctx, key, process)
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	processed, err = repo.Process(ctx, key, process)
+	require.NoError(t, err)
+	require.False(t, processed)
+	require.Equal(t, 1, calls)
+
+	// the same id of another source is a different event
+	processed, err = repo.Process(ctx, inboxmodels.Key{Source: inboxmodels.SourceStockLevel, EventID: 1}, process)
+	require.NoError(t, err)
+	require.True(t, processed)
+	require.Equal(t, 2, calls)
+}
+
+func TestInboxProcessFailedEventAgain(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := inbox.NewRepository(conn)
+	key := inboxmodels.Key{Source: inboxmodels.SourceOrderStatus, EventID: 2}
+	channelErr := errors.New("smtp error")
+
+	processed, err := repo.Process(ctx, key, func(ctx context.Context) error {
+		require.NoError(t, repo.MarkDelivered(ctx, key, "webhook"))
+		return channelErr
+	})
+	require.ErrorIs(t, err, channelErr)
+	require.True(t, processed)
+
+	// the delivery is kept although the event was rolled back
+	delivered, err := repo.Delivered(ctx, key, "webhook")
+	require.NoError(t, err)
+	require.True(t, delivered)
+
+	delivered, err = repo.Delivered(ctx, key, "email")
+	require.NoError(t, err)
+	require.False(t, delivered)
+
+	processed, err = repo.Process(ctx, key, func(context.Context) error { return nil })
+	require.NoError(t, err)
+	require.True(t, processed)
+}
+
+func TestInboxProcessConcurrentCopies(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	first := inbox.NewRepository(conn)
+	second := inbox.NewRepository(conn)
+	key := inboxmodels.Key{Source: inboxmodels.SourceStockLevel, EventID: 3}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	firstDone := make(chan error, 1)
+
+	go func() {
+		_, err := first.Process(ctx, key, func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+		firstDone <- err
+	}()
+
+	<-started
+
+	secondDone := make(chan error, 1)
+	secondProcessed := false
+	go func() {
+		var err error
+		secondProcessed, err = second.Process(ctx, key, func(context.Context) error {
+			return errors.New("processed twice")
+		})
+		secondDone <- err
+	}()
+
+	// the copy waits for the event being processed
+	select {
+	case <-secondDone:
+		t.Fatal("copy was not blocked by the event being processed")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-firstDone)
+	require.NoError(t, <-secondDone)
+	require.False(t, secondProcessed)
+}
+
+func TestInboxDeleteProcessedBefore(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	ctx := context.Background()
+	repo := inbox.NewRepository(conn)
+	oldKey := inboxmodels.Key{Source: inboxmodels.SourceOrderStatus, EventID: 4}
+	newKey := inboxmodels.Key{Source: inboxmodels.SourceOrderStatus, EventID: 5}
+
+	for _, key := range []inboxmodels.Key{oldKey, newKey} {
+		_, err := repo.Process(ctx, key, func(ctx context.Context) error {
+			return repo.MarkDelivered(ctx, key, "email")
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := conn.Exec(ctx, `UPDATE "inbox_events" SET processed_at = processed_at - interval '2 days' WHERE event_id = $1`, oldKey.EventID)
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, `UPDATE "inbox_deliveries" SET delivered_at = delivered_at - interval '2 days' WHERE event_id = $1`, oldKey.EventID)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteProcessedBefore(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	delivered, err := repo.Delivered(ctx, oldKey, "email")
+	require.NoError(t, err)
+	require.False(t, delivered)
+
+	delivered, err = repo.Delivered(ctx, newKey, "email")
+	require.NoError(t, err)
+	require.True(t, delivered)
+
+	processed, err := repo.Process(ctx, oldKey, func(context.Context) error { return nil })
+	require.NoError(t, err)
+	require.True(t, processed)
+}
@@ -0,0 +1,39 @@
+//go:build integration
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BruteMors/marketplace-service/notifier/internal/config"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// The tests expect a database migrated with make local-migration-up.
+
+var (
+	conn *pgxpool.Pool
+)
+
+func setupTest(t *testing.T) {
+	err := config.Load("../.env")
+	require.NoError(t, err)
+
+	pgConfig, err := config.NewPGConfig()
+	require.NoError(t, err)
+
+	conn, err = pgxpool.New(context.Background(), pgConfig.DSN())
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), `TRUNCATE "inbox_events", "inbox_deliveries", "contacts", "contact_preferences"`)
+	require.NoError(t, err)
+}
+
+func teardownTest(t *testing.T) {
+	_, err := conn.Exec(context.Background(), `TRUNCATE "inbox_events", "inbox_deliveries", "contacts", "contact_preferences"`)
+	require.NoError(t, err)
+
+	conn.Close()
+}